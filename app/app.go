@@ -506,6 +506,7 @@ func New(
 		app.EpochstorageKeeper,
 		app.SpecKeeper,
 		app.FixationStoreKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 	dualstakingModule := dualstakingmodule.NewAppModule(appCodec, app.DualstakingKeeper, app.AccountKeeper, app.BankKeeper)
 
@@ -806,7 +807,6 @@ func New(
 		stakingtypes.ModuleName,
 		slashingtypes.ModuleName,
 		govtypes.ModuleName,
-		crisistypes.ModuleName,
 		dualstakingmoduletypes.ModuleName,
 		genutiltypes.ModuleName,
 		evidencetypes.ModuleName,
@@ -829,6 +829,7 @@ func New(
 		paramstypes.ModuleName,
 		fixationtypes.ModuleName,       // fixation store has no init genesis but module manager requires it.
 		timerstoretypes.ModuleName,     // timer store has no init genesis but module manager requires it.
+		crisistypes.ModuleName,         // crisis asserts the invariants, so it must come after the modules that register them
 		conflictmoduletypes.ModuleName, // NOTICE: the last module to initgenesis needs to push fixation in epoch storage
 		// this line is used by starport scaffolding # stargate/app/initGenesis
 	)
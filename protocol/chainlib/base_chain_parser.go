@@ -410,6 +410,7 @@ func getServiceApis(spec spectypes.Spec, rpcInterface string) (retServerApis map
 						LatestDistance:  parseValue.LatestDistance,
 						VerificationKey: verificationKey,
 						Severity:        parseValue.Severity,
+						Priority:        verificationPriority(parseValue.Severity),
 					}
 
 					if extensionVerifications, ok := verifications[verificationKey]; !ok {
@@ -2,9 +2,15 @@ package chainlib
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"os"
 	"strconv"
-	"sync/atomic"
+	"strings"
+	"sync"
 	"time"
 
 	"golang.org/x/exp/slices"
@@ -23,8 +29,87 @@ import (
 const (
 	TendermintStatusQuery  = "status"
 	ChainFetcherHeaderName = "X-LAVA-Provider"
+
+	VerificationJSONLogFlagName = "verification-json-log"
+	StrictVerificationsFlagName = "strict-verifications"
+)
+
+var (
+	// VerificationJSONLog additionally writes each verification outcome as a single
+	// JSON line, for log aggregators
+	VerificationJSONLog = false
+	// verificationJSONLogWriter is where the verification JSON lines are written
+	verificationJSONLogWriter io.Writer = os.Stderr
+	// StrictVerifications makes the provider's chain fetchers fail validation
+	// for node URLs without verifications (see ChainFetcherOptions)
+	StrictVerifications = false
+)
+
+const (
+	DefaultLatestBlockAttempts = 1 // no retries
+	LatestBlockRetryBackoff    = 100 * time.Millisecond
+	DefaultVerifyTimeout       = 30 * time.Second
+	// a latest block lower than the stored one by at least this many blocks is
+	// treated as a genuine reset (e.g. a node resync) rather than a lagging backend
+	DefaultLatestBlockResetGap = 1000
+	// a requested block number may be ahead of the known latest block by at most this
+	// many blocks, to allow for the latest block lagging behind the node
+	BlockNumFutureTolerance = 10
+	// number of blocks fetched in parallel by FetchBlockHashesRange, unless set otherwise
+	DefaultBlockHashesRangeConcurrency = 4
+	// a verification's latest distance above 1/LatestDistanceSanityFraction of the
+	// latest block is suspected to be a spec misconfiguration
+	LatestDistanceSanityFraction = 2
+)
+
+// a verification opts in to template arguments by referencing them in its
+// function template, they are substituted before the message is crafted
+const (
+	VerificationArgLatestBlock    = "{latest_block}"     // decimal, e.g. 1234
+	VerificationArgLatestBlockHex = "{latest_block_hex}" // hex, e.g. 0x4d2
+)
+
+// BlockTimestampUnit is the unit in which a chain reports numeric block timestamps
+type BlockTimestampUnit int
+
+const (
+	BlockTimestampSeconds BlockTimestampUnit = iota
+	BlockTimestampMilliseconds
+)
+
+// BlockHashFormat is the form FetchBlockHashByNum returns hex block hashes in, so
+// hashes from different providers of a chain can be compared as strings
+type BlockHashFormat int
+
+const (
+	BlockHashFormatRaw           BlockHashFormat = iota // as parsed from the node's reply
+	BlockHashFormatHexPrefixed                          // lowercase, with a 0x prefix
+	BlockHashFormatHexUnprefixed                        // lowercase, without a 0x prefix
 )
 
+// normalizeBlockHash returns the hash in the given format. Hashes that aren't hex
+// (e.g. base64 encoded) are returned as is, since changing their case would
+// change their value.
+func normalizeBlockHash(hash string, format BlockHashFormat) string {
+	if format == BlockHashFormatRaw {
+		return hash
+	}
+	digits := strings.TrimPrefix(strings.TrimPrefix(hash, "0x"), "0X")
+	if digits == "" {
+		return hash
+	}
+	for _, c := range digits {
+		if !strings.ContainsRune("0123456789abcdefABCDEF", c) {
+			return hash
+		}
+	}
+	digits = strings.ToLower(digits)
+	if format == BlockHashFormatHexPrefixed {
+		return "0x" + digits
+	}
+	return digits
+}
+
 type ChainFetcherIf interface {
 	FetchLatestBlockNum(ctx context.Context) (int64, error)
 	FetchBlockHashByNum(ctx context.Context, blockNum int64) (string, error)
@@ -37,13 +122,74 @@ type ChainFetcher struct {
 	chainRouter ChainRouter
 	chainParser ChainParser
 	cache       *performance.Cache
-	latestBlock int64
+
+	latestBlockLock sync.RWMutex
+	latestBlock     latestBlockState
+
+	disableCache bool
+
+	latestBlockAttempts int
+	latestBlockResetGap int64
+	verifyTimeout       time.Duration
+
+	blockTimestampParser *spectypes.BlockParser
+	blockTimestampUnit   BlockTimestampUnit
+
+	verificationCache   *VerificationCache
+	strictVerifications bool
+
+	nodeInfoParsing *NodeInfoParsing
+
+	blockHashFormat BlockHashFormat
+
+	finalizationSafetyMargin int64
+}
+
+// latestBlockState is the latest block stored by FetchLatestBlockNum, along with
+// when it was last updated and the number of successful fetches. It is only
+// accessed under latestBlockLock, so readers never see a half-updated state.
+type latestBlockState struct {
+	block          int64
+	updatedAt      time.Time
+	fetchSuccesses uint64
+}
+
+func (cf *ChainFetcher) getLatestBlock() int64 {
+	cf.latestBlockLock.RLock()
+	defer cf.latestBlockLock.RUnlock()
+	return cf.latestBlock.block
+}
+
+// LatestBlockInfo returns the stored latest block, the time it was last updated and
+// the number of successful latest block fetches, as a consistent snapshot
+func (cf *ChainFetcher) LatestBlockInfo() (block int64, updatedAt time.Time, fetchSuccesses uint64) {
+	cf.latestBlockLock.RLock()
+	defer cf.latestBlockLock.RUnlock()
+	return cf.latestBlock.block, cf.latestBlock.updatedAt, cf.latestBlock.fetchSuccesses
 }
 
 func (cf *ChainFetcher) FetchEndpoint() lavasession.RPCProviderEndpoint {
 	return *cf.endpoint
 }
 
+// NodeURLs returns the endpoint's node URLs, in their configured order
+func (cf *ChainFetcher) NodeURLs() []string {
+	urls := make([]string, 0, len(cf.endpoint.NodeUrls))
+	for _, nodeUrl := range cf.endpoint.NodeUrls {
+		urls = append(urls, nodeUrl.Url)
+	}
+	return urls
+}
+
+// PrimaryNodeURL returns the endpoint's first configured node URL, or an empty
+// string if it has none
+func (cf *ChainFetcher) PrimaryNodeURL() string {
+	if len(cf.endpoint.NodeUrls) == 0 {
+		return ""
+	}
+	return cf.endpoint.NodeUrls[0].Url
+}
+
 func (cf *ChainFetcher) Validate(ctx context.Context) error {
 	for _, url := range cf.endpoint.NodeUrls {
 		addons := url.Addons
@@ -51,44 +197,153 @@ func (cf *ChainFetcher) Validate(ctx context.Context) error {
 		if err != nil {
 			return err
 		}
-		if len(verifications) == 0 {
-			utils.LavaFormatDebug("no verifications for NodeUrl", utils.Attribute{Key: "url", Value: url.String()})
+		if err := validateUniqueVerificationNames(verifications); err != nil {
+			return utils.LavaFormatError("invalid verifications for NodeUrl", err, utils.Attribute{Key: "url", Value: url.String()}, utils.Attribute{Key: "Addons", Value: addons})
+		}
+		if err := checkHasVerifications(url, verifications, cf.strictVerifications); err != nil {
+			return err
 		}
+		sortVerificationsByPriority(verifications)
 		var latestBlock int64
-		for attempts := 0; attempts < 3; attempts++ {
-			latestBlock, err = cf.FetchLatestBlockNum(ctx)
-			if err == nil {
-				break
+		fetchedLatestBlock := false
+		fetchLatestBlock := func() (err error) {
+			for attempts := 0; attempts < 3; attempts++ {
+				latestBlock, err = cf.FetchLatestBlockNum(ctx)
+				if err == nil {
+					fetchedLatestBlock = true
+					return nil
+				}
 			}
-		}
-		if err != nil {
 			return err
 		}
+		// with a verification cache, the node is only queried if a verification
+		// has to run
+		if cf.verificationCache == nil {
+			if err := fetchLatestBlock(); err != nil {
+				return err
+			}
+		} else {
+			cf.verificationCache.SetAddons(cf.endpoint.ChainID, cf.endpoint.ApiInterface, url.Url, addons)
+		}
+		var cached []string
 		for _, verification := range verifications {
 			if slices.Contains(url.SkipVerifications, verification.Name) {
 				utils.LavaFormatDebug("Skipping Verification", utils.LogAttr("verification", verification.Name))
 				continue
 			}
-			// we give several chances for starting up
-			var err error
-			for attempts := 0; attempts < 3; attempts++ {
-				err = cf.Verify(ctx, verification, uint64(latestBlock))
-				if err == nil {
-					break
+			if cf.verificationCache != nil && cf.verificationCache.Passed(cf.endpoint.ChainID, cf.endpoint.ApiInterface, url.Url, verification) {
+				cached = append(cached, verification.Name)
+				continue
+			}
+			if !fetchedLatestBlock {
+				if err := fetchLatestBlock(); err != nil {
+					return err
 				}
 			}
+			// we give several chances for starting up
+			err := cf.verifyWithRetries(ctx, verification, uint64(latestBlock), 3)
 			if err != nil {
-				err := utils.LavaFormatError("invalid Verification on provider startup", err, utils.Attribute{Key: "Addons", Value: addons}, utils.Attribute{Key: "verification", Value: verification.Name})
+				// a failing verification aborts the startup, any other severity (e.g. a
+				// chain-id mismatch configured as a warning) is only reported
 				if verification.Severity == spectypes.ParseValue_Fail {
-					return err
+					return utils.LavaFormatError("invalid Verification on provider startup", err, utils.Attribute{Key: "Addons", Value: addons}, utils.Attribute{Key: "verification", Value: verification.Name})
 				}
+				utils.LavaFormatWarning("invalid Verification on provider startup, continuing", err, utils.Attribute{Key: "Addons", Value: addons}, utils.Attribute{Key: "verification", Value: verification.Name}, utils.Attribute{Key: "severity", Value: verification.Severity.String()})
+			} else if cf.verificationCache != nil {
+				cf.verificationCache.Store(cf.endpoint.ChainID, cf.endpoint.ApiInterface, url.Url, verification)
+			}
+		}
+		if len(cached) > 0 {
+			utils.LavaFormatInfo("using cached verification results", utils.LogAttr("url", url.String()), utils.LogAttr("verifications", cached))
+		}
+	}
+	return nil
+}
+
+// checkHasVerifications handles a node URL without verifications: it is logged,
+// and in strict mode it fails the validation unless the URL skips verifications
+func checkHasVerifications(url common.NodeUrl, verifications []VerificationContainer, strict bool) error {
+	if len(verifications) > 0 {
+		return nil
+	}
+	if strict && len(url.SkipVerifications) == 0 {
+		return utils.LavaFormatError("no verifications for NodeUrl in strict mode", nil, utils.Attribute{Key: "url", Value: url.String()}, utils.Attribute{Key: "Addons", Value: url.Addons})
+	}
+	utils.LavaFormatDebug("no verifications for NodeUrl", utils.Attribute{Key: "url", Value: url.String()})
+	return nil
+}
+
+// validateUniqueVerificationNames checks that the verifications' names are unique
+// (per extension and addon), since skipping verifications matches them by name
+func validateUniqueVerificationNames(verifications []VerificationContainer) error {
+	seen := map[VerificationKey]map[string]struct{}{}
+	var duplicates []string
+	for _, verification := range verifications {
+		names, ok := seen[verification.VerificationKey]
+		if !ok {
+			names = map[string]struct{}{}
+			seen[verification.VerificationKey] = names
+		}
+		if _, ok := names[verification.Name]; ok {
+			if !slices.Contains(duplicates, verification.Name) {
+				duplicates = append(duplicates, verification.Name)
 			}
+			continue
 		}
+		names[verification.Name] = struct{}{}
+	}
+	if len(duplicates) > 0 {
+		return fmt.Errorf("duplicate verification names: %s", strings.Join(duplicates, ", "))
 	}
 	return nil
 }
 
-func (cf *ChainFetcher) populateCache(relayData *pairingtypes.RelayPrivateData, reply *pairingtypes.RelayReply, requestedBlockHash []byte, finalized bool) {
+// ProbeNodeURL checks the health of a single node URL (e.g. a new backend, before
+// putting it into rotation): it fetches the latest block and runs the URL's
+// verifications, like Validate, against just that URL
+func (cf *ChainFetcher) ProbeNodeURL(ctx context.Context, url common.NodeUrl) error {
+	probeEndpoint := *cf.endpoint
+	probeEndpoint.NodeUrls = []common.NodeUrl{url}
+
+	chainRouter, err := GetChainRouter(ctx, 1, &probeEndpoint, cf.chainParser)
+	if err != nil {
+		return utils.LavaFormatWarning("failed creating chain router for probe", err, utils.LogAttr("url", url.String()))
+	}
+
+	probe := NewChainFetcher(ctx, &ChainFetcherOptions{
+		ChainRouter:         chainRouter,
+		ChainParser:         cf.chainParser,
+		Endpoint:            &probeEndpoint,
+		LatestBlockAttempts: cf.latestBlockAttempts,
+		LatestBlockResetGap: cf.latestBlockResetGap,
+		VerifyTimeout:       cf.verifyTimeout,
+		DisableCache:        true,
+	})
+	return probe.Validate(ctx)
+}
+
+// populateCache writes the reply to the cache, if it is active and the reply is
+// cacheable. It returns the cache write error, which is also logged.
+// A reply deemed finalized is only cached as such if the requested block is still
+// finalized against a freshly fetched latest block, as the latest block the caller
+// used may have been stale (e.g. reset by a reorg since). Otherwise, a reorged
+// block could be cached permanently.
+func (cf *ChainFetcher) populateCache(ctx context.Context, relayData *pairingtypes.RelayPrivateData, reply *pairingtypes.RelayReply, requestedBlockHash []byte, requestedBlock int64, finalized bool) error {
+	if cf.disableCache {
+		return nil
+	}
+	if finalized {
+		latestBlock, safe := cf.isSafelyFinalized(ctx, requestedBlock)
+		if !safe {
+			utils.LavaFormatDebug("block is no longer finalized against the latest block, not caching it as finalized",
+				utils.Attribute{Key: "chainID", Value: cf.endpoint.ChainID},
+				utils.Attribute{Key: "APIInterface", Value: cf.endpoint.ApiInterface},
+				utils.Attribute{Key: "requestedBlock", Value: requestedBlock},
+				utils.Attribute{Key: "latestBlock", Value: latestBlock},
+			)
+			finalized = false
+		}
+	}
 	if cf.cache.CacheActive() && (requestedBlockHash != nil || finalized) {
 		new_ctx := context.Background()
 		new_ctx, cancel := context.WithTimeout(new_ctx, common.DataReliabilityTimeoutIncrease)
@@ -96,53 +351,213 @@ func (cf *ChainFetcher) populateCache(relayData *pairingtypes.RelayPrivateData,
 		// provider side doesn't use SharedStateId, so we default it to empty so it wont have effect.
 		err := cf.cache.SetEntry(new_ctx, &pairingtypes.RelayCacheSet{Request: relayData, BlockHash: requestedBlockHash, ChainID: cf.endpoint.ChainID, Response: reply, Finalized: finalized, OptionalMetadata: nil, SharedStateId: ""})
 		if err != nil {
-			utils.LavaFormatWarning("chain fetcher error updating cache with new entry", err)
+			return utils.LavaFormatWarning("chain fetcher error updating cache with new entry", err)
 		}
 	}
+	return nil
+}
+
+// isSafelyFinalized fetches the latest block and checks that the block is at least
+// the spec's block distance to finalization, plus the finalization safety margin,
+// behind it. It returns the latest block it checked against; if fetching it fails,
+// the block isn't considered finalized.
+func (cf *ChainFetcher) isSafelyFinalized(ctx context.Context, blockNum int64) (int64, bool) {
+	latestBlock, err := cf.FetchLatestBlockNum(ctx)
+	if err != nil {
+		return spectypes.NOT_APPLICABLE, false
+	}
+	_, _, blockDistanceToFinalization, _ := cf.chainParser.ChainBlockStats()
+	safeLatestBlock := latestBlock - cf.finalizationSafetyMargin
+	return latestBlock, safeLatestBlock > 0 && spectypes.IsFinalizedBlock(blockNum, safeLatestBlock, blockDistanceToFinalization)
 }
 
 func (cf *ChainFetcher) Verify(ctx context.Context, verification VerificationContainer, latestBlock uint64) error {
+	_, err := cf.verify(ctx, verification, latestBlock)
+	return err
+}
+
+// verifyWithRetries runs the verification up to the given number of attempts, until
+// it passes. If all of them fail, the returned error joins the errors of all the
+// attempts (in order), as they may differ (e.g. a timeout, then a wrong value).
+func (cf *ChainFetcher) verifyWithRetries(ctx context.Context, verification VerificationContainer, latestBlock uint64, attempts int) error {
+	var errs []error
+	for attempt := 1; attempt <= attempts; attempt++ {
+		err := cf.Verify(ctx, verification, latestBlock)
+		if err == nil {
+			return nil
+		}
+		errs = append(errs, fmt.Errorf("attempt %d: %w", attempt, err))
+	}
+	return errors.Join(errs...)
+}
+
+// VerificationResult is the outcome of running a single verification on demand
+type VerificationResult struct {
+	Name         string
+	Expected     string
+	ParsedResult string
+	LatestBlock  uint64
+	Duration     time.Duration
+	Error        string // empty if the verification passed
+}
+
+// RunVerificationByName looks up the named verification among the endpoint's
+// verifications and runs it once against the node, returning the parsed result.
+// It is meant for diagnostics, e.g. from an admin handler.
+func (cf *ChainFetcher) RunVerificationByName(ctx context.Context, name string) (VerificationResult, error) {
+	verification, err := cf.findVerification(name)
+	if err != nil {
+		return VerificationResult{Name: name}, err
+	}
+
+	latestBlock, err := cf.FetchLatestBlockNum(ctx)
+	if err != nil {
+		return VerificationResult{Name: name}, err
+	}
+
+	return cf.runVerification(ctx, *verification, uint64(latestBlock))
+}
+
+// RevalidateFailed runs only the named verifications (e.g. those that failed a
+// previous Validate run, after the node was fixed) once against the node. It
+// returns their results in the given order, and an error joining the failures.
+func (cf *ChainFetcher) RevalidateFailed(ctx context.Context, failed []string) ([]VerificationResult, error) {
+	verifications := make([]VerificationContainer, 0, len(failed))
+	for _, name := range failed {
+		verification, err := cf.findVerification(name)
+		if err != nil {
+			return nil, err
+		}
+		verifications = append(verifications, *verification)
+	}
+
+	latestBlock, err := cf.FetchLatestBlockNum(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]VerificationResult, 0, len(verifications))
+	var errs []error
+	for _, verification := range verifications {
+		result, err := cf.runVerification(ctx, verification, uint64(latestBlock))
+		if err != nil {
+			errs = append(errs, err)
+		}
+		results = append(results, result)
+	}
+	return results, errors.Join(errs...)
+}
+
+// findVerification looks up the named verification among the verifications of
+// the endpoint's node URLs (and their addons)
+func (cf *ChainFetcher) findVerification(name string) (*VerificationContainer, error) {
+	for _, url := range cf.endpoint.NodeUrls {
+		verifications, err := cf.chainParser.GetVerifications(url.Addons)
+		if err != nil {
+			return nil, err
+		}
+		if idx := slices.IndexFunc(verifications, func(v VerificationContainer) bool { return v.Name == name }); idx >= 0 {
+			return &verifications[idx], nil
+		}
+	}
+	return nil, utils.LavaFormatWarning("verification not found", nil,
+		utils.LogAttr("verification", name),
+		utils.LogAttr("chainID", cf.endpoint.ChainID),
+		utils.LogAttr("APIInterface", cf.endpoint.ApiInterface),
+	)
+}
+
+func (cf *ChainFetcher) runVerification(ctx context.Context, verification VerificationContainer, latestBlock uint64) (VerificationResult, error) {
+	result := VerificationResult{
+		Name:        verification.Name,
+		Expected:    verification.Value,
+		LatestBlock: latestBlock,
+	}
+	start := time.Now()
+	parsedResult, err := cf.verify(ctx, verification, latestBlock)
+	result.ParsedResult = parsedResult
+	result.Duration = time.Since(start)
+	if err != nil {
+		result.Error = err.Error()
+	}
+	return result, err
+}
+
+// verify runs the verification and returns the parsed result (also when the
+// parsed result fails the verification)
+func (cf *ChainFetcher) verify(ctx context.Context, verification VerificationContainer, latestBlock uint64) (parsedResult string, err error) {
+	var nodeUrl, nodeChainID string
+	if VerificationJSONLog {
+		defer func() {
+			cf.logVerificationJSON(verification, nodeChainID, nodeUrl, parsedResult, err)
+		}()
+	}
+
 	parsing := &verification.ParseDirective
 	collectionType := verification.ConnectionType
 	path := parsing.ApiName
-	data := []byte(fmt.Sprintf(parsing.FunctionTemplate))
+	data, err := craftVerificationData(parsing.FunctionTemplate, latestBlock)
+	if err != nil {
+		return "", utils.LavaFormatError("[-] verify failed crafting data", err, []utils.Attribute{{Key: "chainID", Value: cf.endpoint.ChainID}, {Key: "APIInterface", Value: cf.endpoint.ApiInterface}, {Key: "verification", Value: verification.Name}}...)
+	}
 	chainMessage, err := CraftChainMessage(parsing, collectionType, cf.chainParser, &CraftData{Path: path, Data: data, ConnectionType: collectionType}, cf.ChainFetcherMetadata())
 	if err != nil {
-		return utils.LavaFormatError("[-] verify failed creating chainMessage", err, []utils.Attribute{{Key: "chainID", Value: cf.endpoint.ChainID}, {Key: "APIInterface", Value: cf.endpoint.ApiInterface}}...)
+		return "", utils.LavaFormatError("[-] verify failed creating chainMessage", err, []utils.Attribute{{Key: "chainID", Value: cf.endpoint.ChainID}, {Key: "APIInterface", Value: cf.endpoint.ApiInterface}}...)
 	}
 
-	reply, _, _, proxyUrl, chainId, err := cf.chainRouter.SendNodeMsg(ctx, nil, chainMessage, []string{verification.Extension})
+	timeout := cf.verificationTimeout(verification)
+	sendCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	reply, _, _, proxyUrl, chainId, err := cf.chainRouter.SendNodeMsg(sendCtx, nil, chainMessage, []string{verification.Extension})
 	if err != nil {
-		return utils.LavaFormatWarning("[-] verify failed sending chainMessage", err, []utils.Attribute{{Key: "chainID", Value: cf.endpoint.ChainID}, {Key: "APIInterface", Value: cf.endpoint.ApiInterface}}...)
+		if ctx.Err() == nil && errors.Is(sendCtx.Err(), context.DeadlineExceeded) {
+			return "", utils.LavaFormatWarning("[-] verify timed out sending chainMessage", common.VerificationTimeoutError, []utils.Attribute{{Key: "chainID", Value: cf.endpoint.ChainID}, {Key: "APIInterface", Value: cf.endpoint.ApiInterface}, {Key: "verification", Value: verification.Name}, {Key: "timeout", Value: timeout}}...)
+		}
+		return "", utils.LavaFormatWarning("[-] verify failed sending chainMessage", err, []utils.Attribute{{Key: "chainID", Value: cf.endpoint.ChainID}, {Key: "APIInterface", Value: cf.endpoint.ApiInterface}}...)
+	}
+	nodeUrl, nodeChainID = proxyUrl.Url, chainId
+
+	if verification.MaxResponseBytes > 0 && len(reply.Data) > verification.MaxResponseBytes {
+		return "", utils.LavaFormatWarning("[-] verify failed response is too large", common.VerificationResponseTooLargeError, []utils.Attribute{
+			{Key: "chainId", Value: chainId},
+			{Key: "nodeUrl", Value: proxyUrl.Url},
+			{Key: "verification", Value: verification.Name},
+			{Key: "responseBytes", Value: len(reply.Data)},
+			{Key: "maxResponseBytes", Value: verification.MaxResponseBytes},
+		}...)
 	}
 
 	parserInput, err := FormatResponseForParsing(reply, chainMessage)
 	if err != nil {
-		return err
+		return "", err
 	}
 
-	parsedResult, err := parser.ParseFromReply(parserInput, parsing.ResultParsing)
+	parsedResult, err = parser.ParseFromReply(parserInput, parsing.ResultParsing)
 	if err != nil {
-		return utils.LavaFormatWarning("[-] verify failed to parse result", err, []utils.Attribute{
+		return "", utils.LavaFormatWarning("[-] verify failed to parse result", err, []utils.Attribute{
 			{Key: "chainId", Value: chainId},
 			{Key: "nodeUrl", Value: proxyUrl.Url},
 			{Key: "Method", Value: parsing.GetApiName()},
-			{Key: "Response", Value: string(reply.Data)},
+			{Key: "Response", Value: cf.replyDataForLog(reply)},
 		}...)
 	}
+	if verification.LatestDistance != 0 && latestBlock == 0 {
+		utils.LavaFormatDebug("[-] verify skipped latest distance check, the latest block is unknown", []utils.Attribute{{Key: "chainID", Value: cf.endpoint.ChainID}, {Key: "verification", Value: verification.Name}}...)
+	}
 	if verification.LatestDistance != 0 && latestBlock != 0 {
+		alwaysFails, _ := checkLatestDistance(verification, latestBlock)
 		parsedResultAsNumber, err := strconv.ParseUint(parsedResult, 0, 64)
 		if err != nil {
-			return utils.LavaFormatWarning("[-] verify failed to parse result as number", err, []utils.Attribute{
+			return parsedResult, utils.LavaFormatWarning("[-] verify failed to parse result as number", err, []utils.Attribute{
 				{Key: "chainId", Value: chainId},
 				{Key: "nodeUrl", Value: proxyUrl.Url},
 				{Key: "Method", Value: parsing.GetApiName()},
-				{Key: "Response", Value: string(reply.Data)},
+				{Key: "Response", Value: cf.replyDataForLog(reply)},
 				{Key: "parsedResult", Value: parsedResult},
 			}...)
 		}
 		if parsedResultAsNumber > latestBlock {
-			return utils.LavaFormatWarning("[-] verify failed parsed result is greater than latestBlock", err, []utils.Attribute{
+			return parsedResult, utils.LavaFormatWarning("[-] verify failed parsed result is greater than latestBlock", err, []utils.Attribute{
 				{Key: "chainId", Value: chainId},
 				{Key: "nodeUrl", Value: proxyUrl.Url},
 				{Key: "Method", Value: parsing.GetApiName()},
@@ -150,8 +565,17 @@ func (cf *ChainFetcher) Verify(ctx context.Context, verification VerificationCon
 				{Key: "parsedResult", Value: parsedResultAsNumber},
 			}...)
 		}
+		if alwaysFails {
+			return parsedResult, utils.LavaFormatWarning("[-] verify failed latest distance exceeds the latest block, the check can never pass (a zero latest distance disables it)", nil, []utils.Attribute{
+				{Key: "chainId", Value: chainId},
+				{Key: "nodeUrl", Value: proxyUrl.Url},
+				{Key: "verification", Value: verification.Name},
+				{Key: "latestBlock", Value: latestBlock},
+				{Key: "latestDistance", Value: verification.LatestDistance},
+			}...)
+		}
 		if latestBlock-parsedResultAsNumber < verification.LatestDistance {
-			return utils.LavaFormatWarning("[-] verify failed expected block distance is not sufficient", err, []utils.Attribute{
+			return parsedResult, utils.LavaFormatWarning("[-] verify failed expected block distance is not sufficient", err, []utils.Attribute{
 				{Key: "chainId", Value: chainId},
 				{Key: "nodeUrl", Value: proxyUrl.Url},
 				{Key: "Method", Value: parsing.GetApiName()},
@@ -164,7 +588,7 @@ func (cf *ChainFetcher) Verify(ctx context.Context, verification VerificationCon
 	// some verifications only want the response to be valid, and don't care about the value
 	if verification.Value != "*" && verification.Value != "" {
 		if parsedResult != verification.Value {
-			return utils.LavaFormatWarning("[-] verify failed expected and received are different", err, []utils.Attribute{
+			return parsedResult, utils.LavaFormatWarning("[-] verify failed expected and received are different", err, []utils.Attribute{
 				{Key: "chainId", Value: chainId},
 				{Key: "nodeUrl", Value: proxyUrl.Url},
 				{Key: "parsedResult", Value: parsedResult},
@@ -183,7 +607,90 @@ func (cf *ChainFetcher) Verify(ctx context.Context, verification VerificationCon
 		utils.Attribute{Key: "value", Value: parser.CapStringLen(parsedResult)},
 		utils.Attribute{Key: "verificationKey", Value: verification.VerificationKey},
 	)
-	return nil
+	return parsedResult, nil
+}
+
+// checkLatestDistance checks that the verification's LatestDistance is plausible
+// for the latest block, and logs a warning (which it returns) if it isn't: beyond
+// the latest block the check always fails, and beyond a large fraction of it the
+// spec is likely misconfigured. A zero LatestDistance disables the check.
+func checkLatestDistance(verification VerificationContainer, latestBlock uint64) (alwaysFails bool, warning error) {
+	if verification.LatestDistance == 0 || latestBlock == 0 {
+		return false, nil
+	}
+	alwaysFails = verification.LatestDistance > latestBlock
+	if alwaysFails || verification.LatestDistance > latestBlock/LatestDistanceSanityFraction {
+		warning = utils.LavaFormatWarning("verification latest distance is implausibly large for the latest block, check the spec", nil,
+			utils.LogAttr("verification", verification.Name),
+			utils.LogAttr("latestDistance", verification.LatestDistance),
+			utils.LogAttr("latestBlock", latestBlock),
+			utils.LogAttr("alwaysFails", alwaysFails),
+		)
+	}
+	return alwaysFails, warning
+}
+
+// verificationLogEntry is the JSON line written for a verification outcome
+type verificationLogEntry struct {
+	Verification string `json:"verification"`
+	Result       string `json:"result"`
+	Expected     string `json:"expected"`
+	Got          string `json:"got"`
+	NodeUrl      string `json:"nodeUrl"`
+	ChainId      string `json:"chainId"`
+	Error        string `json:"error,omitempty"`
+}
+
+func (cf *ChainFetcher) logVerificationJSON(verification VerificationContainer, chainID, nodeUrl, parsedResult string, verifyErr error) {
+	if chainID == "" {
+		chainID = cf.endpoint.ChainID
+	}
+	entry := verificationLogEntry{
+		Verification: verification.Name,
+		Result:       "success",
+		Expected:     verification.Value,
+		Got:          parser.CapStringLen(parsedResult),
+		NodeUrl:      nodeUrl,
+		ChainId:      chainID,
+	}
+	if verifyErr != nil {
+		entry.Result = "failure"
+		entry.Error = verifyErr.Error()
+	}
+	line, err := json.Marshal(entry)
+	if err != nil {
+		utils.LavaFormatDebug("failed marshaling verification log entry", utils.LogAttr("error", err))
+		return
+	}
+	verificationJSONLogWriter.Write(append(line, '\n'))
+}
+
+// verificationTimeout returns the timeout for sending a verification's message
+func (cf *ChainFetcher) verificationTimeout(verification VerificationContainer) time.Duration {
+	if verification.Timeout > 0 {
+		return verification.Timeout
+	}
+	if cf.verifyTimeout > 0 {
+		return cf.verifyTimeout
+	}
+	return DefaultVerifyTimeout
+}
+
+// craftVerificationData formats a verification's function template. Templates
+// that reference the latest block arguments get them substituted, which requires
+// a known latest block
+func craftVerificationData(template string, latestBlock uint64) ([]byte, error) {
+	if !strings.Contains(template, VerificationArgLatestBlock) && !strings.Contains(template, VerificationArgLatestBlockHex) {
+		return []byte(template), nil
+	}
+	if latestBlock == 0 {
+		return nil, fmt.Errorf("verification template references the latest block but it is unknown")
+	}
+	replacer := strings.NewReplacer(
+		VerificationArgLatestBlockHex, "0x"+strconv.FormatUint(latestBlock, 16),
+		VerificationArgLatestBlock, strconv.FormatUint(latestBlock, 10),
+	)
+	return []byte(replacer.Replace(template)), nil
 }
 
 func (cf *ChainFetcher) ChainFetcherMetadata() []pairingtypes.Metadata {
@@ -209,6 +716,74 @@ func (cf *ChainFetcher) FetchLatestBlockNum(ctx context.Context) (int64, error)
 	if err != nil {
 		return spectypes.NOT_APPLICABLE, utils.LavaFormatError(tagName+" failed creating chainMessage", err, []utils.Attribute{{Key: "chainID", Value: cf.endpoint.ChainID}, {Key: "APIInterface", Value: cf.endpoint.ApiInterface}}...)
 	}
+	attempts := cf.latestBlockAttempts
+	if attempts < 1 {
+		attempts = DefaultLatestBlockAttempts
+	}
+	var blockNum int64
+	for attempt := 0; attempt < attempts; attempt++ {
+		if attempt > 0 {
+			// transient failures (e.g. a malformed reply under load) get another chance
+			// after an exponential backoff; the message is re-sent, not re-parsed
+			select {
+			case <-ctx.Done():
+				return spectypes.NOT_APPLICABLE, ctx.Err()
+			case <-time.After(LatestBlockRetryBackoff << (attempt - 1)):
+			}
+		}
+		blockNum, err = cf.sendLatestBlockNumMessage(ctx, parsing, chainMessage)
+		if err == nil {
+			break
+		}
+	}
+	if err != nil {
+		return spectypes.NOT_APPLICABLE, err
+	}
+	return cf.updateLatestBlock(blockNum), nil
+}
+
+// updateLatestBlock stores the fetched latest block and returns it, unless it is
+// behind the stored one (e.g. a lagging backend behind a load balancer), in which
+// case the stored one is kept and returned. Large enough backward gaps are
+// accepted as a genuine reset. Either way, the fetch is counted as a success and
+// the update time is refreshed along with the block.
+func (cf *ChainFetcher) updateLatestBlock(blockNum int64) int64 {
+	resetGap := cf.latestBlockResetGap
+	if resetGap <= 0 {
+		resetGap = DefaultLatestBlockResetGap
+	}
+
+	cf.latestBlockLock.Lock()
+	defer cf.latestBlockLock.Unlock()
+
+	cf.latestBlock.updatedAt = time.Now()
+	cf.latestBlock.fetchSuccesses++
+
+	stored := cf.latestBlock.block
+	if blockNum < stored && stored-blockNum < resetGap {
+		utils.LavaFormatWarning("ignoring latest block lower than the stored one", nil,
+			utils.Attribute{Key: "chainID", Value: cf.endpoint.ChainID},
+			utils.Attribute{Key: "APIInterface", Value: cf.endpoint.ApiInterface},
+			utils.Attribute{Key: "fetched", Value: blockNum},
+			utils.Attribute{Key: "stored", Value: stored},
+		)
+		return stored
+	}
+	if blockNum < stored {
+		utils.LavaFormatWarning("latest block went back beyond the reset gap, accepting it", nil,
+			utils.Attribute{Key: "chainID", Value: cf.endpoint.ChainID},
+			utils.Attribute{Key: "APIInterface", Value: cf.endpoint.ApiInterface},
+			utils.Attribute{Key: "fetched", Value: blockNum},
+			utils.Attribute{Key: "stored", Value: stored},
+		)
+	}
+	cf.latestBlock.block = blockNum
+	return blockNum
+}
+
+// sendLatestBlockNumMessage sends a crafted GET_BLOCKNUM message to the node and parses the block number from its reply
+func (cf *ChainFetcher) sendLatestBlockNumMessage(ctx context.Context, parsing *spectypes.ParseDirective, chainMessage ChainMessageForSend) (int64, error) {
+	tagName := spectypes.FUNCTION_TAG_GET_BLOCKNUM.String()
 	reply, _, _, proxyUrl, chainId, err := cf.chainRouter.SendNodeMsg(ctx, nil, chainMessage, nil)
 	if err != nil {
 		return spectypes.NOT_APPLICABLE, utils.LavaFormatDebug(tagName+" failed sending chainMessage", []utils.Attribute{{Key: "chainID", Value: cf.endpoint.ChainID}, {Key: "APIInterface", Value: cf.endpoint.ApiInterface}, {Key: "error", Value: err}}...)
@@ -219,7 +794,7 @@ func (cf *ChainFetcher) FetchLatestBlockNum(ctx context.Context) (int64, error)
 			{Key: "chainId", Value: chainId},
 			{Key: "nodeUrl", Value: proxyUrl.Url},
 			{Key: "Method", Value: parsing.ApiName},
-			{Key: "Response", Value: string(reply.Data)},
+			{Key: "Response", Value: cf.replyDataForLog(reply)},
 			{Key: "error", Value: err},
 		}...)
 	}
@@ -229,11 +804,10 @@ func (cf *ChainFetcher) FetchLatestBlockNum(ctx context.Context) (int64, error)
 			{Key: "chainId", Value: chainId},
 			{Key: "nodeUrl", Value: proxyUrl.Url},
 			{Key: "Method", Value: parsing.ApiName},
-			{Key: "Response", Value: string(reply.Data)},
+			{Key: "Response", Value: cf.replyDataForLog(reply)},
 			{Key: "error", Value: err},
 		}...)
 	}
-	atomic.StoreInt64(&cf.latestBlock, blockNum)
 	return blockNum, nil
 }
 
@@ -251,53 +825,354 @@ func (cf *ChainFetcher) constructRelayData(conectionType string, path string, da
 	return relayData
 }
 
+// replyDataForLog returns the reply data in a printable form: gRPC replies are
+// protobuf encoded (their parsing goes through the message's CustomParsingMessage
+// in FormatResponseForParsing), so they are logged base64 encoded
+func (cf *ChainFetcher) replyDataForLog(reply *pairingtypes.RelayReply) string {
+	if cf.endpoint.ApiInterface == spectypes.APIInterfaceGrpc {
+		return base64.StdEncoding.EncodeToString(reply.Data)
+	}
+	return string(reply.Data)
+}
+
+// validateBlockNum rejects negative block numbers and, when the latest block is
+// known, block numbers too far ahead of it
+func (cf *ChainFetcher) validateBlockNum(blockNum int64) error {
+	if blockNum < 0 {
+		return fmt.Errorf("negative block number %d", blockNum)
+	}
+	latestBlock := cf.getLatestBlock()
+	if latestBlock > 0 && blockNum > latestBlock+BlockNumFutureTolerance {
+		return fmt.Errorf("block number %d is ahead of latest block %d", blockNum, latestBlock)
+	}
+	return nil
+}
+
+// FetchBlockHashByNum fetches the block hash, normalized according to the
+// fetcher's BlockHashFormat
 func (cf *ChainFetcher) FetchBlockHashByNum(ctx context.Context, blockNum int64) (string, error) {
-	parsing, collectionData, ok := cf.chainParser.GetParsingByTag(spectypes.FUNCTION_TAG_GET_BLOCK_BY_NUM)
+	hash, err := cf.FetchRawBlockHashByNum(ctx, blockNum)
+	if err != nil {
+		return "", err
+	}
+	return normalizeBlockHash(hash, cf.blockHashFormat), nil
+}
+
+// FetchRawBlockHashByNum fetches the block hash as parsed from the node's reply
+func (cf *ChainFetcher) FetchRawBlockHashByNum(ctx context.Context, blockNum int64) (string, error) {
+	hash, _, err := cf.fetchBlockHashByNum(ctx, blockNum)
+	return hash, err
+}
+
+// fetchBlockHashByNum fetches the (raw) block hash, and also returns whether the
+// block was fetched but writing it to the cache failed
+func (cf *ChainFetcher) fetchBlockHashByNum(ctx context.Context, blockNum int64) (string, bool, error) {
 	tagName := spectypes.FUNCTION_TAG_GET_BLOCK_BY_NUM.String()
+	if err := cf.validateBlockNum(blockNum); err != nil {
+		return "", false, utils.LavaFormatWarning(tagName+" invalid block number", err, []utils.Attribute{{Key: "chainID", Value: cf.endpoint.ChainID}, {Key: "APIInterface", Value: cf.endpoint.ApiInterface}}...)
+	}
+	parsing, collectionData, ok := cf.chainParser.GetParsingByTag(spectypes.FUNCTION_TAG_GET_BLOCK_BY_NUM)
 	if !ok {
-		return "", utils.LavaFormatError(tagName+" tag function not found", nil, []utils.Attribute{{Key: "chainID", Value: cf.endpoint.ChainID}, {Key: "APIInterface", Value: cf.endpoint.ApiInterface}}...)
+		return "", false, utils.LavaFormatError(tagName+" tag function not found", nil, []utils.Attribute{{Key: "chainID", Value: cf.endpoint.ChainID}, {Key: "APIInterface", Value: cf.endpoint.ApiInterface}}...)
 	}
 	if parsing.FunctionTemplate == "" {
-		return "", utils.LavaFormatError(tagName+" missing function template", nil, []utils.Attribute{{Key: "chainID", Value: cf.endpoint.ChainID}, {Key: "APIInterface", Value: cf.endpoint.ApiInterface}}...)
+		return "", false, utils.LavaFormatError(tagName+" missing function template", nil, []utils.Attribute{{Key: "chainID", Value: cf.endpoint.ChainID}, {Key: "APIInterface", Value: cf.endpoint.ApiInterface}}...)
 	}
 	path := parsing.ApiName
 	data := []byte(fmt.Sprintf(parsing.FunctionTemplate, blockNum))
 	chainMessage, err := CraftChainMessage(parsing, collectionData.Type, cf.chainParser, &CraftData{Path: path, Data: data, ConnectionType: collectionData.Type}, cf.ChainFetcherMetadata())
 	if err != nil {
-		return "", utils.LavaFormatError(tagName+" failed CraftChainMessage on function template", err, []utils.Attribute{{Key: "chainID", Value: cf.endpoint.ChainID}, {Key: "APIInterface", Value: cf.endpoint.ApiInterface}}...)
+		return "", false, utils.LavaFormatError(tagName+" failed CraftChainMessage on function template", err, []utils.Attribute{{Key: "chainID", Value: cf.endpoint.ChainID}, {Key: "APIInterface", Value: cf.endpoint.ApiInterface}}...)
 	}
 	start := time.Now()
 	reply, _, _, proxyUrl, chainId, err := cf.chainRouter.SendNodeMsg(ctx, nil, chainMessage, nil)
 	if err != nil {
 		timeTaken := time.Since(start)
-		return "", utils.LavaFormatDebug(tagName+" failed sending chainMessage", []utils.Attribute{{Key: "sendTime", Value: timeTaken}, {Key: "error", Value: err}, {Key: "chainID", Value: cf.endpoint.ChainID}, {Key: "APIInterface", Value: cf.endpoint.ApiInterface}}...)
+		return "", false, utils.LavaFormatDebug(tagName+" failed sending chainMessage", []utils.Attribute{{Key: "sendTime", Value: timeTaken}, {Key: "error", Value: err}, {Key: "chainID", Value: cf.endpoint.ChainID}, {Key: "APIInterface", Value: cf.endpoint.ApiInterface}}...)
 	}
 	parserInput, err := FormatResponseForParsing(reply, chainMessage)
 	if err != nil {
-		return "", utils.LavaFormatDebug(tagName+" Failed formatResponseForParsing", []utils.Attribute{
+		return "", false, utils.LavaFormatDebug(tagName+" Failed formatResponseForParsing", []utils.Attribute{
 			{Key: "error", Value: err},
 			{Key: "chainId", Value: chainId},
 			{Key: "nodeUrl", Value: proxyUrl.Url},
 			{Key: "Method", Value: parsing.ApiName},
-			{Key: "Response", Value: string(reply.Data)},
+			{Key: "Response", Value: cf.replyDataForLog(reply)},
 		}...)
 	}
 
 	res, err := parser.ParseFromReplyAndDecode(parserInput, parsing.ResultParsing)
 	if err != nil {
-		return "", utils.LavaFormatDebug(tagName+" Failed ParseMessageResponse", []utils.Attribute{
+		return "", false, utils.LavaFormatDebug(tagName+" Failed ParseMessageResponse", []utils.Attribute{
 			{Key: "error", Value: err},
 			{Key: "chainId", Value: chainId},
 			{Key: "nodeUrl", Value: proxyUrl.Url},
 			{Key: "Method", Value: parsing.ApiName},
-			{Key: "Response", Value: string(reply.Data)},
+			{Key: "Response", Value: cf.replyDataForLog(reply)},
 		}...)
 	}
 	_, _, blockDistanceToFinalization, _ := cf.chainParser.ChainBlockStats()
-	latestBlock := atomic.LoadInt64(&cf.latestBlock) // assuming FetchLatestBlockNum is called before this one it's always true
+	latestBlock := cf.getLatestBlock() // assuming FetchLatestBlockNum is called before this one it's always true
 	if latestBlock > 0 {
 		finalized := spectypes.IsFinalizedBlock(blockNum, latestBlock, blockDistanceToFinalization)
-		cf.populateCache(cf.constructRelayData(collectionData.Type, path, data, blockNum, "", nil), reply, []byte(res), finalized)
+		if err := cf.populateCache(ctx, cf.constructRelayData(collectionData.Type, path, data, blockNum, "", nil), reply, []byte(res), blockNum, finalized); err != nil {
+			return res, true, nil
+		}
+	}
+	return res, false, nil
+}
+
+// WarmCache pre-fetches the hashes of the last depth finalized blocks, so they are
+// cached before the first consumer relays arrive. It uses the latest block known
+// to the chain fetcher (fetching it if unknown), and is a no-op when the cache is
+// inactive or disabled.
+func (cf *ChainFetcher) WarmCache(ctx context.Context, depth int) error {
+	if depth <= 0 || cf.disableCache || !cf.cache.CacheActive() {
+		return nil
+	}
+
+	latestBlock := cf.getLatestBlock()
+	if latestBlock <= 0 {
+		var err error
+		latestBlock, err = cf.FetchLatestBlockNum(ctx)
+		if err != nil {
+			return err
+		}
+	}
+
+	// only finalized blocks are cached
+	_, _, blockDistanceToFinalization, _ := cf.chainParser.ChainBlockStats()
+	toBlock := latestBlock - int64(blockDistanceToFinalization)
+	if toBlock < 0 {
+		return nil
+	}
+	fromBlock := toBlock - int64(depth) + 1
+	if fromBlock < 0 {
+		fromBlock = 0
+	}
+
+	_, _, err := cf.FetchBlockHashesRange(ctx, fromBlock, toBlock, 0)
+	return err
+}
+
+// CacheResult summarizes the cache writes of the blocks fetched by
+// FetchBlockHashesRange (blocks that aren't cacheable, e.g. not finalized, never fail)
+type CacheResult struct {
+	Failed []int64 // fetched blocks that failed to be written to the cache, sorted
+}
+
+// FetchBlockHashesRange fetches the hashes of the blocks in [fromBlock, toBlock],
+// with up to concurrency blocks fetched in parallel (a non-positive concurrency
+// means DefaultBlockHashesRangeConcurrency). On partial failure, the hashes that
+// were fetched are returned along with the joined errors of the failed blocks.
+// The returned CacheResult lists the fetched blocks that failed to be cached, so
+// the caller can decide whether to retry caching them.
+func (cf *ChainFetcher) FetchBlockHashesRange(ctx context.Context, fromBlock, toBlock int64, concurrency int) (map[int64]string, CacheResult, error) {
+	if fromBlock > toBlock {
+		return nil, CacheResult{}, utils.LavaFormatWarning("invalid block range", nil, utils.LogAttr("fromBlock", fromBlock), utils.LogAttr("toBlock", toBlock))
+	}
+	if concurrency <= 0 {
+		concurrency = DefaultBlockHashesRangeConcurrency
+	}
+
+	blocks := make(chan int64)
+	go func() {
+		defer close(blocks)
+		for blockNum := fromBlock; blockNum <= toBlock; blockNum++ {
+			select {
+			case blocks <- blockNum:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	var (
+		mu          sync.Mutex
+		wg          sync.WaitGroup
+		hashes      = map[int64]string{}
+		cacheResult CacheResult
+		errs        []error
+	)
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for blockNum := range blocks {
+				hash, cacheFailed, err := cf.fetchBlockHashByNum(ctx, blockNum)
+				mu.Lock()
+				if err != nil {
+					errs = append(errs, fmt.Errorf("block %d: %w", blockNum, err))
+				} else {
+					hashes[blockNum] = normalizeBlockHash(hash, cf.blockHashFormat)
+					if cacheFailed {
+						cacheResult.Failed = append(cacheResult.Failed, blockNum)
+					}
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	if ctx.Err() != nil {
+		errs = append(errs, ctx.Err())
+	}
+	slices.Sort(cacheResult.Failed)
+	return hashes, cacheResult, errors.Join(errs...)
+}
+
+// FetchBlockTimestampByNum fetches the block by its number (using the GET_BLOCK_BY_NUM
+// function template) and parses its timestamp out of the reply
+func (cf *ChainFetcher) FetchBlockTimestampByNum(ctx context.Context, blockNum int64) (time.Time, error) {
+	tagName := spectypes.FUNCTION_TAG_GET_BLOCK_BY_NUM.String()
+	if cf.blockTimestampParser == nil {
+		return time.Time{}, utils.LavaFormatError(tagName+" block timestamp parser not configured", nil, []utils.Attribute{{Key: "chainID", Value: cf.endpoint.ChainID}, {Key: "APIInterface", Value: cf.endpoint.ApiInterface}}...)
+	}
+	parsing, collectionData, ok := cf.chainParser.GetParsingByTag(spectypes.FUNCTION_TAG_GET_BLOCK_BY_NUM)
+	if !ok {
+		return time.Time{}, utils.LavaFormatError(tagName+" tag function not found", nil, []utils.Attribute{{Key: "chainID", Value: cf.endpoint.ChainID}, {Key: "APIInterface", Value: cf.endpoint.ApiInterface}}...)
+	}
+	if parsing.FunctionTemplate == "" {
+		return time.Time{}, utils.LavaFormatError(tagName+" missing function template", nil, []utils.Attribute{{Key: "chainID", Value: cf.endpoint.ChainID}, {Key: "APIInterface", Value: cf.endpoint.ApiInterface}}...)
+	}
+	path := parsing.ApiName
+	data := []byte(fmt.Sprintf(parsing.FunctionTemplate, blockNum))
+	chainMessage, err := CraftChainMessage(parsing, collectionData.Type, cf.chainParser, &CraftData{Path: path, Data: data, ConnectionType: collectionData.Type}, cf.ChainFetcherMetadata())
+	if err != nil {
+		return time.Time{}, utils.LavaFormatError(tagName+" failed CraftChainMessage on function template", err, []utils.Attribute{{Key: "chainID", Value: cf.endpoint.ChainID}, {Key: "APIInterface", Value: cf.endpoint.ApiInterface}}...)
+	}
+	reply, _, _, proxyUrl, chainId, err := cf.chainRouter.SendNodeMsg(ctx, nil, chainMessage, nil)
+	if err != nil {
+		return time.Time{}, utils.LavaFormatDebug(tagName+" failed sending chainMessage", []utils.Attribute{{Key: "error", Value: err}, {Key: "chainID", Value: cf.endpoint.ChainID}, {Key: "APIInterface", Value: cf.endpoint.ApiInterface}}...)
+	}
+	parserInput, err := FormatResponseForParsing(reply, chainMessage)
+	if err != nil {
+		return time.Time{}, utils.LavaFormatDebug(tagName+" Failed formatResponseForParsing", []utils.Attribute{
+			{Key: "error", Value: err},
+			{Key: "chainId", Value: chainId},
+			{Key: "nodeUrl", Value: proxyUrl.Url},
+			{Key: "Method", Value: parsing.ApiName},
+			{Key: "Response", Value: cf.replyDataForLog(reply)},
+		}...)
+	}
+
+	res, err := parser.ParseFromReply(parserInput, *cf.blockTimestampParser)
+	if err != nil {
+		return time.Time{}, utils.LavaFormatDebug(tagName+" Failed parsing block timestamp", []utils.Attribute{
+			{Key: "error", Value: err},
+			{Key: "chainId", Value: chainId},
+			{Key: "nodeUrl", Value: proxyUrl.Url},
+			{Key: "Method", Value: parsing.ApiName},
+			{Key: "Response", Value: cf.replyDataForLog(reply)},
+		}...)
+	}
+	return parseBlockTimestamp(res, cf.blockTimestampUnit)
+}
+
+// parseBlockTimestamp parses a numeric (decimal or 0x-prefixed hex) timestamp in
+// the given unit, or an RFC3339 formatted one
+func parseBlockTimestamp(value string, unit BlockTimestampUnit) (time.Time, error) {
+	if num, err := strconv.ParseInt(value, 0, 64); err == nil {
+		switch unit {
+		case BlockTimestampSeconds:
+			return time.Unix(num, 0).UTC(), nil
+		case BlockTimestampMilliseconds:
+			return time.UnixMilli(num).UTC(), nil
+		default:
+			return time.Time{}, fmt.Errorf("unknown block timestamp unit %d", unit)
+		}
+	}
+	parsed, err := time.Parse(time.RFC3339Nano, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid block timestamp %q: %w", value, err)
+	}
+	return parsed.UTC(), nil
+}
+
+// NodeInfo is the upstream node's diagnostic info. Fields that the chain can't
+// report (or that aren't configured) are empty, or NOT_APPLICABLE for numbers.
+type NodeInfo struct {
+	Version   string
+	PeerCount int64
+}
+
+// NodeInfoParsing describes the node messages FetchNodeInfo sends, since specs
+// have no parsing tag for the node's info. A nil query is not applicable.
+type NodeInfoParsing struct {
+	Version   *NodeInfoQuery // e.g. web3_clientVersion
+	PeerCount *NodeInfoQuery // e.g. net_peerCount
+}
+
+// NodeInfoQuery is a node message (its ApiName and FunctionTemplate) sent over the
+// connection type, and the ResultParsing extracting the value from the reply
+type NodeInfoQuery struct {
+	ConnectionType string
+	ParseDirective spectypes.ParseDirective
+}
+
+// FetchNodeInfo queries the node's software version and peer count, for
+// diagnostics. Queries that aren't configured are reported as not applicable.
+func (cf *ChainFetcher) FetchNodeInfo(ctx context.Context) (NodeInfo, error) {
+	info := NodeInfo{PeerCount: spectypes.NOT_APPLICABLE}
+	if cf.nodeInfoParsing == nil {
+		return info, nil
+	}
+
+	if query := cf.nodeInfoParsing.Version; query != nil {
+		version, err := cf.sendNodeInfoQuery(ctx, query)
+		if err != nil {
+			return info, err
+		}
+		info.Version = version
+	}
+
+	if query := cf.nodeInfoParsing.PeerCount; query != nil {
+		res, err := cf.sendNodeInfoQuery(ctx, query)
+		if err != nil {
+			return info, err
+		}
+		peerCount, err := strconv.ParseInt(res, 0, 64)
+		if err != nil {
+			return info, utils.LavaFormatDebug("node info failed parsing peer count", []utils.Attribute{{Key: "chainID", Value: cf.endpoint.ChainID}, {Key: "APIInterface", Value: cf.endpoint.ApiInterface}, {Key: "peerCount", Value: res}, {Key: "error", Value: err}}...)
+		}
+		info.PeerCount = peerCount
+	}
+
+	return info, nil
+}
+
+// sendNodeInfoQuery sends the node info query to the node and parses its reply
+func (cf *ChainFetcher) sendNodeInfoQuery(ctx context.Context, query *NodeInfoQuery) (string, error) {
+	parsing := &query.ParseDirective
+	craftData := &CraftData{Path: parsing.ApiName, Data: []byte(parsing.FunctionTemplate), ConnectionType: query.ConnectionType}
+	chainMessage, err := CraftChainMessage(parsing, query.ConnectionType, cf.chainParser, craftData, cf.ChainFetcherMetadata())
+	if err != nil {
+		return "", utils.LavaFormatError("node info failed creating chainMessage", err, []utils.Attribute{{Key: "chainID", Value: cf.endpoint.ChainID}, {Key: "APIInterface", Value: cf.endpoint.ApiInterface}, {Key: "Method", Value: parsing.ApiName}}...)
+	}
+	reply, _, _, proxyUrl, chainId, err := cf.chainRouter.SendNodeMsg(ctx, nil, chainMessage, nil)
+	if err != nil {
+		return "", utils.LavaFormatDebug("node info failed sending chainMessage", []utils.Attribute{{Key: "chainID", Value: cf.endpoint.ChainID}, {Key: "APIInterface", Value: cf.endpoint.ApiInterface}, {Key: "Method", Value: parsing.ApiName}, {Key: "error", Value: err}}...)
+	}
+	parserInput, err := FormatResponseForParsing(reply, chainMessage)
+	if err != nil {
+		return "", utils.LavaFormatDebug("node info Failed formatResponseForParsing", []utils.Attribute{
+			{Key: "chainId", Value: chainId},
+			{Key: "nodeUrl", Value: proxyUrl.Url},
+			{Key: "Method", Value: parsing.ApiName},
+			{Key: "Response", Value: cf.replyDataForLog(reply)},
+			{Key: "error", Value: err},
+		}...)
+	}
+	res, err := parser.ParseFromReply(parserInput, parsing.ResultParsing)
+	if err != nil {
+		return "", utils.LavaFormatDebug("node info Failed to parse Response", []utils.Attribute{
+			{Key: "chainId", Value: chainId},
+			{Key: "nodeUrl", Value: proxyUrl.Url},
+			{Key: "Method", Value: parsing.ApiName},
+			{Key: "Response", Value: cf.replyDataForLog(reply)},
+			{Key: "error", Value: err},
+		}...)
 	}
 	return res, nil
 }
@@ -307,14 +1182,66 @@ type ChainFetcherOptions struct {
 	ChainParser ChainParser
 	Endpoint    *lavasession.RPCProviderEndpoint
 	Cache       *performance.Cache
+	// LatestBlockAttempts is the number of times FetchLatestBlockNum sends its
+	// message before giving up (zero means DefaultLatestBlockAttempts)
+	LatestBlockAttempts int
+	// LatestBlockResetGap is the backward gap from which a lower latest block is
+	// accepted rather than ignored (zero means DefaultLatestBlockResetGap)
+	LatestBlockResetGap int64
+	// VerifyTimeout bounds the node message of verifications that don't set
+	// their own timeout (zero means DefaultVerifyTimeout)
+	VerifyTimeout time.Duration
+	// BlockTimestampParser extracts the block timestamp from the GET_BLOCK_BY_NUM
+	// reply (FetchBlockTimestampByNum fails when it's not set), and numeric
+	// timestamps are interpreted according to BlockTimestampUnit
+	BlockTimestampParser *spectypes.BlockParser
+	BlockTimestampUnit   BlockTimestampUnit
+	// DisableCache stops the fetcher from ever writing its replies to the cache,
+	// even when Cache is active. Useful for verification-only or diagnostic
+	// fetchers that shouldn't populate the shared cache
+	DisableCache bool
+	// VerificationCache, when set, lets Validate reuse recent successful
+	// verification results instead of re-verifying them against the node
+	VerificationCache *VerificationCache
+	// StrictVerifications makes Validate fail when a node URL has no
+	// verifications (unless it skips verifications), to catch spec mistakes.
+	// By default such URLs only log a debug message
+	StrictVerifications bool
+	// NodeInfoParsing tells FetchNodeInfo how to query the node's version and
+	// peer count (when not set, they are not applicable)
+	NodeInfoParsing *NodeInfoParsing
+	// BlockHashFormat normalizes the hex block hashes returned by
+	// FetchBlockHashByNum and FetchBlockHashesRange (the zero value keeps them raw)
+	BlockHashFormat BlockHashFormat
+	// FinalizationSafetyMargin is the number of blocks, beyond the spec's block
+	// distance to finalization, a block must be behind the latest block for its
+	// reply to be cached as finalized (zero means no margin)
+	FinalizationSafetyMargin int64
 }
 
 func NewChainFetcher(ctx context.Context, options *ChainFetcherOptions) *ChainFetcher {
+	latestBlockAttempts := options.LatestBlockAttempts
+	if latestBlockAttempts <= 0 {
+		latestBlockAttempts = DefaultLatestBlockAttempts
+	}
 	return &ChainFetcher{
-		chainRouter: options.ChainRouter,
-		chainParser: options.ChainParser,
-		endpoint:    options.Endpoint,
-		cache:       options.Cache,
+		chainRouter:         options.ChainRouter,
+		chainParser:         options.ChainParser,
+		endpoint:            options.Endpoint,
+		cache:               options.Cache,
+		latestBlockAttempts: latestBlockAttempts,
+		latestBlockResetGap: options.LatestBlockResetGap,
+		verifyTimeout:       options.VerifyTimeout,
+
+		blockTimestampParser: options.BlockTimestampParser,
+		blockTimestampUnit:   options.BlockTimestampUnit,
+		disableCache:         options.DisableCache,
+		verificationCache:    options.VerificationCache,
+		strictVerifications:  options.StrictVerifications,
+		nodeInfoParsing:      options.NodeInfoParsing,
+		blockHashFormat:      options.BlockHashFormat,
+
+		finalizationSafetyMargin: options.FinalizationSafetyMargin,
 	}
 }
 
@@ -371,6 +1298,15 @@ func FormatResponseForParsing(reply *pairingtypes.RelayReply, chainMessage Chain
 
 type DummyChainFetcher struct {
 	*ChainFetcher
+	// VerifyHook, when set, is called after every Verify attempt made by Validate
+	VerifyHook func(verification VerificationContainer, attempt int, err error)
+	// LatestBlockNum and BlockHash are returned by FetchLatestBlockNum and
+	// FetchBlockHashByNum (for any block), so tests can drive deterministic blocks
+	LatestBlockNum int64
+	BlockHash      string
+	// StrictVerifications makes Validate fail for node URLs without verifications
+	// (see ChainFetcherOptions)
+	StrictVerifications bool
 }
 
 func (cf *DummyChainFetcher) Validate(ctx context.Context) error {
@@ -380,14 +1316,21 @@ func (cf *DummyChainFetcher) Validate(ctx context.Context) error {
 		if err != nil {
 			return err
 		}
-		if len(verifications) == 0 {
-			utils.LavaFormatDebug("no verifications for NodeUrl", utils.Attribute{Key: "url", Value: url.String()})
+		if err := validateUniqueVerificationNames(verifications); err != nil {
+			return utils.LavaFormatError("invalid verifications for NodeUrl", err, utils.Attribute{Key: "url", Value: url.String()}, utils.Attribute{Key: "Addons", Value: addons})
+		}
+		if err := checkHasVerifications(url, verifications, cf.StrictVerifications); err != nil {
+			return err
 		}
+		sortVerificationsByPriority(verifications)
 		for _, verification := range verifications {
 			// we give several chances for starting up
 			var err error
 			for attempts := 0; attempts < 3; attempts++ {
 				err = cf.Verify(ctx, verification, 0)
+				if cf.VerifyHook != nil {
+					cf.VerifyHook(verification, attempts+1, err)
+				}
 				if err == nil {
 					break
 				}
@@ -402,16 +1345,52 @@ func (cf *DummyChainFetcher) Validate(ctx context.Context) error {
 
 // overwrite this
 func (cf *DummyChainFetcher) FetchLatestBlockNum(ctx context.Context) (int64, error) {
-	return 0, nil
+	return cf.LatestBlockNum, nil
 }
 
 // overwrite this too
 func (cf *DummyChainFetcher) FetchBlockHashByNum(ctx context.Context, blockNum int64) (string, error) {
-	return "dummy", nil
+	return cf.BlockHash, nil
 }
 
 func NewVerificationsOnlyChainFetcher(ctx context.Context, chainRouter ChainRouter, chainParser ChainParser, endpoint *lavasession.RPCProviderEndpoint) *DummyChainFetcher {
 	cfi := ChainFetcher{chainRouter: chainRouter, chainParser: chainParser, endpoint: endpoint}
-	cf := &DummyChainFetcher{ChainFetcher: &cfi}
+	cf := &DummyChainFetcher{ChainFetcher: &cfi, BlockHash: "dummy"}
 	return cf
 }
+
+// VerificationRecorder records the Verify attempts of a DummyChainFetcher (set
+// its Record method as the VerifyHook), so tests can assert which verifications
+// were attempted and how many times
+type VerificationRecorder struct {
+	lock     sync.Mutex
+	attempts map[string]int
+	order    []string
+}
+
+func NewVerificationRecorder() *VerificationRecorder {
+	return &VerificationRecorder{attempts: map[string]int{}}
+}
+
+func (vr *VerificationRecorder) Record(verification VerificationContainer, attempt int, err error) {
+	vr.lock.Lock()
+	defer vr.lock.Unlock()
+	if _, ok := vr.attempts[verification.Name]; !ok {
+		vr.order = append(vr.order, verification.Name)
+	}
+	vr.attempts[verification.Name] = attempt
+}
+
+// Attempts returns the number of Verify attempts made for the named verification
+func (vr *VerificationRecorder) Attempts(name string) int {
+	vr.lock.Lock()
+	defer vr.lock.Unlock()
+	return vr.attempts[name]
+}
+
+// Verifications returns the names of the attempted verifications, in order
+func (vr *VerificationRecorder) Verifications() []string {
+	vr.lock.Lock()
+	defer vr.lock.Unlock()
+	return slices.Clone(vr.order)
+}
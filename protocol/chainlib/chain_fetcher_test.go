@@ -0,0 +1,1490 @@
+package chainlib
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/lavanet/lava/protocol/common"
+	"github.com/lavanet/lava/protocol/lavasession"
+	"github.com/lavanet/lava/protocol/performance"
+	keepertest "github.com/lavanet/lava/testutil/keeper"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	spectypes "github.com/lavanet/lava/x/spec/types"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/exp/slices"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func TestFetchLatestBlockNumRetry(t *testing.T) {
+	ctx := context.Background()
+	var calls int32
+	serverHandle := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		if atomic.AddInt32(&calls, 1) == 1 {
+			// first reply is garbage, the rest are valid
+			fmt.Fprint(w, `{"jsonrpc":"2.0","id":1,"result":"garbage"}`)
+			return
+		}
+		fmt.Fprint(w, `{"jsonrpc":"2.0","id":1,"result":"0x10a7a08"}`)
+	})
+
+	_, _, chainFetcher, closeServer, err := CreateChainLibMocks(ctx, "ETH1", spectypes.APIInterfaceJsonRPC, serverHandle, "../../", nil)
+	require.NoError(t, err)
+	defer func() {
+		if closeServer != nil {
+			closeServer()
+		}
+	}()
+	cf, ok := chainFetcher.(*ChainFetcher)
+	require.True(t, ok)
+
+	// default: no retry
+	_, err = cf.FetchLatestBlockNum(ctx)
+	require.Error(t, err)
+	require.NotZero(t, atomic.LoadInt32(&calls))
+
+	// with retries the message is re-sent until a valid reply arrives
+	atomic.StoreInt32(&calls, 0)
+	cf.latestBlockAttempts = 3
+	block, err := cf.FetchLatestBlockNum(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(0x10a7a08), block)
+	require.Equal(t, int32(2), atomic.LoadInt32(&calls))
+}
+
+func TestDummyChainFetcherRecordsVerifications(t *testing.T) {
+	ctx := context.Background()
+	serverHandle := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// a chain id that doesn't match the spec's expected value
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, `{"jsonrpc":"2.0","id":1,"result":"0x2"}`)
+	})
+
+	chainParser, chainRouter, chainFetcher, closeServer, err := CreateChainLibMocks(ctx, "ETH1", spectypes.APIInterfaceJsonRPC, serverHandle, "../../", nil)
+	require.NoError(t, err)
+	defer func() {
+		if closeServer != nil {
+			closeServer()
+		}
+	}()
+
+	endpoint := chainFetcher.FetchEndpoint()
+	dummyFetcher := NewVerificationsOnlyChainFetcher(ctx, chainRouter, chainParser, &endpoint)
+	recorder := NewVerificationRecorder()
+	dummyFetcher.VerifyHook = recorder.Record
+
+	err = dummyFetcher.Validate(ctx)
+	require.Error(t, err)
+	require.Equal(t, []string{"chain-id"}, recorder.Verifications())
+	require.Equal(t, 3, recorder.Attempts("chain-id"))
+}
+
+func TestDummyChainFetcherConfigurableBlocks(t *testing.T) {
+	ctx := context.Background()
+	serverHandle := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, `{"jsonrpc":"2.0","id":1,"result":"0x1"}`)
+	})
+
+	chainParser, chainRouter, chainFetcher, closeServer, err := CreateChainLibMocks(ctx, "ETH1", spectypes.APIInterfaceJsonRPC, serverHandle, "../../", nil)
+	require.NoError(t, err)
+	defer func() {
+		if closeServer != nil {
+			closeServer()
+		}
+	}()
+
+	endpoint := chainFetcher.FetchEndpoint()
+	dummyFetcher := NewVerificationsOnlyChainFetcher(ctx, chainRouter, chainParser, &endpoint)
+
+	// defaults
+	var fetcher ChainFetcherIf = dummyFetcher
+	block, err := fetcher.FetchLatestBlockNum(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(0), block)
+	hash, err := fetcher.FetchBlockHashByNum(ctx, 10)
+	require.NoError(t, err)
+	require.Equal(t, "dummy", hash)
+
+	dummyFetcher.LatestBlockNum = 500
+	dummyFetcher.BlockHash = "abc"
+	block, err = fetcher.FetchLatestBlockNum(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(500), block)
+	hash, err = fetcher.FetchBlockHashByNum(ctx, block)
+	require.NoError(t, err)
+	require.Equal(t, "abc", hash)
+}
+
+// verificationsChainParser overrides the verifications of the wrapped parser
+type verificationsChainParser struct {
+	ChainParser
+	verifications []VerificationContainer
+}
+
+func (vcp verificationsChainParser) GetVerifications(supported []string) ([]VerificationContainer, error) {
+	return append([]VerificationContainer{}, vcp.verifications...), nil
+}
+
+func TestValidateVerificationPriority(t *testing.T) {
+	ctx := context.Background()
+	serverHandle := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// a chain id that doesn't match the spec's expected value
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, `{"jsonrpc":"2.0","id":1,"result":"0x2"}`)
+	})
+
+	chainParser, chainRouter, chainFetcher, closeServer, err := CreateChainLibMocks(ctx, "ETH1", spectypes.APIInterfaceJsonRPC, serverHandle, "../../", nil)
+	require.NoError(t, err)
+	defer func() {
+		if closeServer != nil {
+			closeServer()
+		}
+	}()
+
+	verifications, err := chainParser.GetVerifications(nil)
+	require.NoError(t, err)
+	var chainIDVerification VerificationContainer
+	for _, verification := range verifications {
+		if verification.Name == "chain-id" {
+			chainIDVerification = verification
+		}
+	}
+	require.Equal(t, "chain-id", chainIDVerification.Name)
+
+	// all the verifications fail, the first high priority one aborts the validation
+	newVerification := func(name string, priority int) VerificationContainer {
+		verification := chainIDVerification
+		verification.Name = name
+		verification.Priority = priority
+		return verification
+	}
+	parser := verificationsChainParser{
+		ChainParser: chainParser,
+		verifications: []VerificationContainer{
+			newVerification("low", 0),
+			newVerification("high-1", 2),
+			newVerification("medium", 1),
+			newVerification("high-2", 2),
+		},
+	}
+
+	endpoint := chainFetcher.FetchEndpoint()
+	dummyFetcher := NewVerificationsOnlyChainFetcher(ctx, chainRouter, parser, &endpoint)
+	recorder := NewVerificationRecorder()
+	dummyFetcher.VerifyHook = recorder.Record
+
+	err = dummyFetcher.Validate(ctx)
+	require.Error(t, err)
+	require.Equal(t, []string{"high-1"}, recorder.Verifications())
+
+	// same priorities keep their order
+	sortVerificationsByPriority(parser.verifications)
+	names := []string{}
+	for _, verification := range parser.verifications {
+		names = append(names, verification.Name)
+	}
+	require.Equal(t, []string{"high-1", "high-2", "medium", "low"}, names)
+}
+
+func TestCraftVerificationData(t *testing.T) {
+	template := `{"jsonrpc":"2.0","method":"eth_getBlockByNumber","params":["{latest_block_hex}", false],"id":1}`
+	data, err := craftVerificationData(template, 1234)
+	require.NoError(t, err)
+	require.Equal(t, `{"jsonrpc":"2.0","method":"eth_getBlockByNumber","params":["0x4d2", false],"id":1}`, string(data))
+
+	data, err = craftVerificationData("/blocks/{latest_block}", 1234)
+	require.NoError(t, err)
+	require.Equal(t, "/blocks/1234", string(data))
+
+	// templates without arguments are left as is
+	data, err = craftVerificationData(`{"jsonrpc":"2.0","method":"eth_chainId","params":[],"id":1}`, 0)
+	require.NoError(t, err)
+	require.Equal(t, `{"jsonrpc":"2.0","method":"eth_chainId","params":[],"id":1}`, string(data))
+
+	// the latest block must be known to be substituted
+	_, err = craftVerificationData(template, 0)
+	require.Error(t, err)
+}
+
+func TestVerifyWithLatestBlockTemplate(t *testing.T) {
+	ctx := context.Background()
+	bodies := make(chan string, 1)
+	serverHandle := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		select {
+		case bodies <- string(body):
+		default:
+		}
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, `{"jsonrpc":"2.0","id":1,"result":"0x1"}`)
+	})
+
+	chainParser, _, chainFetcher, closeServer, err := CreateChainLibMocks(ctx, "ETH1", spectypes.APIInterfaceJsonRPC, serverHandle, "../../", nil)
+	require.NoError(t, err)
+	defer func() {
+		if closeServer != nil {
+			closeServer()
+		}
+	}()
+	cf, ok := chainFetcher.(*ChainFetcher)
+	require.True(t, ok)
+
+	verifications, err := chainParser.GetVerifications(nil)
+	require.NoError(t, err)
+	var verification VerificationContainer
+	for _, v := range verifications {
+		if v.Name == "chain-id" {
+			verification = v
+		}
+	}
+	require.Equal(t, "chain-id", verification.Name)
+	verification.ParseDirective.FunctionTemplate = `{"jsonrpc":"2.0","method":"eth_chainId","params":["{latest_block_hex}"],"id":1}`
+
+	err = cf.Verify(ctx, verification, 0x10a7a08)
+	require.NoError(t, err)
+	require.Contains(t, <-bodies, `"params":["0x10a7a08"]`)
+
+	// without a latest block the verification can't be crafted
+	err = cf.Verify(ctx, verification, 0)
+	require.Error(t, err)
+}
+
+func TestVerifyTimeout(t *testing.T) {
+	ctx := context.Background()
+	serverHandle := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// the node never answers in time
+		select {
+		case <-r.Context().Done():
+		case <-time.After(5 * time.Second):
+		}
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, `{"jsonrpc":"2.0","id":1,"result":"0x1"}`)
+	})
+
+	chainParser, _, chainFetcher, closeServer, err := CreateChainLibMocks(ctx, "ETH1", spectypes.APIInterfaceJsonRPC, serverHandle, "../../", nil)
+	require.NoError(t, err)
+	defer func() {
+		if closeServer != nil {
+			closeServer()
+		}
+	}()
+	cf, ok := chainFetcher.(*ChainFetcher)
+	require.True(t, ok)
+
+	verifications, err := chainParser.GetVerifications(nil)
+	require.NoError(t, err)
+	require.NotEmpty(t, verifications)
+	verification := verifications[0]
+	verification.Timeout = 200 * time.Millisecond
+
+	start := time.Now()
+	err = cf.Verify(ctx, verification, 0)
+	require.Error(t, err)
+	require.ErrorIs(t, err, common.VerificationTimeoutError)
+	require.Less(t, time.Since(start), 2*time.Second)
+
+	// the chain fetcher default applies when the verification has no timeout
+	verification.Timeout = 0
+	cf.verifyTimeout = 200 * time.Millisecond
+	start = time.Now()
+	err = cf.Verify(ctx, verification, 0)
+	require.ErrorIs(t, err, common.VerificationTimeoutError)
+	require.Less(t, time.Since(start), 2*time.Second)
+}
+
+func TestParseBlockTimestamp(t *testing.T) {
+	expected := time.Unix(1700000000, 0).UTC()
+
+	ts, err := parseBlockTimestamp("1700000000", BlockTimestampSeconds)
+	require.NoError(t, err)
+	require.Equal(t, expected, ts)
+
+	ts, err = parseBlockTimestamp("0x6553f100", BlockTimestampSeconds)
+	require.NoError(t, err)
+	require.Equal(t, expected, ts)
+
+	ts, err = parseBlockTimestamp("1700000000000", BlockTimestampMilliseconds)
+	require.NoError(t, err)
+	require.Equal(t, expected, ts)
+
+	ts, err = parseBlockTimestamp("2023-11-14T22:13:20Z", BlockTimestampSeconds)
+	require.NoError(t, err)
+	require.Equal(t, expected, ts)
+
+	_, err = parseBlockTimestamp("garbage", BlockTimestampSeconds)
+	require.Error(t, err)
+}
+
+func TestFetchBlockTimestampByNum(t *testing.T) {
+	playbook := []struct {
+		name      string
+		timestamp string
+		unit      BlockTimestampUnit
+	}{
+		{name: "seconds", timestamp: "0x6553f100", unit: BlockTimestampSeconds},
+		{name: "milliseconds", timestamp: "0x18bcfe56800", unit: BlockTimestampMilliseconds},
+	}
+	for _, play := range playbook {
+		t.Run(play.name, func(t *testing.T) {
+			ctx := context.Background()
+			serverHandle := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusOK)
+				fmt.Fprintf(w, `{"jsonrpc":"2.0","id":1,"result":{"hash":"0xabcd","number":"0x10","timestamp":"%s"}}`, play.timestamp)
+			})
+
+			_, _, chainFetcher, closeServer, err := CreateChainLibMocks(ctx, "ETH1", spectypes.APIInterfaceJsonRPC, serverHandle, "../../", nil)
+			require.NoError(t, err)
+			defer func() {
+				if closeServer != nil {
+					closeServer()
+				}
+			}()
+			cf, ok := chainFetcher.(*ChainFetcher)
+			require.True(t, ok)
+
+			// not configured
+			_, err = cf.FetchBlockTimestampByNum(ctx, 16)
+			require.Error(t, err)
+
+			cf.blockTimestampParser = &spectypes.BlockParser{
+				ParserArg:  []string{"0", "timestamp"},
+				ParserFunc: spectypes.PARSER_FUNC_PARSE_CANONICAL,
+			}
+			cf.blockTimestampUnit = play.unit
+			ts, err := cf.FetchBlockTimestampByNum(ctx, 16)
+			require.NoError(t, err)
+			require.Equal(t, time.Unix(1700000000, 0).UTC(), ts)
+		})
+	}
+}
+
+type countingCacheServer struct {
+	pairingtypes.UnimplementedRelayerCacheServer
+	sets int32
+}
+
+func (cs *countingCacheServer) SetRelay(ctx context.Context, in *pairingtypes.RelayCacheSet) (*emptypb.Empty, error) {
+	atomic.AddInt32(&cs.sets, 1)
+	return &emptypb.Empty{}, nil
+}
+
+func TestChainFetcherDisableCache(t *testing.T) {
+	ctx := context.Background()
+	serverHandle := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, `{"jsonrpc":"2.0","id":1,"result":{"hash":"0xabcd","number":"0x10"}}`)
+	})
+
+	_, _, chainFetcher, closeServer, err := CreateChainLibMocks(ctx, "ETH1", spectypes.APIInterfaceJsonRPC, serverHandle, "../../", nil)
+	require.NoError(t, err)
+	defer func() {
+		if closeServer != nil {
+			closeServer()
+		}
+	}()
+	cf, ok := chainFetcher.(*ChainFetcher)
+	require.True(t, ok)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	cacheServer := &countingCacheServer{}
+	grpcServer := grpc.NewServer()
+	pairingtypes.RegisterRelayerCacheServer(grpcServer, cacheServer)
+	go grpcServer.Serve(listener)
+	defer grpcServer.Stop()
+
+	cache, err := performance.InitCache(ctx, listener.Addr().String())
+	require.NoError(t, err)
+	cf.cache = cache
+	// a latest block far ahead makes the fetched block finalized, hence cacheable
+	cf.latestBlock.block = 1000
+
+	cf.disableCache = true
+	_, err = cf.FetchBlockHashByNum(ctx, 16)
+	require.NoError(t, err)
+	require.Equal(t, int32(0), atomic.LoadInt32(&cacheServer.sets))
+
+	// sanity: the same fetch is cached when the cache isn't disabled
+	cf.disableCache = false
+	_, err = cf.FetchBlockHashByNum(ctx, 16)
+	require.NoError(t, err)
+	require.Equal(t, int32(1), atomic.LoadInt32(&cacheServer.sets))
+}
+
+// finalizedRecordingCacheServer records the finalized flag of each cache write
+type finalizedRecordingCacheServer struct {
+	pairingtypes.UnimplementedRelayerCacheServer
+	lock      sync.Mutex
+	finalized []bool
+}
+
+func (cs *finalizedRecordingCacheServer) SetRelay(ctx context.Context, in *pairingtypes.RelayCacheSet) (*emptypb.Empty, error) {
+	cs.lock.Lock()
+	defer cs.lock.Unlock()
+	cs.finalized = append(cs.finalized, in.Finalized)
+	return &emptypb.Empty{}, nil
+}
+
+func (cs *finalizedRecordingCacheServer) last() bool {
+	cs.lock.Lock()
+	defer cs.lock.Unlock()
+	return cs.finalized[len(cs.finalized)-1]
+}
+
+func TestPopulateCacheRechecksFinalization(t *testing.T) {
+	ctx := context.Background()
+	// the node's latest block, as served to the block number requests
+	var latestBlock int64
+	serverHandle := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var request struct {
+			Method string `json:"method"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		if request.Method == "eth_blockNumber" {
+			fmt.Fprintf(w, `{"jsonrpc":"2.0","id":1,"result":"0x%x"}`, atomic.LoadInt64(&latestBlock))
+			return
+		}
+		fmt.Fprint(w, `{"jsonrpc":"2.0","id":1,"result":{"hash":"0xabcd","number":"0x10"}}`)
+	})
+
+	_, _, chainFetcher, closeServer, err := CreateChainLibMocks(ctx, "ETH1", spectypes.APIInterfaceJsonRPC, serverHandle, "../../", nil)
+	require.NoError(t, err)
+	defer func() {
+		if closeServer != nil {
+			closeServer()
+		}
+	}()
+	cf, ok := chainFetcher.(*ChainFetcher)
+	require.True(t, ok)
+	// let the reorg below reset the latest block
+	cf.latestBlockResetGap = 10
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	cacheServer := &finalizedRecordingCacheServer{}
+	grpcServer := grpc.NewServer()
+	pairingtypes.RegisterRelayerCacheServer(grpcServer, cacheServer)
+	go grpcServer.Serve(listener)
+	defer grpcServer.Stop()
+
+	cache, err := performance.InitCache(ctx, listener.Addr().String())
+	require.NoError(t, err)
+	cf.cache = cache
+
+	// a latest block far ahead makes the fetched block finalized
+	atomic.StoreInt64(&latestBlock, 1000)
+	fetched, err := cf.FetchLatestBlockNum(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(1000), fetched)
+	_, err = cf.FetchBlockHashByNum(ctx, 16)
+	require.NoError(t, err)
+	require.True(t, cacheServer.last())
+
+	// a reorg resets the node's latest block after the block was deemed finalized:
+	// it is still cached, but not as finalized
+	relayData := cf.constructRelayData("POST", "", nil, 16, "", nil)
+	reply := &pairingtypes.RelayReply{Data: []byte("reply")}
+	atomic.StoreInt64(&latestBlock, 17)
+	require.NoError(t, cf.populateCache(ctx, relayData, reply, []byte("0xabcd"), 16, true))
+	require.False(t, cacheServer.last())
+	require.Equal(t, int64(17), cf.getLatestBlock())
+
+	// the safety margin is required on top of the block distance to finalization
+	_, _, blockDistanceToFinalization, _ := cf.chainParser.ChainBlockStats()
+	atomic.StoreInt64(&latestBlock, 16+int64(blockDistanceToFinalization)+1)
+	require.NoError(t, cf.populateCache(ctx, relayData, reply, []byte("0xabcd"), 16, true))
+	require.True(t, cacheServer.last())
+	cf.finalizationSafetyMargin = 5
+	require.NoError(t, cf.populateCache(ctx, relayData, reply, []byte("0xabcd"), 16, true))
+	require.False(t, cacheServer.last())
+}
+
+func TestFetchLatestBlockNumNoRegression(t *testing.T) {
+	ctx := context.Background()
+	blocks := []int64{100, 101, 99, 102, 50}
+	var calls int32
+	serverHandle := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		i := atomic.AddInt32(&calls, 1) - 1
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, `{"jsonrpc":"2.0","id":1,"result":"0x%x"}`, blocks[int(i)%len(blocks)])
+	})
+
+	_, _, chainFetcher, closeServer, err := CreateChainLibMocks(ctx, "ETH1", spectypes.APIInterfaceJsonRPC, serverHandle, "../../", nil)
+	require.NoError(t, err)
+	defer func() {
+		if closeServer != nil {
+			closeServer()
+		}
+	}()
+	cf, ok := chainFetcher.(*ChainFetcher)
+	require.True(t, ok)
+
+	// 99 is ignored since it's behind the stored 101
+	for _, expected := range []int64{100, 101, 101, 102} {
+		block, err := cf.FetchLatestBlockNum(ctx)
+		require.NoError(t, err)
+		require.Equal(t, expected, block)
+		require.Equal(t, expected, cf.getLatestBlock())
+	}
+
+	// a gap larger than the reset gap is accepted
+	cf.latestBlockResetGap = 10
+	block, err := cf.FetchLatestBlockNum(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(50), block)
+	require.Equal(t, int64(50), cf.getLatestBlock())
+}
+
+func TestFetchLatestBlockNumConcurrent(t *testing.T) {
+	ctx := context.Background()
+	var calls int64
+	serverHandle := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		block := atomic.AddInt64(&calls, 1)
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, `{"jsonrpc":"2.0","id":1,"result":"0x%x"}`, block)
+	})
+
+	_, _, chainFetcher, closeServer, err := CreateChainLibMocks(ctx, "ETH1", spectypes.APIInterfaceJsonRPC, serverHandle, "../../", nil)
+	require.NoError(t, err)
+	defer func() {
+		if closeServer != nil {
+			closeServer()
+		}
+	}()
+	cf, ok := chainFetcher.(*ChainFetcher)
+	require.True(t, ok)
+
+	_, _, initialSuccesses := cf.LatestBlockInfo()
+	const goroutines = 20
+	const fetches = 10
+	var wg sync.WaitGroup
+	for i := 0; i < goroutines; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < fetches; j++ {
+				_, err := cf.FetchLatestBlockNum(ctx)
+				require.NoError(t, err)
+				// readers always see the block together with its update
+				block, updatedAt, successes := cf.LatestBlockInfo()
+				require.Positive(t, block)
+				require.False(t, updatedAt.IsZero())
+				require.Positive(t, successes)
+			}
+		}()
+	}
+	wg.Wait()
+
+	block, _, successes := cf.LatestBlockInfo()
+	require.Equal(t, initialSuccesses+goroutines*fetches, successes)
+	require.Equal(t, atomic.LoadInt64(&calls), block)
+}
+
+func TestFetchBlockHashByNumGrpc(t *testing.T) {
+	ctx := context.Background()
+	serverHandle := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})
+	_, _, chainFetcher, closeServer, err := CreateChainLibMocks(ctx, "LAV1", spectypes.APIInterfaceGrpc, serverHandle, "../../", nil)
+	require.NoError(t, err)
+	defer func() {
+		if closeServer != nil {
+			closeServer()
+		}
+	}()
+
+	// the protobuf encoded reply is decoded through the grpc message's parsing data
+	hash, err := chainFetcher.FetchBlockHashByNum(ctx, 16)
+	require.NoError(t, err)
+	require.Equal(t, base64.StdEncoding.EncodeToString(mockBlockHash(16)), hash)
+}
+
+func TestChainFetcherNodeURLs(t *testing.T) {
+	endpoint := &lavasession.RPCProviderEndpoint{
+		ChainID:      "ETH1",
+		ApiInterface: spectypes.APIInterfaceJsonRPC,
+		NodeUrls: []common.NodeUrl{
+			{Url: "http://node-2:8545"},
+			{Url: "ws://node-1:8546"},
+			{Url: "http://node-3:8545", Addons: []string{"archive"}},
+		},
+	}
+	cf := NewChainFetcher(context.Background(), &ChainFetcherOptions{Endpoint: endpoint})
+	require.Equal(t, []string{"http://node-2:8545", "ws://node-1:8546", "http://node-3:8545"}, cf.NodeURLs())
+	require.Equal(t, "http://node-2:8545", cf.PrimaryNodeURL())
+
+	cf = NewChainFetcher(context.Background(), &ChainFetcherOptions{Endpoint: &lavasession.RPCProviderEndpoint{}})
+	require.Empty(t, cf.NodeURLs())
+	require.Equal(t, "", cf.PrimaryNodeURL())
+}
+
+func TestRunVerificationByName(t *testing.T) {
+	ctx := context.Background()
+	serverHandle := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, `{"jsonrpc":"2.0","id":1,"result":"0x1"}`)
+	})
+
+	_, _, chainFetcher, closeServer, err := CreateChainLibMocks(ctx, "ETH1", spectypes.APIInterfaceJsonRPC, serverHandle, "../../", nil)
+	require.NoError(t, err)
+	defer func() {
+		if closeServer != nil {
+			closeServer()
+		}
+	}()
+	cf, ok := chainFetcher.(*ChainFetcher)
+	require.True(t, ok)
+
+	result, err := cf.RunVerificationByName(ctx, "chain-id")
+	require.NoError(t, err)
+	require.Equal(t, "chain-id", result.Name)
+	require.Equal(t, "0x1", result.ParsedResult)
+	require.Equal(t, "0x1", result.Expected)
+	require.Equal(t, uint64(1), result.LatestBlock)
+	require.Empty(t, result.Error)
+
+	_, err = cf.RunVerificationByName(ctx, "no-such-verification")
+	require.Error(t, err)
+}
+
+func TestFetchBlockHashByNumInvalidBlock(t *testing.T) {
+	ctx := context.Background()
+	var calls int32
+	serverHandle := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, `{"jsonrpc":"2.0","id":1,"result":{"hash":"0xabc"}}`)
+	})
+
+	_, _, chainFetcher, closeServer, err := CreateChainLibMocks(ctx, "ETH1", spectypes.APIInterfaceJsonRPC, serverHandle, "../../", nil)
+	require.NoError(t, err)
+	defer func() {
+		if closeServer != nil {
+			closeServer()
+		}
+	}()
+	cf, ok := chainFetcher.(*ChainFetcher)
+	require.True(t, ok)
+	cf.latestBlock.block = 100
+
+	// rejected before any node call
+	for _, blockNum := range []int64{-1, 100 + BlockNumFutureTolerance + 1, math.MaxInt64} {
+		_, err := cf.FetchBlockHashByNum(ctx, blockNum)
+		require.Error(t, err, blockNum)
+	}
+	require.Zero(t, atomic.LoadInt32(&calls))
+
+	// within the tolerance the node is queried
+	_, err = cf.FetchBlockHashByNum(ctx, 100+BlockNumFutureTolerance)
+	require.NoError(t, err)
+	require.NotZero(t, atomic.LoadInt32(&calls))
+}
+
+func TestVerificationJSONLog(t *testing.T) {
+	ctx := context.Background()
+	serverHandle := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, `{"jsonrpc":"2.0","id":1,"result":"0x1"}`)
+	})
+
+	_, _, chainFetcher, closeServer, err := CreateChainLibMocks(ctx, "ETH1", spectypes.APIInterfaceJsonRPC, serverHandle, "../../", nil)
+	require.NoError(t, err)
+	defer func() {
+		if closeServer != nil {
+			closeServer()
+		}
+	}()
+	cf, ok := chainFetcher.(*ChainFetcher)
+	require.True(t, ok)
+
+	var buf bytes.Buffer
+	VerificationJSONLog, verificationJSONLogWriter = true, &buf
+	defer func() {
+		VerificationJSONLog, verificationJSONLogWriter = false, os.Stderr
+	}()
+
+	_, err = cf.RunVerificationByName(ctx, "chain-id")
+	require.NoError(t, err)
+
+	lines := bytes.Split(bytes.TrimSpace(buf.Bytes()), []byte("\n"))
+	require.Len(t, lines, 1)
+	var entry map[string]string
+	require.NoError(t, json.Unmarshal(lines[0], &entry))
+	require.Equal(t, "chain-id", entry["verification"])
+	require.Equal(t, "success", entry["result"])
+	require.Equal(t, "0x1", entry["expected"])
+	require.Equal(t, "0x1", entry["got"])
+	require.NotEmpty(t, entry["nodeUrl"])
+	require.NotEmpty(t, entry["chainId"])
+	require.NotContains(t, entry, "error")
+}
+
+func TestFetchBlockHashesRange(t *testing.T) {
+	ctx := context.Background()
+	serverHandle := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var request struct {
+			Params []interface{} `json:"params"`
+		}
+		body, _ := io.ReadAll(r.Body)
+		_ = json.Unmarshal(body, &request)
+		blockHex := "0x0"
+		if len(request.Params) > 0 {
+			blockHex, _ = request.Params[0].(string)
+		}
+		w.WriteHeader(http.StatusOK)
+		// the block number doubles as its hash
+		fmt.Fprintf(w, `{"jsonrpc":"2.0","id":1,"result":{"hash":"%s"}}`, blockHex)
+	})
+
+	_, _, chainFetcher, closeServer, err := CreateChainLibMocks(ctx, "ETH1", spectypes.APIInterfaceJsonRPC, serverHandle, "../../", nil)
+	require.NoError(t, err)
+	defer func() {
+		if closeServer != nil {
+			closeServer()
+		}
+	}()
+	cf, ok := chainFetcher.(*ChainFetcher)
+	require.True(t, ok)
+
+	hashes, _, err := cf.FetchBlockHashesRange(ctx, 100, 149, 10)
+	require.NoError(t, err)
+	require.Len(t, hashes, 50)
+	for blockNum := int64(100); blockNum <= 149; blockNum++ {
+		// the hex hashes are returned base64 encoded
+		require.Equal(t, base64.StdEncoding.EncodeToString([]byte{byte(blockNum)}), hashes[blockNum])
+	}
+
+	// invalid blocks fail, the rest of the range is still returned
+	hashes, _, err = cf.FetchBlockHashesRange(ctx, -2, 2, 10)
+	require.Error(t, err)
+	require.Len(t, hashes, 3)
+
+	_, _, err = cf.FetchBlockHashesRange(ctx, 10, 9, 10)
+	require.Error(t, err)
+}
+
+// evenRejectingCacheServer fails the cache writes of even blocks
+type evenRejectingCacheServer struct {
+	pairingtypes.UnimplementedRelayerCacheServer
+	sets int32
+}
+
+func (cs *evenRejectingCacheServer) SetRelay(ctx context.Context, in *pairingtypes.RelayCacheSet) (*emptypb.Empty, error) {
+	if in.Request.RequestBlock%2 == 0 {
+		return nil, fmt.Errorf("cache full")
+	}
+	atomic.AddInt32(&cs.sets, 1)
+	return &emptypb.Empty{}, nil
+}
+
+func TestFetchBlockHashesRangeCacheResult(t *testing.T) {
+	ctx := context.Background()
+	serverHandle := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, `{"jsonrpc":"2.0","id":1,"result":{"hash":"0xabcd","number":"0x10"}}`)
+	})
+
+	_, _, chainFetcher, closeServer, err := CreateChainLibMocks(ctx, "ETH1", spectypes.APIInterfaceJsonRPC, serverHandle, "../../", nil)
+	require.NoError(t, err)
+	defer func() {
+		if closeServer != nil {
+			closeServer()
+		}
+	}()
+	cf, ok := chainFetcher.(*ChainFetcher)
+	require.True(t, ok)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	cacheServer := &evenRejectingCacheServer{}
+	grpcServer := grpc.NewServer()
+	pairingtypes.RegisterRelayerCacheServer(grpcServer, cacheServer)
+	go grpcServer.Serve(listener)
+	defer grpcServer.Stop()
+
+	cache, err := performance.InitCache(ctx, listener.Addr().String())
+	require.NoError(t, err)
+	cf.cache = cache
+	cf.latestBlock.block = 1000
+
+	// all the blocks are fetched, but half of them fail to be cached
+	hashes, cacheResult, err := cf.FetchBlockHashesRange(ctx, 100, 109, 4)
+	require.NoError(t, err)
+	require.Len(t, hashes, 10)
+	require.Equal(t, []int64{100, 102, 104, 106, 108}, cacheResult.Failed)
+	require.Equal(t, int32(5), atomic.LoadInt32(&cacheServer.sets))
+}
+
+func TestProbeNodeURL(t *testing.T) {
+	ctx := context.Background()
+	serverHandle := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var request struct {
+			Method string `json:"method"`
+		}
+		body, _ := io.ReadAll(r.Body)
+		_ = json.Unmarshal(body, &request)
+		result := `"0x"`
+		switch request.Method {
+		case "eth_chainId":
+			result = `"0x1"`
+		case "eth_blockNumber":
+			// far enough ahead for the pruning verification's latest distance
+			result = `"0x10000"`
+		case "eth_getBlockByNumber":
+			result = `{"number":"0x0","hash":"0xabc"}`
+		}
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, `{"jsonrpc":"2.0","id":1,"result":%s}`, result)
+	})
+
+	_, _, chainFetcher, closeServer, err := CreateChainLibMocks(ctx, "ETH1", spectypes.APIInterfaceJsonRPC, serverHandle, "../../", nil)
+	require.NoError(t, err)
+	defer func() {
+		if closeServer != nil {
+			closeServer()
+		}
+	}()
+	cf, ok := chainFetcher.(*ChainFetcher)
+	require.True(t, ok)
+
+	healthy := httptest.NewServer(serverHandle)
+	defer healthy.Close()
+	unhealthy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer unhealthy.Close()
+
+	require.NoError(t, cf.ProbeNodeURL(ctx, common.NodeUrl{Url: healthy.URL}))
+	require.Error(t, cf.ProbeNodeURL(ctx, common.NodeUrl{Url: unhealthy.URL}))
+
+	// the fetcher's own endpoint is untouched
+	require.Len(t, cf.NodeURLs(), 1)
+	require.NotEqual(t, healthy.URL, cf.PrimaryNodeURL())
+}
+
+func TestRevalidateFailed(t *testing.T) {
+	ctx := context.Background()
+	var chainIDFixed atomic.Bool
+	serverHandle := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var request struct {
+			Method string `json:"method"`
+		}
+		body, _ := io.ReadAll(r.Body)
+		_ = json.Unmarshal(body, &request)
+		w.WriteHeader(http.StatusOK)
+		switch request.Method {
+		case "eth_chainId":
+			if chainIDFixed.Load() {
+				fmt.Fprint(w, `{"jsonrpc":"2.0","id":1,"result":"0x1"}`)
+			} else {
+				fmt.Fprint(w, `{"jsonrpc":"2.0","id":1,"result":"0x2"}`)
+			}
+		case "eth_getCode":
+			fmt.Fprint(w, `not a json response`)
+		default:
+			fmt.Fprint(w, `{"jsonrpc":"2.0","id":1,"result":"0x1"}`)
+		}
+	})
+
+	_, _, chainFetcher, closeServer, err := CreateChainLibMocks(ctx, "ETH1", spectypes.APIInterfaceJsonRPC, serverHandle, "../../", nil)
+	require.NoError(t, err)
+	defer func() {
+		if closeServer != nil {
+			closeServer()
+		}
+	}()
+	cf, ok := chainFetcher.(*ChainFetcher)
+	require.True(t, ok)
+
+	failed := []string{"chain-id", "trustless-rpc"}
+	results, err := cf.RevalidateFailed(ctx, failed)
+	require.Error(t, err)
+	require.Len(t, results, 2)
+	for i, result := range results {
+		require.Equal(t, failed[i], result.Name)
+		require.NotEmpty(t, result.Error)
+	}
+	require.Equal(t, "0x2", results[0].ParsedResult)
+
+	// fix the node's chain ID and re-check only that verification
+	chainIDFixed.Store(true)
+	results, err = cf.RevalidateFailed(ctx, failed[:1])
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	require.Equal(t, "chain-id", results[0].Name)
+	require.Equal(t, "0x1", results[0].ParsedResult)
+	require.Empty(t, results[0].Error)
+
+	_, err = cf.RevalidateFailed(ctx, []string{"no-such-verification"})
+	require.Error(t, err)
+}
+
+func TestVerifyMaxResponseBytes(t *testing.T) {
+	ctx := context.Background()
+	serverHandle := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, `{"jsonrpc":"2.0","id":1,"result":"0x1"}`)
+	})
+
+	chainParser, _, chainFetcher, closeServer, err := CreateChainLibMocks(ctx, "ETH1", spectypes.APIInterfaceJsonRPC, serverHandle, "../../", nil)
+	require.NoError(t, err)
+	defer func() {
+		if closeServer != nil {
+			closeServer()
+		}
+	}()
+	cf, ok := chainFetcher.(*ChainFetcher)
+	require.True(t, ok)
+
+	verifications, err := chainParser.GetVerifications(nil)
+	require.NoError(t, err)
+	idx := slices.IndexFunc(verifications, func(v VerificationContainer) bool { return v.Name == "chain-id" })
+	require.GreaterOrEqual(t, idx, 0)
+	verification := verifications[idx]
+
+	// unlimited by default
+	require.NoError(t, cf.Verify(ctx, verification, 0))
+
+	verification.MaxResponseBytes = 1000
+	require.NoError(t, cf.Verify(ctx, verification, 0))
+
+	verification.MaxResponseBytes = 10
+	err = cf.Verify(ctx, verification, 0)
+	require.ErrorIs(t, err, common.VerificationResponseTooLargeError)
+}
+
+func TestValidateDuplicateVerificationNames(t *testing.T) {
+	ctx := context.Background()
+	serverHandle := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, `{"jsonrpc":"2.0","id":1,"result":"0x1"}`)
+	})
+
+	chainParser, chainRouter, chainFetcher, closeServer, err := CreateChainLibMocks(ctx, "ETH1", spectypes.APIInterfaceJsonRPC, serverHandle, "../../", nil)
+	require.NoError(t, err)
+	defer func() {
+		if closeServer != nil {
+			closeServer()
+		}
+	}()
+
+	verifications, err := chainParser.GetVerifications(nil)
+	require.NoError(t, err)
+	idx := slices.IndexFunc(verifications, func(v VerificationContainer) bool { return v.Name == "chain-id" })
+	require.GreaterOrEqual(t, idx, 0)
+	chainIDVerification := verifications[idx]
+
+	newVerification := func(name, extension string) VerificationContainer {
+		verification := chainIDVerification
+		verification.Name = name
+		verification.Extension = extension
+		return verification
+	}
+
+	// the same name on different extensions is allowed
+	require.NoError(t, validateUniqueVerificationNames([]VerificationContainer{
+		newVerification("pruning", ""),
+		newVerification("pruning", "archive"),
+	}))
+
+	parser := verificationsChainParser{
+		ChainParser: chainParser,
+		verifications: []VerificationContainer{
+			newVerification("dup-1", ""),
+			newVerification("unique", ""),
+			newVerification("dup-1", ""),
+			newVerification("dup-2", ""),
+			newVerification("dup-2", ""),
+			newVerification("dup-1", ""),
+		},
+	}
+
+	endpoint := chainFetcher.FetchEndpoint()
+	dummyFetcher := NewVerificationsOnlyChainFetcher(ctx, chainRouter, parser, &endpoint)
+	recorder := NewVerificationRecorder()
+	dummyFetcher.VerifyHook = recorder.Record
+
+	err = dummyFetcher.Validate(ctx)
+	require.ErrorContains(t, err, "duplicate verification names: dup-1, dup-2")
+	require.Empty(t, recorder.Verifications())
+}
+
+func TestWarmCache(t *testing.T) {
+	ctx := context.Background()
+	var lock sync.Mutex
+	requested := map[int64]struct{}{}
+	serverHandle := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var request struct {
+			Params []interface{} `json:"params"`
+		}
+		body, _ := io.ReadAll(r.Body)
+		_ = json.Unmarshal(body, &request)
+		if len(request.Params) > 0 {
+			if blockNum, ok := request.Params[0].(string); ok {
+				if num, err := strconv.ParseInt(blockNum, 0, 64); err == nil {
+					lock.Lock()
+					requested[num] = struct{}{}
+					lock.Unlock()
+				}
+			}
+		}
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, `{"jsonrpc":"2.0","id":1,"result":{"hash":"0xabcd","number":"0x10"}}`)
+	})
+
+	chainParser, _, chainFetcher, closeServer, err := CreateChainLibMocks(ctx, "ETH1", spectypes.APIInterfaceJsonRPC, serverHandle, "../../", nil)
+	require.NoError(t, err)
+	defer func() {
+		if closeServer != nil {
+			closeServer()
+		}
+	}()
+	cf, ok := chainFetcher.(*ChainFetcher)
+	require.True(t, ok)
+
+	// no cache: nothing to warm
+	require.NoError(t, cf.WarmCache(ctx, 5))
+	require.Empty(t, requested)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	cacheServer := &countingCacheServer{}
+	grpcServer := grpc.NewServer()
+	pairingtypes.RegisterRelayerCacheServer(grpcServer, cacheServer)
+	go grpcServer.Serve(listener)
+	defer grpcServer.Stop()
+
+	cache, err := performance.InitCache(ctx, listener.Addr().String())
+	require.NoError(t, err)
+	cf.cache = cache
+	cf.latestBlock.block = 1000
+
+	depth := 5
+	require.NoError(t, cf.WarmCache(ctx, depth))
+	require.Equal(t, int32(depth), atomic.LoadInt32(&cacheServer.sets))
+
+	// the last depth finalized blocks were fetched
+	_, _, blockDistanceToFinalization, _ := chainParser.ChainBlockStats()
+	lastFinalized := 1000 - int64(blockDistanceToFinalization)
+	lock.Lock()
+	defer lock.Unlock()
+	require.Len(t, requested, depth)
+	for blockNum := lastFinalized - int64(depth) + 1; blockNum <= lastFinalized; blockNum++ {
+		require.Contains(t, requested, blockNum)
+	}
+}
+
+func TestValidateVerificationCache(t *testing.T) {
+	ctx := context.Background()
+	var nodeCalls atomic.Int64
+	serverHandle := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		nodeCalls.Add(1)
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, `{"jsonrpc":"2.0","id":1,"result":"0x1"}`)
+	})
+
+	chainParser, chainRouter, chainFetcher, closeServer, err := CreateChainLibMocks(ctx, "ETH1", spectypes.APIInterfaceJsonRPC, serverHandle, "../../", nil)
+	require.NoError(t, err)
+	defer func() {
+		if closeServer != nil {
+			closeServer()
+		}
+	}()
+
+	verifications, err := chainParser.GetVerifications(nil)
+	require.NoError(t, err)
+	idx := slices.IndexFunc(verifications, func(v VerificationContainer) bool { return v.Name == "chain-id" })
+	require.GreaterOrEqual(t, idx, 0)
+	parser := verificationsChainParser{ChainParser: chainParser, verifications: verifications[idx : idx+1]}
+
+	endpoint := chainFetcher.FetchEndpoint()
+	cachePath := t.TempDir() + "/verifications.json"
+	newFetcher := func(endpoint lavasession.RPCProviderEndpoint) *ChainFetcher {
+		return NewChainFetcher(ctx, &ChainFetcherOptions{
+			ChainRouter:       chainRouter,
+			ChainParser:       parser,
+			Endpoint:          &endpoint,
+			VerificationCache: NewVerificationCache(time.Minute, cachePath),
+		})
+	}
+
+	require.NoError(t, newFetcher(endpoint).Validate(ctx))
+	require.NotZero(t, nodeCalls.Load())
+
+	// a restart within the TTL uses the persisted results, without querying the node
+	nodeCalls.Store(0)
+	require.NoError(t, newFetcher(endpoint).Validate(ctx))
+	require.Zero(t, nodeCalls.Load())
+
+	// changing the node url's addons invalidates its results
+	endpoint.NodeUrls = slices.Clone(endpoint.NodeUrls)
+	endpoint.NodeUrls[0].Addons = []string{"debug"}
+	require.NoError(t, newFetcher(endpoint).Validate(ctx))
+	require.NotZero(t, nodeCalls.Load())
+
+	// an expired result is verified again
+	nodeCalls.Store(0)
+	expired := NewChainFetcher(ctx, &ChainFetcherOptions{
+		ChainRouter:       chainRouter,
+		ChainParser:       parser,
+		Endpoint:          &endpoint,
+		VerificationCache: NewVerificationCache(time.Nanosecond, cachePath),
+	})
+	require.NoError(t, expired.Validate(ctx))
+	require.NotZero(t, nodeCalls.Load())
+}
+
+func TestValidateStrictVerifications(t *testing.T) {
+	ctx := context.Background()
+	serverHandle := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, `{"jsonrpc":"2.0","id":1,"result":"0x1"}`)
+	})
+
+	chainParser, chainRouter, chainFetcher, closeServer, err := CreateChainLibMocks(ctx, "ETH1", spectypes.APIInterfaceJsonRPC, serverHandle, "../../", nil)
+	require.NoError(t, err)
+	defer func() {
+		if closeServer != nil {
+			closeServer()
+		}
+	}()
+
+	// a spec without verifications
+	parser := verificationsChainParser{ChainParser: chainParser}
+	endpoint := chainFetcher.FetchEndpoint()
+	newFetcher := func(endpoint lavasession.RPCProviderEndpoint, strict bool) *ChainFetcher {
+		return NewChainFetcher(ctx, &ChainFetcherOptions{
+			ChainRouter:         chainRouter,
+			ChainParser:         parser,
+			Endpoint:            &endpoint,
+			StrictVerifications: strict,
+		})
+	}
+
+	// lenient by default
+	require.NoError(t, newFetcher(endpoint, false).Validate(ctx))
+	require.Error(t, newFetcher(endpoint, true).Validate(ctx))
+
+	dummyFetcher := NewVerificationsOnlyChainFetcher(ctx, chainRouter, parser, &endpoint)
+	require.NoError(t, dummyFetcher.Validate(ctx))
+	dummyFetcher.StrictVerifications = true
+	require.Error(t, dummyFetcher.Validate(ctx))
+
+	// a node url that skips verifications is allowed to have none
+	endpoint.NodeUrls = slices.Clone(endpoint.NodeUrls)
+	endpoint.NodeUrls[0].SkipVerifications = []string{"chain-id"}
+	require.NoError(t, newFetcher(endpoint, true).Validate(ctx))
+}
+
+func TestFetchNodeInfo(t *testing.T) {
+	ctx := context.Background()
+	serverHandle := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var request struct {
+			Method string `json:"method"`
+		}
+		body, _ := io.ReadAll(r.Body)
+		_ = json.Unmarshal(body, &request)
+		w.WriteHeader(http.StatusOK)
+		switch request.Method {
+		case "web3_clientVersion":
+			fmt.Fprint(w, `{"jsonrpc":"2.0","id":1,"result":"Geth/v1.13.5-stable/linux-amd64/go1.21.4"}`)
+		case "net_peerCount":
+			fmt.Fprint(w, `{"jsonrpc":"2.0","id":1,"result":"0x19"}`)
+		default:
+			fmt.Fprint(w, `{"jsonrpc":"2.0","id":1,"result":"0x1"}`)
+		}
+	})
+
+	chainParser, chainRouter, chainFetcher, closeServer, err := CreateChainLibMocks(ctx, "ETH1", spectypes.APIInterfaceJsonRPC, serverHandle, "../../", nil)
+	require.NoError(t, err)
+	defer func() {
+		if closeServer != nil {
+			closeServer()
+		}
+	}()
+
+	// chains lacking the node info parsing report it as not applicable
+	cf, ok := chainFetcher.(*ChainFetcher)
+	require.True(t, ok)
+	info, err := cf.FetchNodeInfo(ctx)
+	require.NoError(t, err)
+	require.Empty(t, info.Version)
+	require.Equal(t, int64(spectypes.NOT_APPLICABLE), info.PeerCount)
+
+	// build the queries like the chain-id verification, which parses the "result"
+	verifications, err := chainParser.GetVerifications(nil)
+	require.NoError(t, err)
+	idx := slices.IndexFunc(verifications, func(v VerificationContainer) bool { return v.Name == "chain-id" })
+	require.GreaterOrEqual(t, idx, 0)
+	newQuery := func(method string) *NodeInfoQuery {
+		query := &NodeInfoQuery{
+			ConnectionType: verifications[idx].ConnectionType,
+			ParseDirective: verifications[idx].ParseDirective,
+		}
+		query.ParseDirective.ApiName = method
+		query.ParseDirective.FunctionTemplate = `{"jsonrpc":"2.0","method":"` + method + `","params":[],"id":1}`
+		return query
+	}
+
+	endpoint := chainFetcher.FetchEndpoint()
+	cf = NewChainFetcher(ctx, &ChainFetcherOptions{
+		ChainRouter: chainRouter,
+		ChainParser: chainParser,
+		Endpoint:    &endpoint,
+		NodeInfoParsing: &NodeInfoParsing{
+			Version:   newQuery("web3_clientVersion"),
+			PeerCount: newQuery("net_peerCount"),
+		},
+	})
+	info, err = cf.FetchNodeInfo(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "Geth/v1.13.5-stable/linux-amd64/go1.21.4", info.Version)
+	require.Equal(t, int64(25), info.PeerCount)
+}
+
+func TestVerifyLatestDistanceSanity(t *testing.T) {
+	ctx := context.Background()
+	serverHandle := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, `{"jsonrpc":"2.0","id":1,"result":"0x1"}`)
+	})
+
+	chainParser, _, chainFetcher, closeServer, err := CreateChainLibMocks(ctx, "ETH1", spectypes.APIInterfaceJsonRPC, serverHandle, "../../", nil)
+	require.NoError(t, err)
+	defer func() {
+		if closeServer != nil {
+			closeServer()
+		}
+	}()
+	cf, ok := chainFetcher.(*ChainFetcher)
+	require.True(t, ok)
+
+	verifications, err := chainParser.GetVerifications(nil)
+	require.NoError(t, err)
+	idx := slices.IndexFunc(verifications, func(v VerificationContainer) bool { return v.Name == "chain-id" })
+	require.GreaterOrEqual(t, idx, 0)
+	verification := verifications[idx]
+
+	// zero disables the check
+	alwaysFails, warning := checkLatestDistance(verification, 100)
+	require.False(t, alwaysFails)
+	require.NoError(t, warning)
+
+	// sane distance
+	verification.LatestDistance = 10
+	alwaysFails, warning = checkLatestDistance(verification, 100)
+	require.False(t, alwaysFails)
+	require.NoError(t, warning)
+	require.NoError(t, cf.Verify(ctx, verification, 100))
+
+	// suspicious, but can still pass
+	verification.LatestDistance = 60
+	alwaysFails, warning = checkLatestDistance(verification, 100)
+	require.False(t, alwaysFails)
+	require.Error(t, warning)
+
+	// absurd, the check can never pass
+	verification.LatestDistance = 1_000_000_000
+	alwaysFails, warning = checkLatestDistance(verification, 100)
+	require.True(t, alwaysFails)
+	require.ErrorContains(t, warning, "implausibly large")
+	err = cf.Verify(ctx, verification, 100)
+	require.ErrorContains(t, err, "can never pass")
+}
+
+func TestNormalizeBlockHash(t *testing.T) {
+	playbook := []struct {
+		name       string
+		hash       string
+		prefixed   string
+		unprefixed string
+	}{
+		{name: "prefixed lowercase", hash: "0xabcd12", prefixed: "0xabcd12", unprefixed: "abcd12"},
+		{name: "prefixed uppercase", hash: "0XABCD12", prefixed: "0xabcd12", unprefixed: "abcd12"},
+		{name: "unprefixed mixed case", hash: "AbCd12", prefixed: "0xabcd12", unprefixed: "abcd12"},
+		{name: "base64", hash: "q80S+w==", prefixed: "q80S+w==", unprefixed: "q80S+w=="},
+		{name: "empty", hash: "", prefixed: "", unprefixed: ""},
+	}
+	for _, play := range playbook {
+		t.Run(play.name, func(t *testing.T) {
+			require.Equal(t, play.hash, normalizeBlockHash(play.hash, BlockHashFormatRaw))
+			require.Equal(t, play.prefixed, normalizeBlockHash(play.hash, BlockHashFormatHexPrefixed))
+			require.Equal(t, play.unprefixed, normalizeBlockHash(play.hash, BlockHashFormatHexUnprefixed))
+		})
+	}
+}
+
+func TestFetchBlockHashByNumNormalized(t *testing.T) {
+	ctx := context.Background()
+	for _, rawHash := range []string{"0xABCDEF01", "abcdef01"} {
+		t.Run(rawHash, func(t *testing.T) {
+			serverHandle := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusOK)
+				fmt.Fprintf(w, `{"jsonrpc":"2.0","id":1,"result":{"block_hash":"%s","block_number":16}}`, rawHash)
+			})
+
+			// starknet's block hashes are returned as is (unlike ethereum's, which are base64 encoded)
+			_, _, chainFetcher, closeServer, err := CreateChainLibMocks(ctx, "STRK", spectypes.APIInterfaceJsonRPC, serverHandle, "../../", nil)
+			require.NoError(t, err)
+			defer func() {
+				if closeServer != nil {
+					closeServer()
+				}
+			}()
+			cf, ok := chainFetcher.(*ChainFetcher)
+			require.True(t, ok)
+
+			hash, err := cf.FetchBlockHashByNum(ctx, 16)
+			require.NoError(t, err)
+			require.Equal(t, rawHash, hash)
+
+			cf.blockHashFormat = BlockHashFormatHexPrefixed
+			hash, err = cf.FetchBlockHashByNum(ctx, 16)
+			require.NoError(t, err)
+			require.Equal(t, "0xabcdef01", hash)
+			hashes, _, err := cf.FetchBlockHashesRange(ctx, 16, 17, 2)
+			require.NoError(t, err)
+			require.Equal(t, map[int64]string{16: "0xabcdef01", 17: "0xabcdef01"}, hashes)
+
+			cf.blockHashFormat = BlockHashFormatHexUnprefixed
+			hash, err = cf.FetchBlockHashByNum(ctx, 16)
+			require.NoError(t, err)
+			require.Equal(t, "abcdef01", hash)
+
+			// the raw form is preserved
+			hash, err = cf.FetchRawBlockHashByNum(ctx, 16)
+			require.NoError(t, err)
+			require.Equal(t, rawHash, hash)
+		})
+	}
+}
+
+func TestValidateChainIDMismatchSeverity(t *testing.T) {
+	ctx := context.Background()
+	// a node of another chain: everything but the chain ID verifies
+	serverHandle := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var request struct {
+			Method string `json:"method"`
+		}
+		body, _ := io.ReadAll(r.Body)
+		_ = json.Unmarshal(body, &request)
+		result := `"0x"`
+		switch request.Method {
+		case "eth_chainId":
+			result = `"0x2"`
+		case "eth_blockNumber":
+			// far enough ahead for the pruning verification's latest distance
+			result = `"0x10000"`
+		case "eth_getBlockByNumber":
+			result = `{"number":"0x0","hash":"0xabc"}`
+		}
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, `{"jsonrpc":"2.0","id":1,"result":%s}`, result)
+	})
+
+	for _, tt := range []struct {
+		name     string
+		severity spectypes.ParseValue_VerificationSeverity
+		valid    bool
+	}{
+		{"fail", spectypes.ParseValue_Fail, false},
+		{"warning", spectypes.ParseValue_Warning, true},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			chainParser, _, chainFetcher, closeServer, err := CreateChainLibMocks(ctx, "ETH1", spectypes.APIInterfaceJsonRPC, serverHandle, "../../", nil)
+			require.NoError(t, err)
+			defer func() {
+				if closeServer != nil {
+					closeServer()
+				}
+			}()
+
+			spec, err := keepertest.GetASpec("ETH1", "../../", nil, nil)
+			require.NoError(t, err)
+			found := false
+			for _, apiCollection := range spec.ApiCollections {
+				for _, verification := range apiCollection.Verifications {
+					if verification.Name != "chain-id" {
+						continue
+					}
+					for _, value := range verification.Values {
+						value.Severity = tt.severity
+						found = true
+					}
+				}
+			}
+			require.True(t, found)
+			chainParser.SetSpec(spec)
+			cf, ok := chainFetcher.(*ChainFetcher)
+			require.True(t, ok)
+
+			err = cf.Validate(ctx)
+			if tt.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestVerifyWithRetriesKeepsAttemptErrors(t *testing.T) {
+	ctx := context.Background()
+	// the node first fails, then answers with the wrong chain ID
+	var calls atomic.Int32
+	serverHandle := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) == 1 {
+			w.WriteHeader(http.StatusOK)
+			fmt.Fprint(w, `not a json response`)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, `{"jsonrpc":"2.0","id":1,"result":"0x2"}`)
+	})
+
+	chainParser, _, chainFetcher, closeServer, err := CreateChainLibMocks(ctx, "ETH1", spectypes.APIInterfaceJsonRPC, serverHandle, "../../", nil)
+	require.NoError(t, err)
+	defer func() {
+		if closeServer != nil {
+			closeServer()
+		}
+	}()
+	cf, ok := chainFetcher.(*ChainFetcher)
+	require.True(t, ok)
+
+	verifications, err := chainParser.GetVerifications(nil)
+	require.NoError(t, err)
+	idx := slices.IndexFunc(verifications, func(v VerificationContainer) bool { return v.Name == "chain-id" })
+	require.GreaterOrEqual(t, idx, 0)
+
+	err = cf.verifyWithRetries(ctx, verifications[idx], 0, 3)
+	require.Error(t, err)
+	require.Equal(t, int32(3), calls.Load())
+	require.ErrorContains(t, err, "attempt 1: [-] verify failed sending chainMessage")
+	require.ErrorContains(t, err, "attempt 2: [-] verify failed expected and received are different")
+	require.ErrorContains(t, err, "attempt 3: [-] verify failed expected and received are different")
+
+	// a passing attempt clears the earlier failures
+	calls.Store(0)
+	verification := verifications[idx]
+	verification.Value = "0x2"
+	require.NoError(t, cf.verifyWithRetries(ctx, verification, 0, 3))
+}
@@ -6,6 +6,7 @@ import (
 	"net"
 	"net/http"
 	"net/url"
+	"sort"
 	"strings"
 	"time"
 
@@ -31,15 +32,40 @@ type VerificationKey struct {
 }
 
 type VerificationContainer struct {
-	ConnectionType string
-	Name           string
-	ParseDirective spectypes.ParseDirective
-	Value          string
-	LatestDistance uint64
-	Severity       spectypes.ParseValue_VerificationSeverity
+	ConnectionType   string
+	Name             string
+	ParseDirective   spectypes.ParseDirective
+	Value            string
+	LatestDistance   uint64
+	Severity         spectypes.ParseValue_VerificationSeverity
+	Timeout          time.Duration // zero means the chain fetcher's default
+	Priority         int           // higher priorities are verified first
+	MaxResponseBytes int           // zero means unlimited
 	VerificationKey
 }
 
+// verification priorities: failing verifications are the critical ones, so they are
+// verified first and startup fails fast on them
+const (
+	VerificationPriorityWarning = 0
+	VerificationPriorityFail    = 1
+)
+
+func verificationPriority(severity spectypes.ParseValue_VerificationSeverity) int {
+	if severity == spectypes.ParseValue_Fail {
+		return VerificationPriorityFail
+	}
+	return VerificationPriorityWarning
+}
+
+// sortVerificationsByPriority sorts the verifications by descending priority,
+// keeping the spec's order for verifications of the same priority
+func sortVerificationsByPriority(verifications []VerificationContainer) {
+	sort.SliceStable(verifications, func(i, j int) bool {
+		return verifications[i].Priority > verifications[j].Priority
+	})
+}
+
 type TaggedContainer struct {
 	Parsing       *spectypes.ParseDirective
 	ApiCollection *spectypes.ApiCollection
@@ -2,6 +2,7 @@ package chainlib
 
 import (
 	"context"
+	"fmt"
 	"net"
 	"net/http"
 	"net/http/httptest"
@@ -68,6 +69,18 @@ func (bbb myServiceImplementation) GetLatestBlock(ctx context.Context, reqIn *tm
 	return &tmservice.GetLatestBlockResponse{Block: &types.Block{Header: types.Header{Height: int64(num)}}}, nil
 }
 
+func (bbb myServiceImplementation) GetBlockByHeight(ctx context.Context, reqIn *tmservice.GetBlockByHeightRequest) (*tmservice.GetBlockByHeightResponse, error) {
+	return &tmservice.GetBlockByHeightResponse{
+		BlockId: &types.BlockID{Hash: mockBlockHash(reqIn.Height)},
+		Block:   &types.Block{Header: types.Header{Height: reqIn.Height}},
+	}, nil
+}
+
+// mockBlockHash is the block hash the mock gRPC service returns for a height
+func mockBlockHash(height int64) []byte {
+	return []byte(fmt.Sprintf("block-hash-%d", height))
+}
+
 func generateCombinations(arr []string) [][]string {
 	if len(arr) == 0 {
 		return [][]string{{}}
@@ -149,7 +162,7 @@ func CreateChainLibMocks(ctx context.Context, specIndex string, apiInterface str
 			return nil, nil, nil, closeServer, err
 		}
 	}
-	chainFetcher := NewChainFetcher(ctx, &ChainFetcherOptions{chainRouter, chainParser, endpoint, nil})
+	chainFetcher := NewChainFetcher(ctx, &ChainFetcherOptions{ChainRouter: chainRouter, ChainParser: chainParser, Endpoint: endpoint, Cache: nil})
 	return chainParser, chainRouter, chainFetcher, closeServer, err
 }
 
@@ -0,0 +1,140 @@
+package chainlib
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/lavanet/lava/utils"
+	"golang.org/x/exp/slices"
+)
+
+const (
+	VerificationCacheTTLFlagName  = "verification-cache-ttl"
+	VerificationCachePathFlagName = "verification-cache-path"
+)
+
+var (
+	// VerificationCacheTTL is how long a successful verification result is reused
+	// by Validate instead of re-verifying against the node (zero disables the cache)
+	VerificationCacheTTL time.Duration = 0
+	// VerificationCachePath is the file the verification cache is persisted to, so
+	// its results survive a provider restart (empty keeps the cache in memory only)
+	VerificationCachePath = ""
+)
+
+// VerificationCache keeps the successful verification results of Validate for a
+// TTL, so a quick restart can skip re-verifying items that didn't change. Results
+// are keyed by the endpoint's chain and API interface, the node URL, the
+// verification key (extension and addon) and the verification's name, and a node
+// URL's results are dropped when its addons change.
+type VerificationCache struct {
+	lock sync.Mutex
+	ttl  time.Duration
+	path string
+	data verificationCacheData
+}
+
+type verificationCacheData struct {
+	Passed map[string]time.Time `json:"passed"` // verification cache key -> verified at
+	Addons map[string]string    `json:"addons"` // node url key -> its addons
+}
+
+// NewVerificationCache creates a verification cache. If path is set, the cache is
+// loaded from it (a missing or unreadable file starts an empty cache) and every
+// change is written back to it.
+func NewVerificationCache(ttl time.Duration, path string) *VerificationCache {
+	vc := &VerificationCache{
+		ttl:  ttl,
+		path: path,
+		data: verificationCacheData{Passed: map[string]time.Time{}, Addons: map[string]string{}},
+	}
+	if path == "" {
+		return vc
+	}
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		if !errors.Is(err, os.ErrNotExist) {
+			utils.LavaFormatWarning("failed reading verification cache, starting empty", err, utils.LogAttr("path", path))
+		}
+		return vc
+	}
+	var data verificationCacheData
+	if err := json.Unmarshal(raw, &data); err != nil {
+		utils.LavaFormatWarning("failed parsing verification cache, starting empty", err, utils.LogAttr("path", path))
+		return vc
+	}
+	if data.Passed != nil {
+		vc.data.Passed = data.Passed
+	}
+	if data.Addons != nil {
+		vc.data.Addons = data.Addons
+	}
+	return vc
+}
+
+func verificationCacheURLKey(chainID, apiInterface, url string) string {
+	return strings.Join([]string{chainID, apiInterface, url}, " ")
+}
+
+func verificationCacheKey(urlKey string, verification VerificationContainer) string {
+	return strings.Join([]string{urlKey, verification.Extension, verification.Addon, verification.Name}, " ")
+}
+
+// SetAddons records the node URL's addons, dropping its cached results if they
+// differ from the addons it was last validated with
+func (vc *VerificationCache) SetAddons(chainID, apiInterface, url string, addons []string) {
+	sorted := slices.Clone(addons)
+	slices.Sort(sorted)
+	joined := strings.Join(sorted, ",")
+
+	vc.lock.Lock()
+	defer vc.lock.Unlock()
+	urlKey := verificationCacheURLKey(chainID, apiInterface, url)
+	if previous, ok := vc.data.Addons[urlKey]; ok && previous == joined {
+		return
+	}
+	for key := range vc.data.Passed {
+		if strings.HasPrefix(key, urlKey+" ") {
+			delete(vc.data.Passed, key)
+		}
+	}
+	vc.data.Addons[urlKey] = joined
+	vc.persist()
+}
+
+// Passed returns whether the verification passed on the node URL within the TTL
+func (vc *VerificationCache) Passed(chainID, apiInterface, url string, verification VerificationContainer) bool {
+	vc.lock.Lock()
+	defer vc.lock.Unlock()
+	key := verificationCacheKey(verificationCacheURLKey(chainID, apiInterface, url), verification)
+	verifiedAt, ok := vc.data.Passed[key]
+	return ok && time.Since(verifiedAt) < vc.ttl
+}
+
+// Store records that the verification passed on the node URL
+func (vc *VerificationCache) Store(chainID, apiInterface, url string, verification VerificationContainer) {
+	vc.lock.Lock()
+	defer vc.lock.Unlock()
+	key := verificationCacheKey(verificationCacheURLKey(chainID, apiInterface, url), verification)
+	vc.data.Passed[key] = time.Now()
+	vc.persist()
+}
+
+// persist writes the cache to its file, if it has one. Failing to persist only
+// costs re-verifying after a restart, so it is logged and otherwise ignored.
+func (vc *VerificationCache) persist() {
+	if vc.path == "" {
+		return
+	}
+	raw, err := json.Marshal(vc.data)
+	if err == nil {
+		err = os.WriteFile(vc.path, raw, 0o600)
+	}
+	if err != nil {
+		utils.LavaFormatWarning("failed persisting verification cache", err, utils.LogAttr("path", vc.path))
+	}
+}
@@ -121,6 +121,7 @@ type RPCProvider struct {
 	cache                  *performance.Cache
 	shardID                uint // shardID is a flag that allows setting up multiple provider databases of the same chain
 	chainTrackers          *ChainTrackers
+	verificationCache      *chainlib.VerificationCache
 }
 
 func (rpcp *RPCProvider) Start(options *rpcProviderStartOptions) (err error) {
@@ -134,6 +135,9 @@ func (rpcp *RPCProvider) Start(options *rpcProviderStartOptions) (err error) {
 	rpcp.chainTrackers = &ChainTrackers{}
 	rpcp.parallelConnections = options.parallelConnections
 	rpcp.cache = options.cache
+	if chainlib.VerificationCacheTTL > 0 {
+		rpcp.verificationCache = chainlib.NewVerificationCache(chainlib.VerificationCacheTTL, chainlib.VerificationCachePath)
+	}
 	rpcp.providerMetricsManager = metrics.NewProviderMetricsManager(options.metricsListenAddress) // start up prometheus metrics
 	rpcp.providerMetricsManager.SetVersion(upgrade.GetCurrentVersion().ProviderVersion)
 	rpcp.rpcProviderListeners = make(map[string]*ProviderListener)
@@ -350,14 +354,18 @@ func (rpcp *RPCProvider) SetupEndpoint(ctx context.Context, rpcProviderEndpoint
 			chainFetcher = chainlib.NewChainFetcher(
 				ctx,
 				&chainlib.ChainFetcherOptions{
-					ChainRouter: chainRouter,
-					ChainParser: chainParser,
-					Endpoint:    rpcProviderEndpoint,
-					Cache:       rpcp.cache,
+					ChainRouter:         chainRouter,
+					ChainParser:         chainParser,
+					Endpoint:            rpcProviderEndpoint,
+					Cache:               rpcp.cache,
+					VerificationCache:   rpcp.verificationCache,
+					StrictVerifications: chainlib.StrictVerifications,
 				},
 			)
 		} else {
-			chainFetcher = chainlib.NewVerificationsOnlyChainFetcher(ctx, chainRouter, chainParser, rpcProviderEndpoint)
+			verificationsOnlyFetcher := chainlib.NewVerificationsOnlyChainFetcher(ctx, chainRouter, chainParser, rpcProviderEndpoint)
+			verificationsOnlyFetcher.StrictVerifications = chainlib.StrictVerifications
+			chainFetcher = verificationsOnlyFetcher
 		}
 
 		// Add the chain fetcher to the spec validator
@@ -666,6 +674,10 @@ rpcprovider 127.0.0.1:3333 COS3 tendermintrpc "wss://www.node-path.com:80,https:
 	cmdRPCProvider.Flags().Uint(rewardserver.RewardsSnapshotThresholdFlagName, rewardserver.DefaultRewardsSnapshotThreshold, "the number of rewards to wait until making snapshot of the rewards memory")
 	cmdRPCProvider.Flags().Uint(rewardserver.RewardsSnapshotTimeoutSecFlagName, rewardserver.DefaultRewardsSnapshotTimeoutSec, "the seconds to wait until making snapshot of the rewards memory")
 	cmdRPCProvider.Flags().String(StickinessHeaderName, RPCProviderStickinessHeaderName, "the name of the header to be attacked to requests for stickiness by consumer, used for consistency")
+	cmdRPCProvider.Flags().BoolVar(&chainlib.VerificationJSONLog, chainlib.VerificationJSONLogFlagName, false, "additionally log each verification outcome as a single JSON line, for log aggregators")
+	cmdRPCProvider.Flags().DurationVar(&chainlib.VerificationCacheTTL, chainlib.VerificationCacheTTLFlagName, chainlib.VerificationCacheTTL, "reuse successful verification results for this long instead of re-verifying them on startup (0 disables)")
+	cmdRPCProvider.Flags().BoolVar(&chainlib.StrictVerifications, chainlib.StrictVerificationsFlagName, false, "fail the validation of node urls that have no verifications (unless they skip verifications), to catch spec mistakes")
+	cmdRPCProvider.Flags().StringVar(&chainlib.VerificationCachePath, chainlib.VerificationCachePathFlagName, chainlib.VerificationCachePath, "the file to persist the verification results cache to, so it survives restarts")
 	cmdRPCProvider.Flags().Uint64Var(&chaintracker.PollingMultiplier, chaintracker.PollingMultiplierFlagName, 1, "when set, forces the chain tracker to poll more often, improving the sync at the cost of more queries")
 	cmdRPCProvider.Flags().DurationVar(&SpecValidationInterval, SpecValidationIntervalFlagName, SpecValidationInterval, "determines the interval of which to run validation on the spec for all connected chains")
 	cmdRPCProvider.Flags().DurationVar(&SpecValidationIntervalDisabledChains, SpecValidationIntervalDisabledChainsFlagName, SpecValidationIntervalDisabledChains, "determines the interval of which to run validation on the spec for all disabled chains, determines recovery time")
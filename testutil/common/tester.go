@@ -456,6 +456,58 @@ func (ts *Tester) TxDualstakingClaimRewards(
 	return ts.Servers.DualstakingServer.ClaimRewards(ts.GoCtx, msg)
 }
 
+// TxDualstakingUpdateDelegatorAllowlist: implement 'tx dualstaking update-delegator-allowlist'
+func (ts *Tester) TxDualstakingUpdateDelegatorAllowlist(
+	provider string,
+	add []string,
+	remove []string,
+) (*dualstakingtypes.MsgUpdateDelegatorAllowlistResponse, error) {
+	msg := dualstakingtypes.NewMsgUpdateDelegatorAllowlist(provider, add, remove)
+	return ts.Servers.DualstakingServer.UpdateDelegatorAllowlist(ts.GoCtx, msg)
+}
+
+// TxDualstakingSetWithdrawAddress: implement 'tx dualstaking set-withdraw-address'
+func (ts *Tester) TxDualstakingSetWithdrawAddress(
+	delegator string,
+	withdrawAddr string,
+) (*dualstakingtypes.MsgSetWithdrawAddressResponse, error) {
+	msg := dualstakingtypes.NewMsgSetWithdrawAddress(delegator, withdrawAddr)
+	return ts.Servers.DualstakingServer.SetWithdrawAddress(ts.GoCtx, msg)
+}
+
+// TxDualstakingSetAutoCompound: implement 'tx dualstaking set-auto-compound'
+func (ts *Tester) TxDualstakingSetAutoCompound(
+	delegator string,
+	provider string,
+	chainID string,
+	enabled bool,
+) (*dualstakingtypes.MsgSetAutoCompoundResponse, error) {
+	msg := dualstakingtypes.NewMsgSetAutoCompound(delegator, provider, chainID, enabled)
+	return ts.Servers.DualstakingServer.SetAutoCompound(ts.GoCtx, msg)
+}
+
+// TxDualstakingDelegateAtEpoch: implement 'tx dualstaking delegate-at-epoch'
+func (ts *Tester) TxDualstakingDelegateAtEpoch(
+	delegator string,
+	provider string,
+	chainID string,
+	amount sdk.Coin,
+	targetEpoch uint64,
+) (*dualstakingtypes.MsgDelegateAtEpochResponse, error) {
+	msg := dualstakingtypes.NewMsgDelegateAtEpoch(delegator, provider, chainID, amount, targetEpoch)
+	return ts.Servers.DualstakingServer.DelegateAtEpoch(ts.GoCtx, msg)
+}
+
+// TxDualstakingForceUnbondDelegator: implement the gov message 'dualstaking/MsgForceUnbondDelegator'
+func (ts *Tester) TxDualstakingForceUnbondDelegator(
+	authority string,
+	delegator string,
+	skipHoldPeriod bool,
+) (*dualstakingtypes.MsgForceUnbondDelegatorResponse, error) {
+	msg := dualstakingtypes.NewMsgForceUnbondDelegator(authority, delegator, skipHoldPeriod)
+	return ts.Servers.DualstakingServer.ForceUnbondDelegator(ts.GoCtx, msg)
+}
+
 // TxSubscriptionBuy: implement 'tx subscription buy'
 func (ts *Tester) TxSubscriptionBuy(creator, consumer, plan string, months int, autoRenewal, advancePurchase bool) (*subscriptiontypes.MsgBuyResponse, error) {
 	msg := &subscriptiontypes.MsgBuy{
@@ -776,6 +828,14 @@ func (ts *Tester) QueryPairingSubscriptionMonthlyPayout(consumer string) (*pairi
 	return ts.Keepers.Pairing.SubscriptionMonthlyPayout(ts.GoCtx, msg)
 }
 
+// QueryPairingUnbondingHoldBlocks implements 'q pairing unbonding-hold-blocks'
+func (ts *Tester) QueryPairingUnbondingHoldBlocks(chainID string) (*pairingtypes.QueryUnbondingHoldBlocksResponse, error) {
+	msg := &pairingtypes.QueryUnbondingHoldBlocksRequest{
+		ChainID: chainID,
+	}
+	return ts.Keepers.Pairing.UnbondingHoldBlocks(ts.GoCtx, msg)
+}
+
 // QueryPairingVerifyPairing implements 'q dualstaking delegator-providers'
 func (ts *Tester) QueryDualstakingDelegatorProviders(delegator string, withPending bool) (*dualstakingtypes.QueryDelegatorProvidersResponse, error) {
 	msg := &dualstakingtypes.QueryDelegatorProvidersRequest{
@@ -794,6 +854,55 @@ func (ts *Tester) QueryDualstakingProviderDelegators(provider string, withPendin
 	return ts.Keepers.Dualstaking.ProviderDelegators(ts.GoCtx, msg)
 }
 
+// QueryDualstakingDelegatorChainDelegations implements 'q dualstaking delegator-chain-delegations'
+func (ts *Tester) QueryDualstakingDelegatorChainDelegations(delegator string, chainID string, withPending bool) (*dualstakingtypes.QueryDelegatorChainDelegationsResponse, error) {
+	msg := &dualstakingtypes.QueryDelegatorChainDelegationsRequest{
+		Delegator:   delegator,
+		ChainId:     chainID,
+		WithPending: withPending,
+	}
+	return ts.Keepers.Dualstaking.DelegatorChainDelegations(ts.GoCtx, msg)
+}
+
+// QueryDualstakingProviderDelegatorCount implements 'q dualstaking provider-delegator-count'
+func (ts *Tester) QueryDualstakingProviderDelegatorCount(provider string, chainID string, withPending bool) (*dualstakingtypes.QueryProviderDelegatorCountResponse, error) {
+	msg := &dualstakingtypes.QueryProviderDelegatorCountRequest{
+		Provider:    provider,
+		ChainId:     chainID,
+		WithPending: withPending,
+	}
+	return ts.Keepers.Dualstaking.ProviderDelegatorCount(ts.GoCtx, msg)
+}
+
+// QueryDualstakingDelegationAtHeight implements 'q dualstaking delegation-at-height'
+func (ts *Tester) QueryDualstakingDelegationAtHeight(delegator string, provider string, chainID string, height uint64) (*dualstakingtypes.QueryDelegationAtHeightResponse, error) {
+	msg := &dualstakingtypes.QueryDelegationAtHeightRequest{
+		Delegator: delegator,
+		Provider:  provider,
+		ChainId:   chainID,
+		Height:    height,
+	}
+	return ts.Keepers.Dualstaking.DelegationAtHeight(ts.GoCtx, msg)
+}
+
+// QueryDualstakingPoolReconciliation implements 'q dualstaking pool-reconciliation'
+func (ts *Tester) QueryDualstakingPoolReconciliation() (*dualstakingtypes.QueryPoolReconciliationResponse, error) {
+	msg := &dualstakingtypes.QueryPoolReconciliationRequest{}
+	return ts.Keepers.Dualstaking.PoolReconciliation(ts.GoCtx, msg)
+}
+
+// QueryDualstakingStoreStats implements 'q dualstaking store-stats'
+func (ts *Tester) QueryDualstakingStoreStats() (*dualstakingtypes.QueryStoreStatsResponse, error) {
+	msg := &dualstakingtypes.QueryStoreStatsRequest{}
+	return ts.Keepers.Dualstaking.StoreStats(ts.GoCtx, msg)
+}
+
+// QueryDualstakingParams implements 'q dualstaking params'
+func (ts *Tester) QueryDualstakingParams() (*dualstakingtypes.QueryParamsResponse, error) {
+	msg := &dualstakingtypes.QueryParamsRequest{}
+	return ts.Keepers.Dualstaking.Params(ts.GoCtx, msg)
+}
+
 // QueryDualstakingDelegatorRewards implements 'q dualstaking delegator-rewards'
 func (ts *Tester) QueryDualstakingDelegatorRewards(delegator string, provider string, chainID string) (*dualstakingtypes.QueryDelegatorRewardsResponse, error) {
 	msg := &dualstakingtypes.QueryDelegatorRewardsRequest{
@@ -28,6 +28,13 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	cmd.AddCommand(CmdQueryDelegatorProviders())
 	cmd.AddCommand(CmdQueryProviderDelegators())
 	cmd.AddCommand(CmdQueryDelegatorRewards())
+	cmd.AddCommand(CmdQueryMinDelegation())
+	cmd.AddCommand(CmdQueryProviderByMoniker())
+	cmd.AddCommand(CmdQueryDelegatorChainDelegations())
+	cmd.AddCommand(CmdQueryProviderDelegatorCount())
+	cmd.AddCommand(CmdQueryDelegationAtHeight())
+	cmd.AddCommand(CmdQueryPoolReconciliation())
+	cmd.AddCommand(CmdQueryStoreStats())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
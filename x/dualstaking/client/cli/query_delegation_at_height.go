@@ -0,0 +1,48 @@
+package cli
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/lavanet/lava/x/dualstaking/types"
+)
+
+func CmdQueryDelegationAtHeight() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delegation-at-height [delegator] [provider] [chain-id] [height]",
+		Short: "shows the delegation that was in effect at a past block height",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			height, err := strconv.ParseUint(args[3], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.DelegationAtHeight(cmd.Context(), &types.QueryDelegationAtHeightRequest{
+				Delegator: args[0],
+				Provider:  args[1],
+				ChainId:   args[2],
+				Height:    height,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,36 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/lavanet/lava/x/dualstaking/types"
+)
+
+func CmdQueryPoolReconciliation() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pool-reconciliation",
+		Short: "compares the staking module's tokens with the sum of all the provider delegations",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.PoolReconciliation(cmd.Context(), &types.QueryPoolReconciliationRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,39 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/lavanet/lava/x/dualstaking/types"
+)
+
+func CmdQueryProviderByMoniker() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "provider-by-moniker [chain-id] [moniker]",
+		Short: "shows the address of the provider staked on a chain with a specific moniker",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ProviderByMoniker(cmd.Context(), &types.QueryProviderByMonikerRequest{
+				ChainId: args[0],
+				Moniker: args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
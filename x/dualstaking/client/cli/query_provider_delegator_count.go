@@ -0,0 +1,53 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/lavanet/lava/x/dualstaking/types"
+)
+
+func CmdQueryProviderDelegatorCount() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "provider-delegator-count [provider] [chain-id]",
+		Short: "shows the number of delegators of the provider on a specific chain",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			provider := args[0]
+			chainID := args[1]
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			// check if the command includes --with-pending
+			withPendingDelegationsFlag := cmd.Flags().Lookup(WithPendingDelegatorsFlagName)
+			if withPendingDelegationsFlag == nil {
+				return fmt.Errorf("%s flag wasn't found", WithPendingDelegatorsFlagName)
+			}
+			withPendingDelegations := withPendingDelegationsFlag.Changed
+
+			res, err := queryClient.ProviderDelegatorCount(cmd.Context(), &types.QueryProviderDelegatorCountRequest{
+				Provider:    provider,
+				ChainId:     chainID,
+				WithPending: withPendingDelegations,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().Bool(WithPendingDelegatorsFlagName, false, "output with pending delegations (applied from next epoch)")
+
+	return cmd
+}
@@ -31,6 +31,10 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(CmdRedelegate())
 	cmd.AddCommand(CmdUnbond())
 	cmd.AddCommand(CmdClaimRewards())
+	cmd.AddCommand(CmdUpdateDelegatorAllowlist())
+	cmd.AddCommand(CmdSetWithdrawAddress())
+	cmd.AddCommand(CmdSetAutoCompound())
+	cmd.AddCommand(CmdDelegateAtEpoch())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
@@ -19,6 +19,7 @@ func CmdDelegate() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "delegate [validator] provider chain-id amount",
 		Short: "delegate to a validator and provider",
+		Long:  "delegate to a validator and provider, the provider can be given by its address or by its moniker on the chain",
 		Args:  cobra.RangeArgs(3, 4),
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
 			clientCtx, err := client.GetClientTxContext(cmd)
@@ -43,6 +44,11 @@ func CmdDelegate() *cobra.Command {
 				return err
 			}
 
+			argProvider, err = resolveProvider(clientCtx, argProvider, argChainID)
+			if err != nil {
+				return err
+			}
+
 			msg := types.NewMsgDelegate(
 				clientCtx.GetFromAddress().String(),
 				argvalidator,
@@ -63,6 +69,25 @@ func CmdDelegate() *cobra.Command {
 	return cmd
 }
 
+// resolveProvider returns the provider as is when it is an address, otherwise
+// it looks up the provider with this moniker on the chain
+func resolveProvider(clientCtx client.Context, provider string, chainID string) (string, error) {
+	if _, err := sdk.AccAddressFromBech32(provider); err == nil {
+		return provider, nil
+	}
+
+	queryClient := types.NewQueryClient(clientCtx)
+	res, err := queryClient.ProviderByMoniker(context.Background(), &types.QueryProviderByMonikerRequest{
+		ChainId: chainID,
+		Moniker: provider,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return res.Provider, nil
+}
+
 func GetValidator(clientCtx client.Context) string {
 	provider := clientCtx.GetFromAddress().String()
 	q := stakingtypes.NewQueryClient(clientCtx)
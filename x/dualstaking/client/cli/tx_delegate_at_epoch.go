@@ -0,0 +1,56 @@
+package cli
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/x/dualstaking/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdDelegateAtEpoch() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delegate-at-epoch [provider] [chain-id] [amount] [target-epoch] --from <delegator>",
+		Short: "schedule a delegation to a provider to take effect at a future epoch",
+		Long: `schedule a delegation to a provider to take effect at a future epoch (an epoch start
+block, no earlier than the next epoch). The funds are locked right away, and are delegated
+to the provider when the target epoch begins.`,
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argProvider := args[0]
+			argChainID := args[1]
+			argAmount, err := sdk.ParseCoinNormalized(args[2])
+			if err != nil {
+				return err
+			}
+			argTargetEpoch, err := strconv.ParseUint(args[3], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgDelegateAtEpoch(
+				clientCtx.GetFromAddress().String(),
+				argProvider,
+				argChainID,
+				argAmount,
+				argTargetEpoch,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,47 @@
+package cli
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/lavanet/lava/x/dualstaking/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdSetAutoCompound() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-auto-compound [provider] [chain-id] [enabled] --from <delegator>",
+		Short: "set whether the rewards of a delegation are re-delegated to the same provider and chain",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argProvider := args[0]
+			argChainID := args[1]
+			argEnabled, err := strconv.ParseBool(args[2])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetAutoCompound(
+				clientCtx.GetFromAddress().String(),
+				argProvider,
+				argChainID,
+				argEnabled,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,36 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/lavanet/lava/x/dualstaking/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdSetWithdrawAddress() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-withdraw-address [withdraw-address] --from <delegator>",
+		Short: "set the address to which the delegator's rewards are sent. Setting the delegator itself restores the default",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetWithdrawAddress(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,64 @@
+package cli
+
+import (
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/lavanet/lava/x/dualstaking/types"
+	"github.com/spf13/cobra"
+)
+
+const (
+	FlagAllowlistAdd    = "add"
+	FlagAllowlistRemove = "remove"
+)
+
+func CmdUpdateDelegatorAllowlist() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-delegator-allowlist --add <delegators> --remove <delegators> --from <provider>",
+		Short: "add or remove delegators from the provider's delegator allowlist",
+		Long: `add or remove delegators from the provider's delegator allowlist. The delegators are
+comma separated. An empty allowlist accepts delegations from all delegators`,
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			add, err := cmd.Flags().GetString(FlagAllowlistAdd)
+			if err != nil {
+				return err
+			}
+			remove, err := cmd.Flags().GetString(FlagAllowlistRemove)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUpdateDelegatorAllowlist(
+				clientCtx.GetFromAddress().String(),
+				splitList(add),
+				splitList(remove),
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagAllowlistAdd, "", "comma separated delegators to add to the allowlist")
+	cmd.Flags().String(FlagAllowlistRemove, "", "comma separated delegators to remove from the allowlist")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func splitList(list string) []string {
+	if list == "" {
+		return nil
+	}
+	return strings.Split(list, listSeparator)
+}
@@ -13,11 +13,52 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 
 	k.InitDelegations(ctx, genState.DelegationsFS)
 	k.InitDelegators(ctx, genState.DelegatorsFS)
+	k.RebuildDelegatorChainTotals(ctx)
+
+	// bulk import the delegations (e.g. migrated from another system) on top of
+	// the fixation stores' delegations. They depend on modules initialized later
+	// (specs, stake entries, genesis transactions), so they are imported at the
+	// first BeginBlock.
+	k.SetPendingImportedDelegations(ctx, genState.ImportedDelegations)
 
 	// Set all the DelegatorReward
 	for _, elem := range genState.DelegatorRewardList {
 		k.SetDelegatorReward(ctx, elem)
 	}
+
+	for _, elem := range genState.DelegationLocks {
+		k.SetDelegationLock(ctx, elem.Delegator, elem.Provider, elem.ChainId, elem.LockUntil)
+	}
+
+	for _, elem := range genState.DelegatorAllowlist {
+		k.AddDelegatorToAllowlist(ctx, elem.Provider, elem.Delegator)
+	}
+
+	for _, elem := range genState.WithdrawAddresses {
+		if err := k.SetWithdrawAddress(ctx, elem.Delegator, elem.WithdrawAddress); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, elem := range genState.ProviderLastRewards {
+		k.SetProviderLastReward(ctx, elem.Provider, elem.ChainId, elem.Reward)
+	}
+
+	for _, elem := range genState.DelegationTags {
+		if err := k.SetDelegationTag(ctx, elem.Delegator, elem.Provider, elem.ChainId, elem.Tag); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, elem := range genState.DelegationStartEpochs {
+		k.SetDelegationStartEpoch(ctx, elem.Delegator, elem.Provider, elem.ChainId, elem.Epoch)
+	}
+
+	k.InitDelegationAutoCompounds(ctx, genState.DelegationAutoCompounds)
+
+	for _, elem := range genState.ScheduledDelegations {
+		k.SetScheduledDelegation(ctx, elem.Delegator, elem.Provider, elem.ChainId, elem.Epoch, elem.Amount)
+	}
 }
 
 // ExportGenesis returns the module's exported genesis
@@ -27,7 +68,16 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 
 	genesis.DelegationsFS = k.ExportDelegations(ctx)
 	genesis.DelegatorsFS = k.ExportDelegators(ctx)
+	genesis.ImportedDelegations = k.GetPendingImportedDelegations(ctx)
 	genesis.DelegatorRewardList = k.GetAllDelegatorReward(ctx)
+	genesis.DelegationLocks = k.GetAllDelegationLocks(ctx)
+	genesis.DelegatorAllowlist = k.GetAllDelegatorAllowlists(ctx)
+	genesis.WithdrawAddresses = k.GetAllWithdrawAddresses(ctx)
+	genesis.ProviderLastRewards = k.GetAllProviderLastRewards(ctx)
+	genesis.DelegationTags = k.GetAllDelegationTags(ctx)
+	genesis.DelegationStartEpochs = k.GetAllDelegationStartEpochs(ctx)
+	genesis.DelegationAutoCompounds = k.GetAllDelegationAutoCompounds(ctx)
+	genesis.ScheduledDelegations = k.GetAllScheduledDelegations(ctx)
 	// this line is used by starport scaffolding # genesis/module/export
 
 	return genesis
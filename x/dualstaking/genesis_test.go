@@ -3,16 +3,50 @@ package dualstaking_test
 import (
 	"testing"
 
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	commontypes "github.com/lavanet/lava/common/types"
 	keepertest "github.com/lavanet/lava/testutil/keeper"
 	"github.com/lavanet/lava/testutil/nullify"
+	"github.com/lavanet/lava/testutil/sample"
 	"github.com/lavanet/lava/x/dualstaking"
 	"github.com/lavanet/lava/x/dualstaking/types"
 	"github.com/stretchr/testify/require"
 )
 
 func TestGenesis(t *testing.T) {
+	provider, delegator, delegator2 := sample.AccAddress(), sample.AccAddress(), sample.AccAddress()
 	genesisState := types.GenesisState{
 		Params: types.DefaultParams(),
+		DelegationLocks: []types.DelegationLock{
+			{Delegator: delegator, Provider: provider, ChainId: "c0", LockUntil: 100},
+			{Delegator: delegator, Provider: provider, ChainId: "c1", LockUntil: 200},
+		},
+		DelegatorAllowlist: []types.DelegatorAllowlistEntry{
+			{Provider: provider, Delegator: delegator},
+			{Provider: provider, Delegator: delegator2},
+		},
+		WithdrawAddresses: []types.WithdrawAddress{
+			{Delegator: delegator, WithdrawAddress: delegator2},
+		},
+		ProviderLastRewards: []types.ProviderLastReward{
+			{Provider: provider, ChainId: "c0", Reward: math.NewInt(1000)},
+			{Provider: provider, ChainId: "c1", Reward: math.NewInt(2000)},
+		},
+		DelegationTags: []types.DelegationTag{
+			{Delegator: delegator, Provider: provider, ChainId: "c0", Tag: "client-1"},
+		},
+		DelegationStartEpochs: []types.DelegationStartEpoch{
+			{Delegator: delegator, Provider: provider, ChainId: "c0", Epoch: 20},
+			{Delegator: delegator2, Provider: provider, ChainId: "c0", Epoch: 40},
+		},
+		DelegationAutoCompounds: []types.DelegationAutoCompound{
+			{Delegator: delegator2, Provider: provider, ChainId: "c0"},
+		},
+		ScheduledDelegations: []types.ScheduledDelegation{
+			{Delegator: delegator, Provider: provider, ChainId: "c0", Epoch: 60, Amount: sdk.NewCoin(commontypes.TokenDenom, math.NewInt(500))},
+			{Delegator: delegator, Provider: provider, ChainId: "c0", Epoch: 80, Amount: sdk.NewCoin(commontypes.TokenDenom, math.NewInt(700))},
+		},
 
 		// this line is used by starport scaffolding # genesis/test/state
 	}
@@ -22,6 +56,16 @@ func TestGenesis(t *testing.T) {
 	got := dualstaking.ExportGenesis(ctx, *k)
 	require.NotNil(t, got)
 
+	// compare the stores' entries before nullify.Fill (which empties the slices)
+	require.ElementsMatch(t, genesisState.DelegationLocks, got.DelegationLocks)
+	require.ElementsMatch(t, genesisState.DelegatorAllowlist, got.DelegatorAllowlist)
+	require.ElementsMatch(t, genesisState.WithdrawAddresses, got.WithdrawAddresses)
+	require.ElementsMatch(t, genesisState.ProviderLastRewards, got.ProviderLastRewards)
+	require.ElementsMatch(t, genesisState.DelegationTags, got.DelegationTags)
+	require.ElementsMatch(t, genesisState.DelegationStartEpochs, got.DelegationStartEpochs)
+	require.ElementsMatch(t, genesisState.DelegationAutoCompounds, got.DelegationAutoCompounds)
+	require.ElementsMatch(t, genesisState.ScheduledDelegations, got.ScheduledDelegations)
+
 	nullify.Fill(&genesisState)
 	nullify.Fill(got)
 	require.ElementsMatch(t, genesisState.DelegatorRewardList, got.DelegatorRewardList)
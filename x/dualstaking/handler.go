@@ -30,6 +30,21 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 		case *types.MsgClaimRewards:
 			res, err := msgServer.ClaimRewards(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgUpdateDelegatorAllowlist:
+			res, err := msgServer.UpdateDelegatorAllowlist(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgSetWithdrawAddress:
+			res, err := msgServer.SetWithdrawAddress(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgSetAutoCompound:
+			res, err := msgServer.SetAutoCompound(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgDelegateAtEpoch:
+			res, err := msgServer.DelegateAtEpoch(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgForceUnbondDelegator:
+			res, err := msgServer.ForceUnbondDelegator(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 			// this line is used by starport scaffolding # 1
 		default:
 			errMsg := fmt.Sprintf("unrecognized %s message type: %T", types.ModuleName, msg)
@@ -0,0 +1,190 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/utils"
+	"github.com/lavanet/lava/x/dualstaking/types"
+)
+
+// A delegation may be set to auto-compound: its rewards are re-delegated to the
+// same provider and chain when they are distributed, instead of accumulating to be
+// claimed. The compounded part of a reward is capped by the provider's remaining
+// delegation limit, and the rest (or the whole reward, if re-delegating it fails)
+// stays claimable as usual. The flags are indexed by the delegation key
+// <provider,delegator,chainID> and are removed along with the delegation.
+
+// SetDelegationAutoCompound sets whether the delegation's rewards are compounded
+func (k Keeper) SetDelegationAutoCompound(ctx sdk.Context, delegator, provider, chainID string, enabled bool) error {
+	if !enabled {
+		k.RemoveDelegationAutoCompound(ctx, delegator, provider, chainID)
+		return nil
+	}
+
+	nextEpoch := k.epochstorageKeeper.GetCurrentNextEpoch(ctx)
+	if _, found := k.GetDelegation(ctx, delegator, provider, chainID, nextEpoch); !found {
+		return utils.LavaFormatWarning("cannot set auto-compound: delegation not found", types.ErrDelegationNotFound,
+			utils.LogAttr("delegator", delegator),
+			utils.LogAttr("provider", provider),
+			utils.LogAttr("chain_id", chainID),
+		)
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DelegationAutoCompoundPrefix))
+	store.Set([]byte(types.DelegationKey(provider, delegator, chainID)), []byte{1})
+	return nil
+}
+
+// GetDelegationAutoCompound returns whether the delegation's rewards are compounded
+func (k Keeper) GetDelegationAutoCompound(ctx sdk.Context, delegator, provider, chainID string) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DelegationAutoCompoundPrefix))
+	return store.Has([]byte(types.DelegationKey(provider, delegator, chainID)))
+}
+
+// RemoveDelegationAutoCompound removes the auto-compound flag of the delegation
+func (k Keeper) RemoveDelegationAutoCompound(ctx sdk.Context, delegator, provider, chainID string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DelegationAutoCompoundPrefix))
+	store.Delete([]byte(types.DelegationKey(provider, delegator, chainID)))
+}
+
+// InitDelegationAutoCompounds sets the auto-compound flags from genesis (unlike
+// SetDelegationAutoCompound, without checking that the delegations exist)
+func (k Keeper) InitDelegationAutoCompounds(ctx sdk.Context, autoCompounds []types.DelegationAutoCompound) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DelegationAutoCompoundPrefix))
+	for _, elem := range autoCompounds {
+		store.Set([]byte(types.DelegationKey(elem.Provider, elem.Delegator, elem.ChainId)), []byte{1})
+	}
+}
+
+// GetAllDelegationAutoCompounds returns all the auto-compounding delegations (for genesis)
+func (k Keeper) GetAllDelegationAutoCompounds(ctx sdk.Context) []types.DelegationAutoCompound {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DelegationAutoCompoundPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	autoCompounds := []types.DelegationAutoCompound{}
+	for ; iterator.Valid(); iterator.Next() {
+		provider, delegator, chainID := types.DelegationKeyDecode(string(iterator.Key()))
+		autoCompounds = append(autoCompounds, types.DelegationAutoCompound{
+			Delegator: delegator,
+			Provider:  provider,
+			ChainId:   chainID,
+		})
+	}
+	return autoCompounds
+}
+
+// compoundDelegatorReward re-delegates (up to) the given reward of an
+// auto-compounding delegation, which was already added to the delegator's rewards.
+// It is applied on a cached context, so if it fails the reward stays claimable.
+func (k Keeper) compoundDelegatorReward(ctx sdk.Context, delegation types.Delegation, reward math.Int) {
+	if !k.GetDelegationAutoCompound(ctx, delegation.Delegator, delegation.Provider, delegation.ChainID) {
+		return
+	}
+
+	amount := k.compoundableAmount(ctx, delegation, reward)
+	if !amount.IsPositive() {
+		return
+	}
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	if err := k.compound(cacheCtx, delegation, amount); err != nil {
+		utils.LavaFormatWarning("failed to compound delegator reward, leaving it claimable", err,
+			utils.LogAttr("delegator", delegation.Delegator),
+			utils.LogAttr("provider", delegation.Provider),
+			utils.LogAttr("chain_id", delegation.ChainID),
+			utils.LogAttr("amount", amount),
+		)
+		return
+	}
+	writeCache()
+}
+
+// compoundableAmount caps the reward by the provider's remaining delegation limit
+// (the provider's own self delegation is not subject to the limit)
+func (k Keeper) compoundableAmount(ctx sdk.Context, delegation types.Delegation, reward math.Int) math.Int {
+	if delegation.Delegator == delegation.Provider {
+		return reward
+	}
+
+	providerAddr, err := sdk.AccAddressFromBech32(delegation.Provider)
+	if err != nil {
+		return math.ZeroInt()
+	}
+	stakeEntry, found, _ := k.epochstorageKeeper.GetStakeEntryByAddressCurrent(ctx, delegation.ChainID, providerAddr)
+	if !found || stakeEntry.DelegateLimit.IsNil() {
+		return math.ZeroInt()
+	}
+
+	room := stakeEntry.DelegateLimit.Amount
+	if !stakeEntry.DelegateTotal.IsNil() {
+		room = room.Sub(stakeEntry.DelegateTotal.Amount)
+	}
+	if !room.IsPositive() {
+		return math.ZeroInt()
+	}
+	return math.MinInt(reward, room)
+}
+
+// compound moves the amount from the delegator's rewards back to the delegator and
+// delegates it to the provider (through the validator the delegator delegates the
+// most to, or else the most powerful bonded validator)
+func (k Keeper) compound(ctx sdk.Context, delegation types.Delegation, amount math.Int) error {
+	delegatorAddr, err := sdk.AccAddressFromBech32(delegation.Delegator)
+	if err != nil {
+		return err
+	}
+
+	validator, err := k.delegationValidator(ctx, delegatorAddr)
+	if err != nil {
+		return err
+	}
+
+	ind := types.DelegationKey(delegation.Provider, delegation.Delegator, delegation.ChainID)
+	delegatorReward, found := k.GetDelegatorReward(ctx, ind)
+	if !found || delegatorReward.Amount.Amount.LT(amount) {
+		return utils.LavaFormatWarning("delegator reward is smaller than the compounded amount", types.ErrBadDelegationAmount,
+			utils.LogAttr("reward", delegatorReward.Amount),
+			utils.LogAttr("amount", amount),
+		)
+	}
+	delegatorReward.Amount = delegatorReward.Amount.SubAmount(amount)
+	if delegatorReward.Amount.IsZero() {
+		k.RemoveDelegatorReward(ctx, ind)
+	} else {
+		k.SetDelegatorReward(ctx, delegatorReward)
+	}
+
+	coin := sdk.NewCoin(k.stakingKeeper.BondDenom(ctx), amount)
+	err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, delegatorAddr, sdk.NewCoins(coin))
+	if err != nil {
+		return err
+	}
+
+	return k.DelegateFull(ctx, delegation.Delegator, validator, delegation.Provider, delegation.ChainID, coin)
+}
+
+// delegationValidator picks the validator for delegations made on the delegator's
+// behalf: the validator the delegator delegates the most to, or else the most
+// powerful bonded validator
+func (k Keeper) delegationValidator(ctx sdk.Context, delegatorAddr sdk.AccAddress) (string, error) {
+	var validator string
+	most := math.LegacyZeroDec()
+	for _, d := range k.stakingKeeper.GetAllDelegatorDelegations(ctx, delegatorAddr) {
+		if d.Shares.GT(most) {
+			validator, most = d.ValidatorAddress, d.Shares
+		}
+	}
+	if validator != "" {
+		return validator, nil
+	}
+
+	validators := k.stakingKeeper.GetBondedValidatorsByPower(ctx)
+	if len(validators) == 0 {
+		return "", utils.LavaFormatWarning("no validator to delegate to", types.ErrBadDelegationAmount,
+			utils.LogAttr("delegator", delegatorAddr.String()),
+		)
+	}
+	return validators[0].OperatorAddress, nil
+}
@@ -16,24 +16,50 @@ package keeper
 // indexed by the combination <provider,chainD,delegator>, used to track delegations
 // and find/access delegations by provider (and chainID); and another for delegators
 // tracking the list of providers for a delegator, indexed by the delegator.
+//
+// The funds move only through the staking module's Delegate/Undelegate (and the
+// bank's DelegateCoins/UndelegateCoins), which track the delegated vesting and
+// free coins of vesting accounts, so the dualstaking module does not call the
+// vesting TrackDelegation/TrackUndelegation itself.
 
 import (
 	"fmt"
 
 	"cosmossdk.io/math"
+	"github.com/armon/go-metrics"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/lavanet/lava/utils"
 	lavaslices "github.com/lavanet/lava/utils/slices"
 	"github.com/lavanet/lava/x/dualstaking/types"
 	epochstoragetypes "github.com/lavanet/lava/x/epochstorage/types"
+	spectypes "github.com/lavanet/lava/x/spec/types"
 	"golang.org/x/exp/slices"
 )
 
 // increaseDelegation increases the delegation of a delegator to a provider for a
 // given chain. It updates the fixation stores for both delegations and delegators,
-// and updates the (epochstorage) stake-entry.
-func (k Keeper) increaseDelegation(ctx sdk.Context, delegator, provider, chainID string, amount sdk.Coin, nextEpoch uint64) error {
+// and updates the (epochstorage) stake-entry (or defers its update to the batch,
+// if not nil).
+func (k Keeper) increaseDelegation(ctx sdk.Context, delegator, provider, chainID string, amount sdk.Coin, nextEpoch uint64, batch *stakeEntryBatch) error {
+	// verify the provider is staked before touching the fixation stores, so a failed
+	// delegation doesn't leave orphaned delegation entries behind
+	if provider != types.EMPTY_PROVIDER {
+		if err := k.verifyProviderStaked(ctx, provider, chainID); err != nil {
+			return err
+		}
+		if !k.IsDelegatorAllowed(ctx, provider, delegator) {
+			return utils.LavaFormatWarning("delegator is not allowed by the provider", types.ErrDelegatorNotAllowed,
+				utils.Attribute{Key: "delegator", Value: delegator},
+				utils.Attribute{Key: "provider", Value: provider},
+			)
+		}
+		if err := k.verifyProviderActive(ctx, delegator, provider, chainID); err != nil {
+			return err
+		}
+	}
+
 	// get, update and append the delegation entry
 	var delegationEntry types.Delegation
 	index := types.DelegationKey(provider, delegator, chainID)
@@ -44,6 +70,8 @@ func (k Keeper) increaseDelegation(ctx sdk.Context, delegator, provider, chainID
 	}
 
 	delegationEntry.AddAmount(amount)
+	k.SetDelegationStartEpoch(ctx, delegator, provider, chainID, nextEpoch)
+	k.addDelegatorChainTotal(ctx, delegator, chainID, amount.Amount)
 
 	err := k.delegationFS.AppendEntry(ctx, index, nextEpoch, &delegationEntry)
 	if err != nil {
@@ -74,16 +102,51 @@ func (k Keeper) increaseDelegation(ctx sdk.Context, delegator, provider, chainID
 
 	if provider != types.EMPTY_PROVIDER {
 		// update the stake entry
+		if batch != nil {
+			batch.add(delegator, provider, chainID, amount.Amount)
+			return nil
+		}
 		return k.increaseStakeEntryDelegation(ctx, delegator, provider, chainID, amount)
 	}
 
 	return nil
 }
 
+// IsProviderStakedOnChain returns whether the provider has a current stake entry
+// for the chain
+func (k Keeper) IsProviderStakedOnChain(ctx sdk.Context, provider, chainID string) bool {
+	providerAddr, err := sdk.AccAddressFromBech32(provider)
+	if err != nil {
+		return false
+	}
+	_, found, _ := k.epochstorageKeeper.GetStakeEntryByAddressCurrent(ctx, chainID, providerAddr)
+	return found
+}
+
+// verifyProviderStaked returns ErrProviderNotStaked if the provider has no
+// current stake entry for the chain
+func (k Keeper) verifyProviderStaked(ctx sdk.Context, provider, chainID string) error {
+	if _, err := sdk.AccAddressFromBech32(provider); err != nil {
+		return utils.LavaFormatWarning("invalid provider address", err,
+			utils.Attribute{Key: "provider", Value: provider},
+		)
+	}
+
+	if !k.IsProviderStakedOnChain(ctx, provider, chainID) {
+		return utils.LavaFormatWarning(fmt.Sprintf("provider %s is not staked on chain %s", provider, chainID), epochstoragetypes.ErrProviderNotStaked,
+			utils.Attribute{Key: "provider", Value: provider},
+			utils.Attribute{Key: "chainID", Value: chainID},
+		)
+	}
+
+	return nil
+}
+
 // decreaseDelegation decreases the delegation of a delegator to a provider for a
 // given chain. It updates the fixation stores for both delegations and delegators,
-// and updates the (epochstorage) stake-entry.
-func (k Keeper) decreaseDelegation(ctx sdk.Context, delegator, provider, chainID string, amount sdk.Coin, nextEpoch uint64) error {
+// and updates the (epochstorage) stake-entry (or defers its update to the batch,
+// if not nil).
+func (k Keeper) decreaseDelegation(ctx sdk.Context, delegator, provider, chainID string, amount sdk.Coin, nextEpoch uint64, batch *stakeEntryBatch) error {
 	// get, update and append the delegation entry
 	var delegationEntry types.Delegation
 	index := types.DelegationKey(provider, delegator, chainID)
@@ -97,10 +160,15 @@ func (k Keeper) decreaseDelegation(ctx sdk.Context, delegator, provider, chainID
 	}
 
 	delegationEntry.SubAmount(amount)
+	k.addDelegatorChainTotal(ctx, delegator, chainID, amount.Amount.Neg())
 
 	// if delegation now becomes zero, then remove this entry altogether;
 	// otherwise just append the new version (for next epoch).
 	if delegationEntry.Amount.IsZero() {
+		k.RemoveDelegationLock(ctx, delegator, provider, chainID)
+		k.RemoveDelegationTag(ctx, delegator, provider, chainID)
+		k.RemoveDelegationAutoCompound(ctx, delegator, provider, chainID)
+		k.removeDelegationStartEpoch(ctx, delegator, provider, chainID)
 		err := k.delegationFS.DelEntry(ctx, index, nextEpoch)
 		if err != nil {
 			// delete should never fail here
@@ -136,11 +204,14 @@ func (k Keeper) decreaseDelegation(ctx sdk.Context, delegator, provider, chainID
 		)
 	}
 
-	// if delegation now becomes zero, then remove this provider from the delegator
+	// if delegation now becomes zero, and it was the delegator's last delegation
+	// to this provider (on any chain), then remove this provider from the delegator
 	// entry; and if the delegator entry becomes entry then remove it altogether.
 	// otherwise just append the new version (for next epoch).
 	if delegationEntry.Amount.IsZero() {
-		delegatorEntry.DelProvider(provider)
+		if len(k.GetAllProviderDelegatorDelegations(ctx, delegator, provider, nextEpoch)) == 0 {
+			delegatorEntry.DelProvider(provider)
+		}
 	}
 	if delegatorEntry.IsEmpty() {
 		err := k.delegatorFS.DelEntry(ctx, index, nextEpoch)
@@ -165,6 +236,10 @@ func (k Keeper) decreaseDelegation(ctx sdk.Context, delegator, provider, chainID
 	}
 
 	if provider != types.EMPTY_PROVIDER {
+		if batch != nil {
+			batch.add(delegator, provider, chainID, amount.Amount.Neg())
+			return nil
+		}
 		return k.decreaseStakeEntryDelegation(ctx, delegator, provider, chainID, amount)
 	}
 
@@ -195,10 +270,24 @@ func (k Keeper) increaseStakeEntryDelegation(ctx sdk.Context, delegator, provide
 	}
 
 	if delegator == provider {
+		minStake, err := k.getMinStake(ctx, chainID)
+		if err != nil {
+			return err
+		}
+		belowMinStake := stakeEntry.Stake.IsLT(minStake)
 		stakeEntry.Stake = stakeEntry.Stake.Add(amount)
-		if stakeEntry.Stake.IsGTE(k.specKeeper.GetMinStake(ctx, chainID)) && stakeEntry.IsFrozen() {
+		if stakeEntry.Stake.IsGTE(minStake) && stakeEntry.IsFrozen() {
 			stakeEntry.UnFreeze(uint64(ctx.BlockHeight()))
 		}
+		if belowMinStake && stakeEntry.Stake.IsGTE(minStake) {
+			details := map[string]string{
+				"provider":  provider,
+				"chain_id":  chainID,
+				"stake":     stakeEntry.Stake.String(),
+				"min_stake": minStake.String(),
+			}
+			utils.LogLavaEvent(ctx, k.Logger(ctx), types.ProviderMinStakeReachedEventName, details, "Provider reached the min stake")
+		}
 	} else {
 		stakeEntry.DelegateTotal = stakeEntry.DelegateTotal.Add(amount)
 	}
@@ -232,11 +321,15 @@ func (k Keeper) decreaseStakeEntryDelegation(ctx sdk.Context, delegator, provide
 	}
 
 	if delegator == provider {
+		minStake, err := k.getMinStake(ctx, chainID)
+		if err != nil {
+			return err
+		}
 		stakeEntry.Stake, err = stakeEntry.Stake.SafeSub(amount)
 		if err != nil {
 			return fmt.Errorf("invalid or insufficient funds: %w", err)
 		}
-		if stakeEntry.Stake.IsLT(k.specKeeper.GetMinStake(ctx, chainID)) {
+		if stakeEntry.Stake.IsLT(minStake) {
 			stakeEntry.Freeze()
 		}
 	} else {
@@ -251,6 +344,102 @@ func (k Keeper) decreaseStakeEntryDelegation(ctx sdk.Context, delegator, provide
 	return nil
 }
 
+// getMinStake returns the provider's minimum stake for a chain: the minimum of the
+// spec's providers type, if set, or else the spec's MinStakeProvider. It fails if
+// the chain's spec is not found (instead of assuming a zero minimum stake).
+func (k Keeper) getMinStake(ctx sdk.Context, chainID string) (sdk.Coin, error) {
+	spec, found := k.specKeeper.GetSpec(ctx, chainID)
+	if !found {
+		return sdk.Coin{}, utils.LavaFormatError("critical: failed to get spec for chainID", spectypes.ErrSpecNotFound,
+			utils.Attribute{Key: "chainID", Value: chainID},
+		)
+	}
+	if minStake, found := k.GetProvidersTypeMinStake(ctx, spec.ProvidersTypes); found {
+		return minStake, nil
+	}
+	return spec.MinStakeProvider, nil
+}
+
+// RecomputeProviderDelegateTotal overwrites the provider's (current) stake entry
+// Stake and DelegateTotal with the sums of its self delegation and third-party
+// delegations. It is meant to repair a stake entry that went out of sync with
+// the delegation entries (e.g. in a migration).
+func (k Keeper) RecomputeProviderDelegateTotal(ctx sdk.Context, provider, chainID string) error {
+	providerAddr, err := sdk.AccAddressFromBech32(provider)
+	if err != nil {
+		return utils.LavaFormatWarning("invalid provider address", err,
+			utils.Attribute{Key: "provider", Value: provider},
+		)
+	}
+
+	stakeEntry, exists, index := k.epochstorageKeeper.GetStakeEntryByAddressCurrent(ctx, chainID, providerAddr)
+	if !exists {
+		return utils.LavaFormatWarning("cannot recompute delegate total", epochstoragetypes.ErrProviderNotStaked,
+			utils.Attribute{Key: "provider", Value: provider},
+			utils.Attribute{Key: "chainID", Value: chainID},
+		)
+	}
+
+	// the current stake entry already reflects the delegations of the next epoch
+	nextEpoch := k.epochstorageKeeper.GetCurrentNextEpoch(ctx)
+	delegations, err := k.GetProviderDelegators(ctx, provider, nextEpoch)
+	if err != nil {
+		return err
+	}
+
+	stake := sdk.NewCoin(k.stakingKeeper.BondDenom(ctx), sdk.ZeroInt())
+	delegateTotal := sdk.NewCoin(k.stakingKeeper.BondDenom(ctx), sdk.ZeroInt())
+	for _, d := range delegations {
+		if d.ChainID != chainID {
+			continue
+		}
+		if d.Delegator == provider {
+			stake = stake.Add(d.Amount)
+		} else {
+			delegateTotal = delegateTotal.Add(d.Amount)
+		}
+	}
+
+	if !stake.IsEqual(stakeEntry.Stake) || !delegateTotal.IsEqual(stakeEntry.DelegateTotal) {
+		utils.LavaFormatWarning("stake entry out of sync with delegations, recomputing", nil,
+			utils.Attribute{Key: "provider", Value: provider},
+			utils.Attribute{Key: "chainID", Value: chainID},
+			utils.Attribute{Key: "stake", Value: stakeEntry.Stake.String()},
+			utils.Attribute{Key: "recomputed_stake", Value: stake.String()},
+			utils.Attribute{Key: "delegate_total", Value: stakeEntry.DelegateTotal.String()},
+			utils.Attribute{Key: "recomputed_delegate_total", Value: delegateTotal.String()},
+		)
+	}
+
+	minStake, err := k.getMinStake(ctx, chainID)
+	if err != nil {
+		return err
+	}
+
+	stakeEntry.Stake = stake
+	stakeEntry.DelegateTotal = delegateTotal
+	if stakeEntry.Stake.IsLT(minStake) {
+		stakeEntry.Freeze()
+	}
+
+	k.epochstorageKeeper.ModifyStakeEntryCurrent(ctx, chainID, stakeEntry, index)
+
+	return nil
+}
+
+// validateEmptyProviderChainID verifies that delegations to the empty provider use
+// the empty chain ID: the empty provider's delegations are always looked up with
+// EMPTY_PROVIDER_CHAINID, so an entry with any other chain ID would be orphaned.
+func validateEmptyProviderChainID(provider, chainID string) error {
+	if provider == types.EMPTY_PROVIDER && chainID != types.EMPTY_PROVIDER_CHAINID {
+		return utils.LavaFormatWarning("invalid chain ID for empty provider", types.ErrEmptyProviderChainID,
+			utils.LogAttr("provider", provider),
+			utils.LogAttr("chain_id", chainID),
+		)
+	}
+	return nil
+}
+
 // delegate lets a delegator delegate an amount of coins to a provider.
 // (effective on next epoch)
 func (k Keeper) delegate(ctx sdk.Context, delegator, provider, chainID string, amount sdk.Coin) error {
@@ -269,6 +458,8 @@ func (k Keeper) delegate(ctx sdk.Context, delegator, provider, chainID string, a
 				utils.Attribute{Key: "provider", Value: provider},
 			)
 		}
+	} else if err := validateEmptyProviderChainID(provider, chainID); err != nil {
+		return err
 	}
 
 	if err := utils.ValidateCoins(ctx, k.stakingKeeper.BondDenom(ctx), amount, false); err != nil {
@@ -279,7 +470,7 @@ func (k Keeper) delegate(ctx sdk.Context, delegator, provider, chainID string, a
 		)
 	}
 
-	err = k.increaseDelegation(ctx, delegator, provider, chainID, amount, nextEpoch)
+	err = k.increaseDelegation(ctx, delegator, provider, chainID, amount, nextEpoch, nil)
 	if err != nil {
 		return utils.LavaFormatWarning("failed to increase delegation", err,
 			utils.Attribute{Key: "delegator", Value: delegator},
@@ -296,16 +487,62 @@ func (k Keeper) delegate(ctx sdk.Context, delegator, provider, chainID string, a
 // without the funds being subject to unstakeHoldBlocks witholding period.
 // (effective on next epoch)
 func (k Keeper) Redelegate(ctx sdk.Context, delegator, from, to, fromChainID, toChainID string, amount sdk.Coin) error {
+	return k.redelegate(ctx, delegator, from, to, fromChainID, toChainID, amount, nil)
+}
+
+// redelegate is Redelegate, with the stake entries' updates deferred to the batch
+// (if not nil)
+func (k Keeper) redelegate(ctx sdk.Context, delegator, from, to, fromChainID, toChainID string, amount sdk.Coin, batch *stakeEntryBatch) error {
+	// redelegating to the same provider and chain (or between empty providers)
+	// is a no-op that would needlessly touch the stake entry twice
+	if from == to && (fromChainID == toChainID || from == types.EMPTY_PROVIDER) {
+		return utils.LavaFormatWarning("cannot redelegate to the same provider and chain", types.ErrRedelegateToSelf,
+			utils.LogAttr("delegator", delegator),
+			utils.LogAttr("provider", from),
+			utils.LogAttr("from_chain_id", fromChainID),
+			utils.LogAttr("to_chain_id", toChainID),
+		)
+	}
+
+	if err := validateEmptyProviderChainID(from, fromChainID); err != nil {
+		return err
+	}
+	if err := validateEmptyProviderChainID(to, toChainID); err != nil {
+		return err
+	}
+
+	// validate both chain IDs before any state change. The empty chain ID is only
+	// valid for the empty provider, otherwise the delegation entry would be written
+	// to the fixation stores for a chain that has no stake entry.
 	_, foundFrom := k.specKeeper.GetSpec(ctx, fromChainID)
 	_, foundTo := k.specKeeper.GetSpec(ctx, toChainID)
 	if (!foundFrom && fromChainID != types.EMPTY_PROVIDER_CHAINID) ||
-		(!foundTo && toChainID != types.EMPTY_PROVIDER_CHAINID) {
+		(!foundTo && (toChainID != types.EMPTY_PROVIDER_CHAINID || to != types.EMPTY_PROVIDER)) {
 		return utils.LavaFormatWarning("cannot redelegate with invalid chain IDs", fmt.Errorf("chain ID not found"),
 			utils.LogAttr("from_chain_id", fromChainID),
 			utils.LogAttr("to_chain_id", toChainID),
 		)
 	}
 
+	if k.IsDelegationLocked(ctx, delegator, from, fromChainID) {
+		lockUntil, _ := k.GetDelegationLock(ctx, delegator, from, fromChainID)
+		return utils.LavaFormatWarning("cannot redelegate or unbond a locked delegation", types.ErrDelegationLocked,
+			utils.LogAttr("delegator", delegator),
+			utils.LogAttr("provider", from),
+			utils.LogAttr("chain_id", fromChainID),
+			utils.LogAttr("lock_until", lockUntil),
+		)
+	}
+
+	// redelegating must not bypass the to-provider's delegators allowlist, so it is
+	// checked before the from-side delegation is touched
+	if !k.IsDelegatorAllowed(ctx, to, delegator) {
+		return utils.LavaFormatWarning("delegator is not allowed by the provider", types.ErrDelegatorNotAllowed,
+			utils.LogAttr("delegator", delegator),
+			utils.LogAttr("provider", to),
+		)
+	}
+
 	nextEpoch := k.epochstorageKeeper.GetCurrentNextEpoch(ctx)
 
 	if _, err := sdk.AccAddressFromBech32(delegator); err != nil {
@@ -337,7 +574,22 @@ func (k Keeper) Redelegate(ctx sdk.Context, delegator, from, to, fromChainID, to
 		)
 	}
 
-	err := k.increaseDelegation(ctx, delegator, to, toChainID, amount, nextEpoch)
+	// the from-side delegation is decreased by the same amount, so their denoms must match
+	if fromDelegation, found := k.GetDelegation(ctx, delegator, from, fromChainID, nextEpoch); found && fromDelegation.Amount.Denom != amount.Denom {
+		return utils.LavaFormatWarning("cannot redelegate: delegation denom mismatch", types.ErrBadDelegationAmount,
+			utils.Attribute{Key: "delegator", Value: delegator},
+			utils.Attribute{Key: "provider", Value: from},
+			utils.Attribute{Key: "chainID", Value: fromChainID},
+			utils.Attribute{Key: "delegation_denom", Value: fromDelegation.Amount.Denom},
+			utils.Attribute{Key: "amount_denom", Value: amount.Denom},
+		)
+	}
+
+	if err := k.verifyMinDelegation(ctx, delegator, from, to, fromChainID, toChainID, amount, nextEpoch); err != nil {
+		return err
+	}
+
+	err := k.increaseDelegation(ctx, delegator, to, toChainID, amount, nextEpoch, batch)
 	if err != nil {
 		return utils.LavaFormatWarning("failed to increase delegation", err,
 			utils.Attribute{Key: "delegator", Value: delegator},
@@ -346,7 +598,7 @@ func (k Keeper) Redelegate(ctx sdk.Context, delegator, from, to, fromChainID, to
 		)
 	}
 
-	err = k.decreaseDelegation(ctx, delegator, from, fromChainID, amount, nextEpoch)
+	err = k.decreaseDelegation(ctx, delegator, from, fromChainID, amount, nextEpoch, batch)
 	if err != nil {
 		return utils.LavaFormatWarning("failed to decrease delegation", err,
 			utils.Attribute{Key: "delegator", Value: delegator},
@@ -361,20 +613,116 @@ func (k Keeper) Redelegate(ctx sdk.Context, delegator, from, to, fromChainID, to
 	return nil
 }
 
+// RedelegateSplit moves parts of a delegation to several targets at once. The
+// from-side delegation is decreased by the targets' total and each target is
+// increased by its amount, all effective in the same next epoch. The total is
+// validated against the from-side delegation up front, and the redelegations are
+// applied on a cached context so either all of them take effect or none does.
+func (k Keeper) RedelegateSplit(ctx sdk.Context, delegator, from, fromChainID string, targets []types.RedelegateTarget) error {
+	if len(targets) == 0 {
+		return utils.LavaFormatWarning("cannot split redelegation: no targets", types.ErrBadDelegationAmount,
+			utils.LogAttr("delegator", delegator),
+			utils.LogAttr("provider", from),
+		)
+	}
+
+	nextEpoch := k.epochstorageKeeper.GetCurrentNextEpoch(ctx)
+
+	fromDelegation, found := k.GetDelegation(ctx, delegator, from, fromChainID, nextEpoch)
+	if !found {
+		return utils.LavaFormatWarning("cannot split redelegation: delegation not found", types.ErrDelegationNotFound,
+			utils.LogAttr("delegator", delegator),
+			utils.LogAttr("provider", from),
+			utils.LogAttr("chain_id", fromChainID),
+		)
+	}
+
+	total := sdk.NewCoin(fromDelegation.Amount.Denom, sdk.ZeroInt())
+	for _, target := range targets {
+		if target.Amount.Denom != total.Denom {
+			return utils.LavaFormatWarning("cannot split redelegation: delegation denom mismatch", types.ErrBadDelegationAmount,
+				utils.LogAttr("delegator", delegator),
+				utils.LogAttr("provider", target.Provider),
+				utils.LogAttr("delegation_denom", total.Denom),
+				utils.LogAttr("amount_denom", target.Amount.Denom),
+			)
+		}
+		total = total.Add(target.Amount)
+	}
+
+	if fromDelegation.Amount.IsLT(total) {
+		return utils.LavaFormatWarning("cannot split redelegation: insufficient delegation", types.ErrInsufficientDelegation,
+			utils.LogAttr("delegator", delegator),
+			utils.LogAttr("provider", from),
+			utils.LogAttr("chain_id", fromChainID),
+			utils.LogAttr("delegation", fromDelegation.Amount),
+			utils.LogAttr("total", total),
+		)
+	}
+
+	// redelegate on a cached context, and only write it if all the redelegations succeed.
+	// The stake entries are updated in a batch, so the from-side stake entry is
+	// written once rather than once per target
+	cacheCtx, writeCache := ctx.CacheContext()
+	batch := newStakeEntryBatch()
+	for _, target := range targets {
+		if err := k.redelegate(cacheCtx, delegator, from, target.Provider, fromChainID, target.ChainID, target.Amount, batch); err != nil {
+			return utils.LavaFormatWarning("failed to split redelegation", err,
+				utils.LogAttr("delegator", delegator),
+				utils.LogAttr("provider", target.Provider),
+				utils.LogAttr("chain_id", target.ChainID),
+			)
+		}
+	}
+	if err := k.applyStakeEntryBatch(cacheCtx, batch); err != nil {
+		return utils.LavaFormatWarning("failed to split redelegation", err,
+			utils.LogAttr("delegator", delegator),
+			utils.LogAttr("provider", from),
+		)
+	}
+	writeCache()
+
+	return nil
+}
+
+// SwapDelegationChain moves (part of) a delegation to a provider from one of the
+// provider's chains to another, e.g. to rebalance the provider's backing. It is a
+// redelegation with the same provider on both sides, and requires the provider
+// to be staked on both chains.
+// (effective on next epoch)
+func (k Keeper) SwapDelegationChain(ctx sdk.Context, delegator, provider, fromChainID, toChainID string, amount sdk.Coin) error {
+	if provider == types.EMPTY_PROVIDER {
+		return utils.LavaFormatWarning("cannot swap the chain of the empty provider's delegation", fmt.Errorf("invalid provider"),
+			utils.LogAttr("delegator", delegator),
+		)
+	}
+
+	for _, chainID := range []string{fromChainID, toChainID} {
+		if !k.IsProviderStakedOnChain(ctx, provider, chainID) {
+			return utils.LavaFormatWarning("cannot swap delegation chain", epochstoragetypes.ErrProviderNotStaked,
+				utils.LogAttr("delegator", delegator),
+				utils.LogAttr("provider", provider),
+				utils.LogAttr("chain_id", chainID),
+			)
+		}
+	}
+
+	return k.Redelegate(ctx, delegator, provider, provider, fromChainID, toChainID, amount)
+}
+
 // unbond lets a delegator get its delegated coins back from a provider. The
 // delegation ends immediately, but coins are held for unstakeHoldBlocks period
 // before released and transferred back to the delegator. The rewards from the
-// provider will be updated accordingly (or terminate) from the next epoch.
+// provider will be updated accordingly (or terminate) from the given epoch,
+// which callers obtain once so that multi-step unbonds are applied together.
 // (effective on next epoch)
-func (k Keeper) unbond(ctx sdk.Context, delegator, provider, chainID string, amount sdk.Coin) error {
+func (k Keeper) unbond(ctx sdk.Context, delegator, provider, chainID string, amount sdk.Coin, nextEpoch uint64) error {
 	_, found := k.specKeeper.GetSpec(ctx, chainID)
 	if chainID != types.EMPTY_PROVIDER_CHAINID && !found {
 		return utils.LavaFormatWarning("cannot unbond with invalid chain ID", fmt.Errorf("chain ID not found"),
 			utils.LogAttr("chain_id", chainID))
 	}
 
-	nextEpoch := k.epochstorageKeeper.GetCurrentNextEpoch(ctx)
-
 	if _, err := sdk.AccAddressFromBech32(delegator); err != nil {
 		return utils.LavaFormatWarning("invalid delegator address", err,
 			utils.Attribute{Key: "delegator", Value: delegator},
@@ -396,7 +744,7 @@ func (k Keeper) unbond(ctx sdk.Context, delegator, provider, chainID string, amo
 		)
 	}
 
-	err := k.decreaseDelegation(ctx, delegator, provider, chainID, amount, nextEpoch)
+	err := k.decreaseDelegation(ctx, delegator, provider, chainID, amount, nextEpoch, nil)
 	if err != nil {
 		return utils.LavaFormatWarning("failed to decrease delegation", err,
 			utils.Attribute{Key: "delegator", Value: delegator},
@@ -424,6 +772,49 @@ func (k Keeper) GetDelegatorProviders(ctx sdk.Context, delegator string, epoch u
 	return delegatorEntry.Providers, nil
 }
 
+// GetDelegatorDelegationsForChain gets all the delegations of the delegator on a specific chain
+func (k Keeper) GetDelegatorDelegationsForChain(ctx sdk.Context, delegator, chainID string, epoch uint64) ([]types.Delegation, error) {
+	providers, err := k.GetDelegatorProviders(ctx, delegator, epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	var delegations []types.Delegation
+	for _, provider := range providers {
+		delegation, found := k.GetDelegation(ctx, delegator, provider, chainID, epoch)
+		if found {
+			delegations = append(delegations, delegation)
+		}
+	}
+
+	return delegations, nil
+}
+
+// GetDelegatorChains returns the sorted (distinct) chain IDs of the delegator's
+// delegations, across all providers, at the given epoch. The empty provider's
+// delegation is not on any chain, so it is not included.
+func (k Keeper) GetDelegatorChains(ctx sdk.Context, delegator string, epoch uint64) ([]string, error) {
+	providers, err := k.GetDelegatorProviders(ctx, delegator, epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	chains := map[string]struct{}{}
+	chainIDs := []string{}
+	for _, provider := range providers {
+		for _, delegation := range k.GetProviderDelegatorDelegations(ctx, delegator, provider, epoch, false) {
+			if _, ok := chains[delegation.ChainID]; ok {
+				continue
+			}
+			chains[delegation.ChainID] = struct{}{}
+			chainIDs = append(chainIDs, delegation.ChainID)
+		}
+	}
+
+	slices.Sort(chainIDs)
+	return chainIDs, nil
+}
+
 func (k Keeper) GetProviderDelegators(ctx sdk.Context, provider string, epoch uint64) ([]types.Delegation, error) {
 	if provider != types.EMPTY_PROVIDER {
 		_, err := sdk.AccAddressFromBech32(provider)
@@ -434,18 +825,16 @@ func (k Keeper) GetProviderDelegators(ctx sdk.Context, provider string, epoch ui
 		}
 	}
 
+	// the empty chain bucket only belongs to the empty provider
+	includeEmptyChain := provider == types.EMPTY_PROVIDER
+
 	var delegations []types.Delegation
-	indices := k.delegationFS.GetAllEntryIndicesWithPrefix(ctx, provider)
+	indices := k.delegationIndicesWithPrefix(ctx, provider, includeEmptyChain)
 	for _, ind := range indices {
 		var delegation types.Delegation
 		found := k.delegationFS.FindEntry(ctx, ind, epoch, &delegation)
 		if !found {
-			provider, delegator, chainID := types.DelegationKeyDecode(ind)
-			utils.LavaFormatError("delegationFS entry index has no entry", fmt.Errorf("provider delegation not found"),
-				utils.Attribute{Key: "delegator", Value: delegator},
-				utils.Attribute{Key: "provider", Value: provider},
-				utils.Attribute{Key: "chainID", Value: chainID},
-			)
+			reportDelegationIndexWithoutEntry(ind)
 			continue
 		}
 		delegations = append(delegations, delegation)
@@ -454,6 +843,285 @@ func (k Keeper) GetProviderDelegators(ctx sdk.Context, provider string, epoch ui
 	return delegations, nil
 }
 
+// reportDelegationIndexWithoutEntry logs a delegationFS index without an entry, and
+// counts it (by provider) so that such inconsistencies can be monitored
+func reportDelegationIndexWithoutEntry(ind string) {
+	provider, delegator, chainID := types.DelegationKeyDecode(ind)
+	utils.LavaFormatError("delegationFS entry index has no entry", fmt.Errorf("provider delegation not found"),
+		utils.Attribute{Key: "delegator", Value: delegator},
+		utils.Attribute{Key: "provider", Value: provider},
+		utils.Attribute{Key: "chainID", Value: chainID},
+	)
+	telemetry.IncrCounterWithLabels([]string{types.ModuleName, "delegation_index_without_entry"}, 1,
+		[]metrics.Label{telemetry.NewLabel("provider", provider)})
+}
+
+// GetProviderDelegatorCount returns the number of distinct delegators delegated to the
+// provider on the given chain at the given epoch. Only the fixation store's raw
+// entries are checked, the delegations themselves are not unmarshaled.
+func (k Keeper) GetProviderDelegatorCount(ctx sdk.Context, provider, chainID string, epoch uint64) (uint64, error) {
+	if provider != types.EMPTY_PROVIDER {
+		if _, err := sdk.AccAddressFromBech32(provider); err != nil {
+			return 0, utils.LavaFormatWarning("cannot count provider's delegators", err,
+				utils.Attribute{Key: "provider", Value: provider},
+			)
+		}
+	} else if chainID != types.EMPTY_PROVIDER_CHAINID {
+		// the empty provider only has delegations with the empty chain ID
+		return 0, nil
+	}
+
+	delegators := map[string]struct{}{}
+	indices := k.delegationFS.GetAllEntryIndicesWithPrefix(ctx, provider)
+	for _, ind := range indices {
+		indProvider, delegator, indChainID := types.DelegationKeyDecode(ind)
+		if indProvider != provider || indChainID != chainID {
+			continue
+		}
+		entry, err := k.delegationFS.FindRawEntry(ctx, ind, epoch)
+		if err != nil || entry.IsDeletedBy(epoch) {
+			continue
+		}
+		delegators[delegator] = struct{}{}
+	}
+
+	return uint64(len(delegators)), nil
+}
+
+// GetProviderDelegatedChains returns the sorted chain IDs on which the provider has
+// delegations at the given epoch. The chain IDs are decoded from the provider's
+// delegation indices, so the delegations themselves are not unmarshaled.
+func (k Keeper) GetProviderDelegatedChains(ctx sdk.Context, provider string, epoch uint64) []string {
+	// the empty chain bucket only belongs to the empty provider
+	includeEmptyChain := provider == types.EMPTY_PROVIDER
+
+	chains := map[string]struct{}{}
+	chainIDs := []string{}
+	for _, ind := range k.delegationIndicesWithPrefix(ctx, provider, includeEmptyChain) {
+		indProvider, _, chainID := types.DelegationKeyDecode(ind)
+		if indProvider != provider {
+			continue
+		}
+		if _, ok := chains[chainID]; ok {
+			continue
+		}
+		entry, err := k.delegationFS.FindRawEntry(ctx, ind, epoch)
+		if err != nil || entry.IsDeletedBy(epoch) {
+			continue
+		}
+		chains[chainID] = struct{}{}
+		chainIDs = append(chainIDs, chainID)
+	}
+
+	slices.Sort(chainIDs)
+	return chainIDs
+}
+
+// GetProviderDelegatorBreakdown gets the total delegation of each of the provider's
+// delegators (aggregated across the provider's chains), sorted by amount descending
+func (k Keeper) GetProviderDelegatorBreakdown(ctx sdk.Context, provider string, epoch uint64) ([]types.DelegatorContribution, error) {
+	delegations, err := k.GetProviderDelegators(ctx, provider, epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	totals := map[string]int{}
+	var contributions []types.DelegatorContribution
+	for _, d := range delegations {
+		i, ok := totals[d.Delegator]
+		if !ok {
+			totals[d.Delegator] = len(contributions)
+			contributions = append(contributions, types.DelegatorContribution{Delegator: d.Delegator, Amount: d.Amount})
+			continue
+		}
+		contributions[i].Amount = contributions[i].Amount.Add(d.Amount)
+	}
+
+	slices.SortFunc(contributions, func(i, j types.DelegatorContribution) bool {
+		if !i.Amount.Amount.Equal(j.Amount.Amount) {
+			return i.Amount.Amount.GT(j.Amount.Amount)
+		}
+		return i.Delegator < j.Delegator
+	})
+
+	return contributions, nil
+}
+
+// GetProviderTopDelegator gets the provider's largest delegator on the chain at the
+// given epoch (excluding the provider's self delegation), with its amount and its
+// share (in percent) of the provider's delegations total. Ties are broken by
+// address. If the provider has no delegators on the chain, found is false.
+func (k Keeper) GetProviderTopDelegator(ctx sdk.Context, provider, chainID string, epoch uint64) (delegator string, amount sdk.Coin, sharePct math.LegacyDec, found bool, err error) {
+	delegations, err := k.GetProviderDelegators(ctx, provider, epoch)
+	if err != nil {
+		return "", sdk.Coin{}, math.LegacyZeroDec(), false, err
+	}
+
+	total := math.ZeroInt()
+	for _, d := range delegations {
+		if d.ChainID != chainID || d.Delegator == provider {
+			continue
+		}
+		total = total.Add(d.Amount.Amount)
+		if !found || d.Amount.Amount.GT(amount.Amount) || (d.Amount.Amount.Equal(amount.Amount) && d.Delegator < delegator) {
+			delegator, amount, found = d.Delegator, d.Amount, true
+		}
+	}
+	if !found || total.IsZero() {
+		return "", sdk.Coin{}, math.LegacyZeroDec(), false, nil
+	}
+
+	sharePct = math.LegacyNewDecFromInt(amount.Amount).MulInt64(100).QuoInt(total)
+	return delegator, amount, sharePct, true, nil
+}
+
+// GetProvidersRankedByEffectiveStake returns the top providers on the chain at the
+// given epoch, sorted by descending effective stake (ties are broken by address).
+// A non-positive limit returns all the providers.
+func (k Keeper) GetProvidersRankedByEffectiveStake(ctx sdk.Context, chainID string, epoch uint64, limit int) ([]types.RankedProvider, error) {
+	stakeEntries, err := k.epochstorageKeeper.GetStakeEntryForAllProvidersEpoch(ctx, chainID, epoch)
+	if err != nil {
+		return nil, utils.LavaFormatWarning("cannot rank providers", err,
+			utils.LogAttr("chain_id", chainID),
+			utils.LogAttr("epoch", epoch),
+		)
+	}
+
+	ranked := make([]types.RankedProvider, 0, len(*stakeEntries))
+	for _, stakeEntry := range *stakeEntries {
+		ranked = append(ranked, types.RankedProvider{
+			Address:        stakeEntry.Address,
+			Stake:          stakeEntry.Stake,
+			DelegateTotal:  stakeEntry.DelegateTotal,
+			EffectiveStake: stakeEntry.EffectiveStake(),
+		})
+	}
+
+	slices.SortFunc(ranked, func(i, j types.RankedProvider) bool {
+		if !i.EffectiveStake.Equal(j.EffectiveStake) {
+			return i.EffectiveStake.GT(j.EffectiveStake)
+		}
+		return i.Address < j.Address
+	})
+
+	if limit > 0 && len(ranked) > limit {
+		ranked = ranked[:limit]
+	}
+	return ranked, nil
+}
+
+// GetProviderStakeRatioHistory gets the provider's self stake to delegations ratio
+// on the chain at each epoch in [fromEpoch, toEpoch], from the stake entries of
+// the epochs. Epochs where the provider wasn't staked yield points with Staked
+// unset. The range must be within the epochs whose stake entries are kept.
+func (k Keeper) GetProviderStakeRatioHistory(ctx sdk.Context, provider, chainID string, fromEpoch, toEpoch uint64) ([]types.RatioPoint, error) {
+	providerAddr, err := sdk.AccAddressFromBech32(provider)
+	if err != nil {
+		return nil, utils.LavaFormatWarning("invalid provider address", err,
+			utils.LogAttr("provider", provider),
+		)
+	}
+
+	currentEpoch := k.epochstorageKeeper.GetEpochStart(ctx)
+	if fromEpoch > toEpoch || toEpoch > currentEpoch {
+		return nil, utils.LavaFormatWarning("cannot get stake ratio history", fmt.Errorf("invalid epoch range"),
+			utils.LogAttr("from_epoch", fromEpoch),
+			utils.LogAttr("to_epoch", toEpoch),
+			utils.LogAttr("current_epoch", currentEpoch),
+		)
+	}
+
+	earliest := k.epochstorageKeeper.GetEarliestEpochStart(ctx)
+	if fromEpoch < earliest {
+		return nil, utils.LavaFormatWarning("cannot get stake ratio history of pruned epochs", types.ErrHeightPruned,
+			utils.LogAttr("from_epoch", fromEpoch),
+			utils.LogAttr("earliest_epoch", earliest),
+		)
+	}
+
+	epoch, _, err := k.epochstorageKeeper.GetEpochStartForBlock(ctx, fromEpoch)
+	if err != nil {
+		return nil, err
+	}
+	if epoch < fromEpoch {
+		if epoch, err = k.epochstorageKeeper.GetNextEpoch(ctx, epoch); err != nil {
+			return nil, err
+		}
+	}
+
+	denom := k.stakingKeeper.BondDenom(ctx)
+	var points []types.RatioPoint
+	for epoch <= toEpoch {
+		point := types.RatioPoint{
+			Epoch:         epoch,
+			Stake:         sdk.NewCoin(denom, math.ZeroInt()),
+			DelegateTotal: sdk.NewCoin(denom, math.ZeroInt()),
+			Ratio:         math.LegacyZeroDec(),
+		}
+		// a missing stake entry (or stake storage) means the provider wasn't staked
+		if stakeEntry, err := k.epochstorageKeeper.GetStakeEntryForProviderEpoch(ctx, chainID, providerAddr, epoch); err == nil {
+			point.Staked = true
+			point.Stake = stakeEntry.Stake
+			if !stakeEntry.DelegateTotal.IsNil() {
+				point.DelegateTotal = stakeEntry.DelegateTotal
+			}
+			if point.DelegateTotal.IsPositive() {
+				point.Ratio = math.LegacyNewDecFromInt(point.Stake.Amount).QuoInt(point.DelegateTotal.Amount)
+			}
+		}
+		points = append(points, point)
+
+		if epoch, err = k.epochstorageKeeper.GetNextEpoch(ctx, epoch); err != nil {
+			return nil, err
+		}
+	}
+
+	return points, nil
+}
+
+// GetDelegatableProviders returns the providers on the chain at the given epoch that
+// the delegator could still delegate to: providers that accept delegations (a
+// positive delegation limit, and an allowlist that permits the delegator) and whose
+// delegations haven't reached their limit. Providers the delegator already
+// delegates to on the chain are excluded.
+func (k Keeper) GetDelegatableProviders(ctx sdk.Context, delegator, chainID string, epoch uint64) ([]string, error) {
+	delegations, err := k.GetDelegatorDelegationsForChain(ctx, delegator, chainID, epoch)
+	if err != nil {
+		return nil, err
+	}
+	backed := map[string]struct{}{}
+	for _, d := range delegations {
+		backed[d.Provider] = struct{}{}
+	}
+
+	stakeEntries, err := k.epochstorageKeeper.GetStakeEntryForAllProvidersEpoch(ctx, chainID, epoch)
+	if err != nil {
+		return nil, utils.LavaFormatWarning("cannot get delegatable providers", err,
+			utils.LogAttr("chain_id", chainID),
+			utils.LogAttr("epoch", epoch),
+		)
+	}
+
+	providers := []string{}
+	for _, stakeEntry := range *stakeEntries {
+		if _, ok := backed[stakeEntry.Address]; ok || stakeEntry.Address == delegator {
+			continue
+		}
+		if stakeEntry.DelegateLimit.IsNil() || !stakeEntry.DelegateLimit.IsPositive() {
+			continue
+		}
+		if !stakeEntry.DelegateTotal.IsNil() && stakeEntry.DelegateTotal.Amount.GTE(stakeEntry.DelegateLimit.Amount) {
+			continue
+		}
+		if !k.IsDelegatorAllowed(ctx, stakeEntry.Address, delegator) {
+			continue
+		}
+		providers = append(providers, stakeEntry.Address)
+	}
+
+	return providers, nil
+}
+
 func (k Keeper) GetDelegation(ctx sdk.Context, delegator, provider, chainID string, epoch uint64) (types.Delegation, bool) {
 	var delegationEntry types.Delegation
 	index := types.DelegationKey(provider, delegator, chainID)
@@ -462,21 +1130,245 @@ func (k Keeper) GetDelegation(ctx sdk.Context, delegator, provider, chainID stri
 	return delegationEntry, found
 }
 
+// GetDelegationPendingChange returns the delegation's amount that is currently in
+// effect (as of the current epoch) and the amount that takes effect on the next
+// epoch. A missing delegation has a zero amount.
+func (k Keeper) GetDelegationPendingChange(ctx sdk.Context, delegator, provider, chainID string) (current, next sdk.Coin, err error) {
+	if _, err := sdk.AccAddressFromBech32(delegator); err != nil {
+		return current, next, utils.LavaFormatWarning("invalid delegator address", err,
+			utils.Attribute{Key: "delegator", Value: delegator},
+		)
+	}
+	if provider != types.EMPTY_PROVIDER {
+		if _, err := sdk.AccAddressFromBech32(provider); err != nil {
+			return current, next, utils.LavaFormatWarning("invalid provider address", err,
+				utils.Attribute{Key: "provider", Value: provider},
+			)
+		}
+	}
+
+	amountAt := func(epoch uint64) sdk.Coin {
+		if delegation, found := k.GetDelegation(ctx, delegator, provider, chainID, epoch); found {
+			return delegation.Amount
+		}
+		return sdk.NewCoin(k.stakingKeeper.BondDenom(ctx), math.ZeroInt())
+	}
+
+	current = amountAt(k.epochstorageKeeper.GetEpochStart(ctx))
+	next = amountAt(k.epochstorageKeeper.GetCurrentNextEpoch(ctx))
+	return current, next, nil
+}
+
+// GetDelegationChanges diffs the delegations in effect at fromEpoch against those
+// in effect at toEpoch and returns the added, removed and modified delegations.
+// Both epochs must not be stale, otherwise their versions may have been pruned.
+func (k Keeper) GetDelegationChanges(ctx sdk.Context, fromEpoch, toEpoch uint64) ([]types.DelegationChange, error) {
+	if fromEpoch > toEpoch {
+		return nil, utils.LavaFormatWarning("cannot get delegation changes", fmt.Errorf("invalid epoch range"),
+			utils.LogAttr("from_epoch", fromEpoch),
+			utils.LogAttr("to_epoch", toEpoch),
+		)
+	}
+
+	var changes []types.DelegationChange
+	// include deleted indices, so delegations removed within the range are reported
+	indices := k.delegationFS.AllEntryIndicesFilter(ctx, "", nil)
+	for _, ind := range indices {
+		var before, after types.Delegation
+		foundBefore := k.delegationFS.FindEntry(ctx, ind, fromEpoch, &before)
+		foundAfter := k.delegationFS.FindEntry(ctx, ind, toEpoch, &after)
+
+		provider, delegator, chainID := types.DelegationKeyDecode(ind)
+		change := types.DelegationChange{
+			Delegator: delegator,
+			Provider:  provider,
+			ChainID:   chainID,
+		}
+
+		switch {
+		case !foundBefore && foundAfter:
+			change.Type = types.DelegationAdded
+			change.Before = sdk.NewCoin(after.Amount.Denom, sdk.ZeroInt())
+			change.After = after.Amount
+		case foundBefore && !foundAfter:
+			change.Type = types.DelegationRemoved
+			change.Before = before.Amount
+			change.After = sdk.NewCoin(before.Amount.Denom, sdk.ZeroInt())
+		case foundBefore && foundAfter && !before.Amount.IsEqual(after.Amount):
+			change.Type = types.DelegationAmountChanged
+			change.Before = before.Amount
+			change.After = after.Amount
+		default:
+			continue
+		}
+
+		changes = append(changes, change)
+	}
+
+	return changes, nil
+}
+
+// GetDelegationAtHeight gets the delegation that was in effect at a past block
+// height. Heights older than the earliest saved epoch are rejected since their
+// fixation store versions may have already been pruned.
+func (k Keeper) GetDelegationAtHeight(ctx sdk.Context, delegator, provider, chainID string, height uint64) (types.Delegation, error) {
+	if height > uint64(ctx.BlockHeight()) {
+		return types.Delegation{}, utils.LavaFormatWarning("cannot get delegation at a future height", fmt.Errorf("invalid height"),
+			utils.LogAttr("height", height),
+			utils.LogAttr("current_height", ctx.BlockHeight()),
+		)
+	}
+
+	earliest := k.epochstorageKeeper.GetEarliestEpochStart(ctx)
+	if height < earliest {
+		return types.Delegation{}, utils.LavaFormatWarning("cannot get delegation at a pruned height", types.ErrHeightPruned,
+			utils.LogAttr("height", height),
+			utils.LogAttr("earliest_height", earliest),
+		)
+	}
+
+	delegation, found := k.GetDelegation(ctx, delegator, provider, chainID, height)
+	if !found {
+		return types.Delegation{}, utils.LavaFormatWarning("delegation not found at height", types.ErrDelegationNotFound,
+			utils.LogAttr("delegator", delegator),
+			utils.LogAttr("provider", provider),
+			utils.LogAttr("chain_id", chainID),
+			utils.LogAttr("height", height),
+		)
+	}
+
+	return delegation, nil
+}
+
+// GetEpochModifiedDelegations returns the delegations whose version in effect at
+// the given epoch was created at that epoch, i.e. the delegations added or changed
+// for that epoch. Delegations that were removed at that epoch have no version in
+// effect, so they are not included. The epoch may be at most the next epoch, and
+// epochs older than the earliest saved epoch are rejected as pruned.
+func (k Keeper) GetEpochModifiedDelegations(ctx sdk.Context, epoch uint64) ([]types.Delegation, error) {
+	nextEpoch := k.epochstorageKeeper.GetCurrentNextEpoch(ctx)
+	if epoch > nextEpoch {
+		return nil, utils.LavaFormatWarning("cannot get modified delegations of a future epoch", fmt.Errorf("invalid epoch"),
+			utils.LogAttr("epoch", epoch),
+			utils.LogAttr("next_epoch", nextEpoch),
+		)
+	}
+
+	earliest := k.epochstorageKeeper.GetEarliestEpochStart(ctx)
+	if epoch < earliest {
+		return nil, utils.LavaFormatWarning("cannot get modified delegations of a pruned epoch", types.ErrHeightPruned,
+			utils.LogAttr("epoch", epoch),
+			utils.LogAttr("earliest_epoch", earliest),
+		)
+	}
+
+	var delegations []types.Delegation
+	for _, ind := range k.delegationFS.GetAllEntryIndices(ctx) {
+		var delegation types.Delegation
+		entryBlock, _, _, found := k.delegationFS.FindEntryDetailed(ctx, ind, epoch, &delegation)
+		if found && entryBlock == epoch {
+			delegations = append(delegations, delegation)
+		}
+	}
+
+	return delegations, nil
+}
+
+// delegationIndicesWithPrefix gets the delegation indices with the given prefix. A
+// prefix with an empty chain ID matches all chains, which includes the empty chain
+// bucket (EMPTY_PROVIDER_CHAINID), so indices in that bucket are kept only when
+// includeEmptyChain is set.
+func (k Keeper) delegationIndicesWithPrefix(ctx sdk.Context, prefix string, includeEmptyChain bool) []string {
+	indices := k.delegationFS.GetAllEntryIndicesWithPrefix(ctx, prefix)
+	if includeEmptyChain {
+		return indices
+	}
+
+	filtered := []string{}
+	for _, ind := range indices {
+		if _, _, chainID := types.DelegationKeyDecode(ind); chainID != types.EMPTY_PROVIDER_CHAINID {
+			filtered = append(filtered, ind)
+		}
+	}
+	return filtered
+}
+
+// GetAllProviderDelegatorDelegations gets all the delegations of the delegator to
+// the provider on all chains, including the empty chain bucket. It is meant for
+// balance accounting, where every delegation counts.
 func (k Keeper) GetAllProviderDelegatorDelegations(ctx sdk.Context, delegator, provider string, epoch uint64) []types.Delegation {
+	return k.GetProviderDelegatorDelegations(ctx, delegator, provider, epoch, true)
+}
+
+// GetDelegatorPortfolio gets the delegator's delegations at the given epoch,
+// grouped by provider with a subtotal for each provider. The empty provider's
+// delegation (if any) is a group of its own.
+func (k Keeper) GetDelegatorPortfolio(ctx sdk.Context, delegator string, epoch uint64) ([]types.ProviderGroup, error) {
+	providers, err := k.GetDelegatorProviders(ctx, delegator, epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	denom := k.stakingKeeper.BondDenom(ctx)
+	groups := make([]types.ProviderGroup, 0, len(providers))
+	for _, provider := range providers {
+		delegations := k.GetAllProviderDelegatorDelegations(ctx, delegator, provider, epoch)
+		if len(delegations) == 0 {
+			continue
+		}
+		slices.SortFunc(delegations, func(i, j types.Delegation) bool {
+			return i.ChainID < j.ChainID
+		})
+
+		subtotal := sdk.NewCoin(denom, math.ZeroInt())
+		for _, delegation := range delegations {
+			if delegation.Amount.Denom != denom {
+				return nil, utils.LavaFormatError("delegation with unexpected denom", types.ErrBadDelegationAmount,
+					utils.LogAttr("delegator", delegator),
+					utils.LogAttr("provider", provider),
+					utils.LogAttr("chain_id", delegation.ChainID),
+					utils.LogAttr("amount", delegation.Amount),
+				)
+			}
+			subtotal = subtotal.Add(delegation.Amount)
+		}
+		groups = append(groups, types.ProviderGroup{
+			Provider:    provider,
+			Delegations: delegations,
+			Subtotal:    subtotal,
+		})
+	}
+
+	return groups, nil
+}
+
+// GetDelegatorPortfolioWithUnbonding gets the delegator's portfolio (like
+// GetDelegatorPortfolio), and separately the delegator's amount in the unbonding
+// hold, so that the delegator's whole exposure (bonded and unbonding) is reported.
+func (k Keeper) GetDelegatorPortfolioWithUnbonding(ctx sdk.Context, delegator string, epoch uint64) ([]types.ProviderGroup, sdk.Coin, error) {
+	groups, err := k.GetDelegatorPortfolio(ctx, delegator, epoch)
+	if err != nil {
+		return nil, sdk.Coin{}, err
+	}
+	unbonding, err := k.GetDelegatorUnbondingAmount(ctx, delegator)
+	if err != nil {
+		return nil, sdk.Coin{}, err
+	}
+	return groups, unbonding, nil
+}
+
+// GetProviderDelegatorDelegations gets the delegations of the delegator to the
+// provider on all chains, with or without the empty chain bucket.
+func (k Keeper) GetProviderDelegatorDelegations(ctx sdk.Context, delegator, provider string, epoch uint64, includeEmptyChain bool) []types.Delegation {
 	prefix := types.DelegationKey(provider, delegator, "")
-	indices := k.delegationFS.GetAllEntryIndicesWithPrefix(ctx, prefix)
+	indices := k.delegationIndicesWithPrefix(ctx, prefix, includeEmptyChain)
 
 	var delegations []types.Delegation
 	for _, ind := range indices {
 		var delegation types.Delegation
 		found := k.delegationFS.FindEntry(ctx, ind, epoch, &delegation)
 		if !found {
-			provider, delegator, chainID := types.DelegationKeyDecode(ind)
-			utils.LavaFormatError("delegationFS entry index has no entry", fmt.Errorf("provider delegation not found"),
-				utils.Attribute{Key: "delegator", Value: delegator},
-				utils.Attribute{Key: "provider", Value: provider},
-				utils.Attribute{Key: "chainID", Value: chainID},
-			)
+			reportDelegationIndexWithoutEntry(ind)
 			continue
 		}
 		delegations = append(delegations, delegation)
@@ -485,27 +1377,62 @@ func (k Keeper) GetAllProviderDelegatorDelegations(ctx sdk.Context, delegator, p
 	return delegations
 }
 
+// emitEmptyProviderRebalance emits an event for funds moved through the empty
+// provider programmatically (and not by a delegator's explicit request)
+func (k Keeper) emitEmptyProviderRebalance(ctx sdk.Context, delegator string, amount sdk.Coin, reason string) {
+	details := map[string]string{
+		"delegator": delegator,
+		"amount":    amount.String(),
+		"reason":    reason,
+	}
+	utils.LogLavaEvent(ctx, k.Logger(ctx), types.EmptyProviderRebalanceEventName, details, "Empty provider rebalance")
+}
+
+// UnbondUniformProviders unbonds the given amount from the delegator's
+// delegations, starting with the empty provider and then spreading the rest
+// uniformly across the other providers. The empty provider's funds that wait for
+// scheduled delegations are unbonded last, reducing the scheduled delegations
+// accordingly. The next epoch is fetched once so
+// that all the resulting unbonds take effect on the same epoch. Locked
+// delegations are skipped (unless the locks are ignored, as in forced unbonds),
+// and the unbond fails if the unlocked delegations cannot cover the amount.
 func (k Keeper) UnbondUniformProviders(ctx sdk.Context, delegator string, amount sdk.Coin) error {
+	return k.unbondUniformProviders(ctx, delegator, amount, k.getIgnoreDelegationLocks(ctx))
+}
+
+// unbondUniformProviders is UnbondUniformProviders, with the delegation locks
+// optionally ignored (for unbonds that cannot be refused, like slashing)
+func (k Keeper) unbondUniformProviders(ctx sdk.Context, delegator string, amount sdk.Coin, ignoreLocks bool) error {
 	epoch := k.epochstorageKeeper.GetCurrentNextEpoch(ctx)
 	providers, err := k.GetDelegatorProviders(ctx, delegator, epoch)
 	if err != nil {
 		return err
 	}
 
-	// first remove from the empty provider
+	// first remove from the empty provider, except for the funds waiting there for
+	// the delegator's scheduled delegations (which are removed last)
+	scheduledInEmpty := math.ZeroInt()
 	if lavaslices.Contains[string](providers, types.EMPTY_PROVIDER) {
 		delegation, found := k.GetDelegation(ctx, delegator, types.EMPTY_PROVIDER, types.EMPTY_PROVIDER_CHAINID, epoch)
 		if found {
-			if delegation.Amount.Amount.GTE(amount.Amount) {
+			scheduledInEmpty = math.MinInt(delegation.Amount.Amount, k.getDelegatorScheduledTotal(ctx, delegator))
+			free := delegation.Amount.SubAmount(scheduledInEmpty)
+			if free.Amount.GTE(amount.Amount) {
 				// we have enough here, remove all from empty delegator and bail
-				return k.unbond(ctx, delegator, types.EMPTY_PROVIDER, types.EMPTY_PROVIDER_CHAINID, amount)
-			} else {
+				err = k.unbond(ctx, delegator, types.EMPTY_PROVIDER, types.EMPTY_PROVIDER_CHAINID, amount, epoch)
+				if err != nil {
+					return err
+				}
+				k.emitEmptyProviderRebalance(ctx, delegator, amount, types.EmptyProviderRebalanceUniformUnbond)
+				return nil
+			} else if free.IsPositive() {
 				// we dont have enough in the empty provider, remove everything and continue with the rest
-				err = k.unbond(ctx, delegator, types.EMPTY_PROVIDER, types.EMPTY_PROVIDER_CHAINID, delegation.Amount)
+				err = k.unbond(ctx, delegator, types.EMPTY_PROVIDER, types.EMPTY_PROVIDER_CHAINID, free, epoch)
 				if err != nil {
 					return err
 				}
-				amount = amount.Sub(delegation.Amount)
+				k.emitEmptyProviderRebalance(ctx, delegator, free, types.EmptyProviderRebalanceUniformUnbond)
+				amount = amount.Sub(free)
 			}
 		}
 	}
@@ -517,6 +1444,45 @@ func (k Keeper) UnbondUniformProviders(ctx sdk.Context, delegator string, amount
 		delegations = append(delegations, k.GetAllProviderDelegatorDelegations(ctx, delegator, provider, epoch)...)
 	}
 
+	// skip the locked delegations, so unbonding through the staking module cannot
+	// bypass the locks
+	if !ignoreLocks {
+		var unlocked []types.Delegation
+		unlockedTotal := math.ZeroInt()
+		for _, d := range delegations {
+			if k.IsDelegationLocked(ctx, delegator, d.Provider, d.ChainID) {
+				continue
+			}
+			unlocked = append(unlocked, d)
+			unlockedTotal = unlockedTotal.Add(d.Amount.Amount)
+		}
+		if len(unlocked) < len(delegations) && unlockedTotal.Add(scheduledInEmpty).LT(amount.Amount) {
+			return utils.LavaFormatWarning("cannot unbond from locked delegations", types.ErrDelegationLocked,
+				utils.LogAttr("delegator", delegator),
+				utils.LogAttr("amount", amount),
+				utils.LogAttr("unlocked", unlockedTotal),
+			)
+		}
+		delegations = unlocked
+	}
+
+	// if the delegations cannot cover the amount, the shortfall is removed from the
+	// scheduled funds in the empty provider, cancelling scheduled delegations
+	delegationsTotal := math.ZeroInt()
+	for _, d := range delegations {
+		delegationsTotal = delegationsTotal.Add(d.Amount.Amount)
+	}
+	if shortfall := math.MinInt(amount.Amount.Sub(delegationsTotal), scheduledInEmpty); shortfall.IsPositive() {
+		shortfallCoin := sdk.NewCoin(amount.Denom, shortfall)
+		err = k.unbond(ctx, delegator, types.EMPTY_PROVIDER, types.EMPTY_PROVIDER_CHAINID, shortfallCoin, epoch)
+		if err != nil {
+			return err
+		}
+		k.emitEmptyProviderRebalance(ctx, delegator, shortfallCoin, types.EmptyProviderRebalanceUniformUnbond)
+		k.reduceScheduledDelegations(ctx, delegator, shortfall)
+		amount = amount.Sub(shortfallCoin)
+	}
+
 	slices.SortFunc(delegations, func(i, j types.Delegation) bool {
 		return i.Amount.IsLT(j.Amount)
 	})
@@ -527,7 +1493,9 @@ func (k Keeper) UnbondUniformProviders(ctx sdk.Context, delegator string, amount
 	}
 	unbondAmount := map[delegationKey]sdk.Coin{}
 
-	// first round of deduction
+	// first round of deduction: each delegation (smallest first) is deducted an equal
+	// share of the remaining amount, so the truncation remainders of the divisions
+	// roll over to the next (larger) delegations and the largest one takes the rest
 	for i := range delegations {
 		key := delegationKey{provider: delegations[i].Provider, chainID: delegations[i].ChainID}
 		amountToDeduct := amount.Amount.QuoRaw(int64(len(delegations) - i))
@@ -543,25 +1511,30 @@ func (k Keeper) UnbondUniformProviders(ctx sdk.Context, delegator string, amount
 		}
 	}
 
-	// we have leftovers, remove it ununiformaly
-	for i := range delegations {
+	// we have leftovers (delegations that could not cover their share), remove them
+	// from the largest delegations first so the total deducted is exactly the amount
+	for i := len(delegations) - 1; i >= 0; i-- {
 		if amount.IsZero() {
 			break
 		}
 		key := delegationKey{provider: delegations[i].Provider, chainID: delegations[i].ChainID}
+		coinToDeduct := amount
 		if delegations[i].Amount.Amount.LT(amount.Amount) {
-			unbondAmount[key].Add(delegations[i].Amount)
-			amount = amount.Sub(delegations[i].Amount)
-		} else {
-			unbondAmount[key].Add(amount)
-			amount = amount.Sub(amount)
+			coinToDeduct = delegations[i].Amount
 		}
+		unbondAmount[key] = unbondAmount[key].Add(coinToDeduct)
+		amount = amount.Sub(coinToDeduct)
+		delegations[i].Amount = delegations[i].Amount.Sub(coinToDeduct)
 	}
 
-	// now unbond all
+	// now unbond all (with an amount smaller than the number of delegations, some of
+	// them are deducted nothing)
 	for i := range delegations {
 		key := delegationKey{provider: delegations[i].Provider, chainID: delegations[i].ChainID}
-		err := k.unbond(ctx, delegator, delegations[i].Provider, delegations[i].ChainID, unbondAmount[key])
+		if unbondAmount[key].IsZero() {
+			continue
+		}
+		err := k.unbond(ctx, delegator, delegations[i].Provider, delegations[i].ChainID, unbondAmount[key], epoch)
 		if err != nil {
 			return err
 		}
@@ -597,3 +1570,34 @@ func (k Keeper) VerifyDelegatorBalance(ctx sdk.Context, delAddr sdk.AccAddress)
 
 	return sumValidatorDelegations.Sub(sumProviderDelegations), nil
 }
+
+// ReconcilePool compares the tokens held by the staking module for delegations
+// (the validators' tokens, in the bond denom) with the sum of all the provider
+// delegations (including the empty provider), and returns the difference
+// (pool-delegations). A non-zero difference indicates an accounting drift.
+func (k Keeper) ReconcilePool(ctx sdk.Context) (poolBalance, summedDelegations sdk.Coin, diff math.Int, err error) {
+	denom := k.stakingKeeper.BondDenom(ctx)
+	poolBalance = sdk.NewCoin(denom, math.ZeroInt())
+	summedDelegations = sdk.NewCoin(denom, math.ZeroInt())
+
+	for _, v := range k.stakingKeeper.GetAllValidators(ctx) {
+		poolBalance = poolBalance.AddAmount(v.Tokens)
+	}
+
+	nextEpoch := k.epochstorageKeeper.GetCurrentNextEpoch(ctx)
+	for _, ind := range k.delegationFS.GetAllEntryIndices(ctx) {
+		var delegation types.Delegation
+		if !k.delegationFS.FindEntry(ctx, ind, nextEpoch, &delegation) {
+			continue
+		}
+		if delegation.Amount.Denom != denom {
+			return poolBalance, summedDelegations, math.ZeroInt(), utils.LavaFormatError("delegation with unexpected denom", fmt.Errorf("reconcile pool failed"),
+				utils.Attribute{Key: "index", Value: ind},
+				utils.Attribute{Key: "amount", Value: delegation.Amount},
+			)
+		}
+		summedDelegations = summedDelegations.Add(delegation.Amount)
+	}
+
+	return poolBalance, summedDelegations, poolBalance.Amount.Sub(summedDelegations.Amount), nil
+}
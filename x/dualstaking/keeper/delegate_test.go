@@ -1,12 +1,25 @@
 package keeper_test
 
 import (
+	"context"
+	"strconv"
 	"testing"
+	"time"
 
+	"cosmossdk.io/math"
+	"github.com/armon/go-metrics"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	commontypes "github.com/lavanet/lava/common/types"
 	"github.com/lavanet/lava/testutil/common"
+	"github.com/lavanet/lava/utils"
+	"github.com/lavanet/lava/x/dualstaking/types"
+	epochstoragetypes "github.com/lavanet/lava/x/epochstorage/types"
+	spectypes "github.com/lavanet/lava/x/spec/types"
 	"github.com/stretchr/testify/require"
+	"golang.org/x/exp/slices"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 var zeroCoin = sdk.NewCoin(commontypes.TokenDenom, sdk.ZeroInt())
@@ -295,6 +308,72 @@ func TestRedelegate(t *testing.T) {
 	ts.verifyDelegatorsBalance()
 }
 
+func TestRedelegateToSelf(t *testing.T) {
+	ts := newTester(t)
+
+	// 1 delegator, 1 provider staked, 0 provider unstaked, 0 provider unstaking
+	ts.setupForDelegation(1, 1, 0, 0)
+
+	_, client1Addr := ts.GetAccount(common.CONSUMER, 0)
+	provider1Acct, provider1Addr := ts.GetAccount(common.PROVIDER, 0)
+
+	amount := sdk.NewCoin(commontypes.TokenDenom, sdk.NewInt(10000))
+	_, err := ts.TxDualstakingDelegate(client1Addr, provider1Addr, ts.spec.Name, amount)
+	require.NoError(t, err)
+	ts.AdvanceEpoch()
+
+	// redelegate to the same provider and chain (fail)
+	_, err = ts.TxDualstakingRedelegate(
+		client1Addr, provider1Addr, provider1Addr, ts.spec.Name, ts.spec.Name, amount)
+	require.ErrorIs(t, err, types.ErrRedelegateToSelf)
+
+	// redelegate from the empty provider to the empty provider (fail)
+	_, err = ts.TxDualstakingRedelegate(
+		client1Addr, types.EMPTY_PROVIDER, types.EMPTY_PROVIDER,
+		types.EMPTY_PROVIDER_CHAINID, types.EMPTY_PROVIDER_CHAINID, amount)
+	require.ErrorIs(t, err, types.ErrRedelegateToSelf)
+
+	ts.AdvanceEpoch()
+	stakeEntry := ts.getStakeEntry(provider1Acct.Addr, ts.spec.Name)
+	require.True(t, amount.IsEqual(stakeEntry.DelegateTotal))
+
+	ts.verifyDelegatorsBalance()
+}
+
+func TestRedelegateInvalidToChainID(t *testing.T) {
+	ts := newTester(t)
+
+	// 1 delegator, 2 provider staked, 0 provider unstaked, 0 provider unstaking
+	ts.setupForDelegation(1, 2, 0, 0)
+
+	_, client1Addr := ts.GetAccount(common.CONSUMER, 0)
+	_, provider1Addr := ts.GetAccount(common.PROVIDER, 0)
+	_, provider2Addr := ts.GetAccount(common.PROVIDER, 1)
+
+	amount := sdk.NewCoin(commontypes.TokenDenom, sdk.NewInt(10000))
+	_, err := ts.TxDualstakingDelegate(client1Addr, provider1Addr, ts.spec.Index, amount)
+	require.NoError(t, err)
+	ts.AdvanceEpoch()
+
+	keeper := ts.Keepers.Dualstaking
+	nextEpoch := ts.GetNextEpoch()
+	for _, toChainID := range []string{"bogus", types.EMPTY_PROVIDER_CHAINID} {
+		_, err = ts.TxDualstakingRedelegate(
+			client1Addr, provider1Addr, provider2Addr, ts.spec.Index, toChainID, amount)
+		require.Error(t, err)
+
+		// no fixation store mutation occurred
+		_, found := keeper.GetDelegation(ts.Ctx, client1Addr, provider2Addr, toChainID, nextEpoch)
+		require.False(t, found)
+		delegation, found := keeper.GetDelegation(ts.Ctx, client1Addr, provider1Addr, ts.spec.Index, nextEpoch)
+		require.True(t, found)
+		require.True(t, amount.IsEqual(delegation.Amount))
+		providers, err := keeper.GetDelegatorProviders(ts.Ctx, client1Addr, nextEpoch)
+		require.NoError(t, err)
+		require.Equal(t, []string{provider1Addr}, providers)
+	}
+}
+
 func TestUnbondFail(t *testing.T) {
 	ts := newTester(t)
 
@@ -449,6 +528,47 @@ func TestUnbond(t *testing.T) {
 	ts.verifyDelegatorsBalance()
 }
 
+func TestUnbondFraction(t *testing.T) {
+	ts := newTester(t)
+
+	// 1 delegator, 1 provider staked, 0 provider unstaked, 0 provider unstaking
+	ts.setupForDelegation(1, 1, 0, 0)
+
+	_, client1Addr := ts.GetAccount(common.CONSUMER, 0)
+	_, provider1Addr := ts.GetAccount(common.PROVIDER, 0)
+	validator, _ := ts.GetAccount(common.VALIDATOR, 0)
+	validatorAddr := sdk.ValAddress(validator.Addr).String()
+
+	amount := sdk.NewCoin(commontypes.TokenDenom, sdk.NewInt(10001))
+	_, err := ts.TxDualstakingDelegate(client1Addr, provider1Addr, ts.spec.Index, amount)
+	require.NoError(t, err)
+	ts.AdvanceEpoch()
+
+	// out of range fractions
+	for _, fraction := range []math.LegacyDec{math.LegacyZeroDec(), math.LegacyNewDec(-1), math.LegacyNewDecWithPrec(15, 1)} {
+		err = ts.Keepers.Dualstaking.UnbondFraction(ts.Ctx, client1Addr, validatorAddr, provider1Addr, ts.spec.Index, fraction, false)
+		require.Error(t, err)
+	}
+
+	// 50% of 10001 is truncated to 5000
+	err = ts.Keepers.Dualstaking.UnbondFraction(ts.Ctx, client1Addr, validatorAddr, provider1Addr, ts.spec.Index, math.LegacyNewDecWithPrec(5, 1), false)
+	require.NoError(t, err)
+	delegation, found := ts.Keepers.Dualstaking.GetDelegation(ts.Ctx, client1Addr, provider1Addr, ts.spec.Index, ts.GetNextEpoch())
+	require.True(t, found)
+	require.Equal(t, sdk.NewInt(5001), delegation.Amount.Amount)
+	ts.AdvanceEpoch()
+
+	// 100% unbonds the rest
+	err = ts.Keepers.Dualstaking.UnbondFraction(ts.Ctx, client1Addr, validatorAddr, provider1Addr, ts.spec.Index, math.LegacyOneDec(), false)
+	require.NoError(t, err)
+	_, found = ts.Keepers.Dualstaking.GetDelegation(ts.Ctx, client1Addr, provider1Addr, ts.spec.Index, ts.GetNextEpoch())
+	require.False(t, found)
+
+	// nothing left to unbond
+	err = ts.Keepers.Dualstaking.UnbondFraction(ts.Ctx, client1Addr, validatorAddr, provider1Addr, ts.spec.Index, math.LegacyOneDec(), false)
+	require.Error(t, err)
+}
+
 func TestBondUnbondBond(t *testing.T) {
 	ts := newTester(t)
 
@@ -619,3 +739,1522 @@ func TestStakingUnbondStakeIsLowerThanMinStakeCausesFreeze(t *testing.T) {
 	require.True(t, stakeEntry.Stake.IsZero())
 	require.True(t, stakeEntry.IsFrozen())
 }
+
+func TestGetDelegatorDelegationsForChain(t *testing.T) {
+	ts := newTester(t)
+
+	// 1 delegator, 2 provider staked, 0 provider unstaked, 0 provider unstaking
+	ts.setupForDelegation(1, 2, 0, 0)
+
+	_, client1Addr := ts.GetAccount(common.CONSUMER, 0)
+	_, provider1Addr := ts.GetAccount(common.PROVIDER, 0)
+	_, provider2Addr := ts.GetAccount(common.PROVIDER, 1)
+
+	spec1 := common.CreateMockSpec()
+	spec1.Index = "mock1"
+	spec1.Name = "mock1"
+	ts.AddSpec(spec1.Index, spec1)
+	err := ts.StakeProvider(provider2Addr, spec1, testStake)
+	require.NoError(t, err)
+
+	amount := sdk.NewCoin(commontypes.TokenDenom, sdk.NewInt(10000))
+	_, err = ts.TxDualstakingDelegate(client1Addr, provider1Addr, ts.spec.Index, amount)
+	require.NoError(t, err)
+	_, err = ts.TxDualstakingDelegate(client1Addr, provider2Addr, spec1.Index, amount)
+	require.NoError(t, err)
+
+	ts.AdvanceEpoch()
+
+	delegations, err := ts.Keepers.Dualstaking.GetDelegatorDelegationsForChain(ts.Ctx, client1Addr, spec1.Index, ts.EpochStart())
+	require.NoError(t, err)
+	require.Len(t, delegations, 1)
+	require.Equal(t, provider2Addr, delegations[0].Provider)
+	require.Equal(t, spec1.Index, delegations[0].ChainID)
+	require.True(t, amount.IsEqual(delegations[0].Amount))
+
+	_, err = ts.Keepers.Dualstaking.GetDelegatorDelegationsForChain(ts.Ctx, "invalid", spec1.Index, ts.EpochStart())
+	require.Error(t, err)
+
+	// the query shows a delegation increase on the chain only with pending delegations
+	_, err = ts.TxDualstakingDelegate(client1Addr, provider2Addr, spec1.Index, amount)
+	require.NoError(t, err)
+
+	res, err := ts.QueryDualstakingDelegatorChainDelegations(client1Addr, spec1.Index, false)
+	require.NoError(t, err)
+	require.Len(t, res.Delegations, 1)
+	require.True(t, amount.IsEqual(res.Delegations[0].Amount))
+
+	res, err = ts.QueryDualstakingDelegatorChainDelegations(client1Addr, spec1.Index, true)
+	require.NoError(t, err)
+	require.Len(t, res.Delegations, 1)
+	require.True(t, amount.Add(amount).IsEqual(res.Delegations[0].Amount))
+}
+
+func TestGetProviderDelegatorBreakdown(t *testing.T) {
+	ts := newTester(t)
+
+	// 2 delegators, 1 provider staked, 0 provider unstaked, 0 provider unstaking
+	ts.setupForDelegation(2, 1, 0, 0)
+
+	_, client1Addr := ts.GetAccount(common.CONSUMER, 0)
+	_, client2Addr := ts.GetAccount(common.CONSUMER, 1)
+	_, provider1Addr := ts.GetAccount(common.PROVIDER, 0)
+
+	// stake the provider on a second chain
+	spec1 := common.CreateMockSpec()
+	spec1.Index = "mock1"
+	spec1.Name = "mock1"
+	ts.AddSpec(spec1.Index, spec1)
+	err := ts.StakeProvider(provider1Addr, spec1, testStake)
+	require.NoError(t, err)
+
+	amount := sdk.NewCoin(commontypes.TokenDenom, sdk.NewInt(10000))
+	_, err = ts.TxDualstakingDelegate(client1Addr, provider1Addr, ts.spec.Index, amount)
+	require.NoError(t, err)
+	_, err = ts.TxDualstakingDelegate(client1Addr, provider1Addr, spec1.Index, amount)
+	require.NoError(t, err)
+	_, err = ts.TxDualstakingDelegate(client2Addr, provider1Addr, ts.spec.Index, amount)
+	require.NoError(t, err)
+
+	ts.AdvanceEpoch()
+
+	breakdown, err := ts.Keepers.Dualstaking.GetProviderDelegatorBreakdown(ts.Ctx, provider1Addr, ts.EpochStart())
+	require.NoError(t, err)
+
+	// the provider's self delegations are part of the breakdown too
+	var clients []types.DelegatorContribution
+	for _, c := range breakdown {
+		if c.Delegator == client1Addr || c.Delegator == client2Addr {
+			clients = append(clients, c)
+		}
+	}
+	require.Len(t, clients, 2)
+	require.Equal(t, client1Addr, clients[0].Delegator)
+	require.True(t, amount.Add(amount).IsEqual(clients[0].Amount))
+	require.Equal(t, client2Addr, clients[1].Delegator)
+	require.True(t, amount.IsEqual(clients[1].Amount))
+
+	for i := 1; i < len(breakdown); i++ {
+		require.True(t, breakdown[i-1].Amount.Amount.GTE(breakdown[i].Amount.Amount))
+	}
+}
+
+func TestGetProviderTopDelegator(t *testing.T) {
+	ts := newTester(t)
+
+	// 3 delegators, 1 provider staked, 0 provider unstaked, 0 provider unstaking
+	ts.setupForDelegation(3, 1, 0, 0)
+
+	_, client1Addr := ts.GetAccount(common.CONSUMER, 0)
+	_, client2Addr := ts.GetAccount(common.CONSUMER, 1)
+	_, client3Addr := ts.GetAccount(common.CONSUMER, 2)
+	_, provider1Addr := ts.GetAccount(common.PROVIDER, 0)
+
+	// only the provider's self delegation: no delegators yet
+	_, _, _, found, err := ts.Keepers.Dualstaking.GetProviderTopDelegator(ts.Ctx, provider1Addr, ts.spec.Index, ts.EpochStart())
+	require.NoError(t, err)
+	require.False(t, found)
+
+	amounts := map[string]int64{client1Addr: 30000, client2Addr: 50000, client3Addr: 20000}
+	for delegator, amount := range amounts {
+		_, err = ts.TxDualstakingDelegate(delegator, provider1Addr, ts.spec.Index, sdk.NewCoin(commontypes.TokenDenom, sdk.NewInt(amount)))
+		require.NoError(t, err)
+	}
+
+	ts.AdvanceEpoch()
+
+	delegator, amount, sharePct, found, err := ts.Keepers.Dualstaking.GetProviderTopDelegator(ts.Ctx, provider1Addr, ts.spec.Index, ts.EpochStart())
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, client2Addr, delegator)
+	require.True(t, sdk.NewCoin(commontypes.TokenDenom, sdk.NewInt(50000)).IsEqual(amount))
+	require.True(t, sharePct.Equal(math.LegacyNewDec(50)))
+
+	// no delegators on other chains
+	_, _, _, found, err = ts.Keepers.Dualstaking.GetProviderTopDelegator(ts.Ctx, provider1Addr, "mock1", ts.EpochStart())
+	require.NoError(t, err)
+	require.False(t, found)
+}
+
+func TestGetDelegationChanges(t *testing.T) {
+	ts := newTester(t)
+
+	// 1 delegator, 1 provider staked, 0 provider unstaked, 0 provider unstaking
+	ts.setupForDelegation(1, 1, 0, 0)
+
+	_, client1Addr := ts.GetAccount(common.CONSUMER, 0)
+	_, provider1Addr := ts.GetAccount(common.PROVIDER, 0)
+
+	clientChanges := func(changes []types.DelegationChange) []types.DelegationChange {
+		var res []types.DelegationChange
+		for _, c := range changes {
+			if c.Delegator == client1Addr && c.Provider == provider1Addr {
+				res = append(res, c)
+			}
+		}
+		return res
+	}
+
+	epoch0 := ts.EpochStart()
+
+	amount := sdk.NewCoin(commontypes.TokenDenom, sdk.NewInt(10000))
+	_, err := ts.TxDualstakingDelegate(client1Addr, provider1Addr, ts.spec.Index, amount)
+	require.NoError(t, err)
+	ts.AdvanceEpoch()
+	epoch1 := ts.EpochStart()
+
+	unbondAmount := sdk.NewCoin(commontypes.TokenDenom, sdk.NewInt(4000))
+	_, err = ts.TxDualstakingUnbond(client1Addr, provider1Addr, ts.spec.Index, unbondAmount)
+	require.NoError(t, err)
+	ts.AdvanceEpoch()
+	epoch2 := ts.EpochStart()
+
+	// the delegation was added
+	changes, err := ts.Keepers.Dualstaking.GetDelegationChanges(ts.Ctx, epoch0, epoch1)
+	require.NoError(t, err)
+	changes = clientChanges(changes)
+	require.Len(t, changes, 1)
+	require.Equal(t, types.DelegationAdded, changes[0].Type)
+	require.True(t, changes[0].After.IsEqual(amount))
+
+	// and then partially unbonded
+	changes, err = ts.Keepers.Dualstaking.GetDelegationChanges(ts.Ctx, epoch1, epoch2)
+	require.NoError(t, err)
+	changes = clientChanges(changes)
+	require.Len(t, changes, 1)
+	require.Equal(t, types.DelegationAmountChanged, changes[0].Type)
+	require.True(t, changes[0].Before.IsEqual(amount))
+	require.True(t, changes[0].After.IsEqual(amount.Sub(unbondAmount)))
+
+	// over the whole range it's a single addition
+	changes, err = ts.Keepers.Dualstaking.GetDelegationChanges(ts.Ctx, epoch0, epoch2)
+	require.NoError(t, err)
+	changes = clientChanges(changes)
+	require.Len(t, changes, 1)
+	require.Equal(t, types.DelegationAdded, changes[0].Type)
+
+	_, err = ts.Keepers.Dualstaking.GetDelegationChanges(ts.Ctx, epoch2, epoch0)
+	require.Error(t, err)
+}
+
+func TestDelegateUnstakedProviderNoStateChange(t *testing.T) {
+	ts := newTester(t)
+
+	// 1 delegator, 1 provider staked, 1 provider unstaked, 0 provider unstaking
+	ts.setupForDelegation(1, 1, 1, 0)
+
+	client1Acct, client1Addr := ts.GetAccount(common.CONSUMER, 0)
+	_, provider2Addr := ts.GetAccount(common.PROVIDER, 1)
+
+	balance := ts.GetBalance(client1Acct.Addr)
+
+	amount := sdk.NewCoin(commontypes.TokenDenom, sdk.NewInt(10000))
+	_, err := ts.TxDualstakingDelegate(client1Addr, provider2Addr, ts.spec.Index, amount)
+	require.Error(t, err)
+
+	nextEpoch := ts.GetNextEpoch()
+	_, found := ts.Keepers.Dualstaking.GetDelegation(ts.Ctx, client1Addr, provider2Addr, ts.spec.Index, nextEpoch)
+	require.False(t, found)
+	_, found = ts.Keepers.Dualstaking.GetDelegation(ts.Ctx, client1Addr, types.EMPTY_PROVIDER, types.EMPTY_PROVIDER_CHAINID, nextEpoch)
+	require.False(t, found)
+
+	providers, err := ts.Keepers.Dualstaking.GetDelegatorProviders(ts.Ctx, client1Addr, nextEpoch)
+	require.NoError(t, err)
+	require.Empty(t, providers)
+
+	require.Equal(t, balance, ts.GetBalance(client1Acct.Addr))
+}
+
+func TestProviderDelegatorCount(t *testing.T) {
+	ts := newTester(t)
+
+	// 5 delegators, 1 provider staked, 0 provider unstaked, 0 provider unstaking
+	ts.setupForDelegation(5, 1, 0, 0)
+
+	_, provider1Addr := ts.GetAccount(common.PROVIDER, 0)
+
+	amount := sdk.NewCoin(commontypes.TokenDenom, sdk.NewInt(10000))
+	for i := 0; i < 5; i++ {
+		_, clientAddr := ts.GetAccount(common.CONSUMER, i)
+		_, err := ts.TxDualstakingDelegate(clientAddr, provider1Addr, ts.spec.Index, amount)
+		require.NoError(t, err)
+	}
+
+	// delegations take effect on the next epoch
+	count, err := ts.Keepers.Dualstaking.GetProviderDelegatorCount(ts.Ctx, provider1Addr, ts.spec.Index, ts.EpochStart())
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), count) // the provider's self delegation
+
+	res, err := ts.QueryDualstakingProviderDelegatorCount(provider1Addr, ts.spec.Index, false)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), res.Count)
+
+	res, err = ts.QueryDualstakingProviderDelegatorCount(provider1Addr, ts.spec.Index, true)
+	require.NoError(t, err)
+	require.Equal(t, uint64(5+1), res.Count)
+
+	ts.AdvanceEpoch()
+
+	// 5 delegators and the provider's self delegation
+	count, err = ts.Keepers.Dualstaking.GetProviderDelegatorCount(ts.Ctx, provider1Addr, ts.spec.Index, ts.EpochStart())
+	require.NoError(t, err)
+	require.Equal(t, uint64(5+1), count)
+
+	count, err = ts.Keepers.Dualstaking.GetProviderDelegatorCount(ts.Ctx, provider1Addr, "mock1", ts.EpochStart())
+	require.NoError(t, err)
+	require.Zero(t, count)
+
+	// only the validator remains delegated to the empty provider
+	count, err = ts.Keepers.Dualstaking.GetProviderDelegatorCount(ts.Ctx, types.EMPTY_PROVIDER, types.EMPTY_PROVIDER_CHAINID, ts.EpochStart())
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), count)
+
+	_, err = ts.Keepers.Dualstaking.GetProviderDelegatorCount(ts.Ctx, "invalid", ts.spec.Index, ts.EpochStart())
+	require.Error(t, err)
+}
+
+func TestGetProviderDelegatedChains(t *testing.T) {
+	ts := newTester(t)
+
+	// 1 delegator, 1 provider staked, 0 provider unstaked, 0 provider unstaking
+	ts.setupForDelegation(1, 1, 0, 0)
+
+	_, client1Addr := ts.GetAccount(common.CONSUMER, 0)
+	_, provider1Addr := ts.GetAccount(common.PROVIDER, 0)
+
+	// stake the provider on two more chains
+	chainIDs := []string{ts.spec.Index}
+	for _, index := range []string{"mock2", "mock1"} {
+		spec := common.CreateMockSpec()
+		spec.Index = index
+		spec.Name = index
+		ts.AddSpec(spec.Index, spec)
+		err := ts.StakeProvider(provider1Addr, spec, testStake)
+		require.NoError(t, err)
+		chainIDs = append(chainIDs, index)
+	}
+	slices.Sort(chainIDs)
+
+	amount := sdk.NewCoin(commontypes.TokenDenom, sdk.NewInt(10000))
+	for _, chainID := range chainIDs {
+		_, err := ts.TxDualstakingDelegate(client1Addr, provider1Addr, chainID, amount)
+		require.NoError(t, err)
+	}
+	ts.AdvanceEpoch()
+
+	// the delegator and the provider's self delegations share chains, no duplicates
+	chains := ts.Keepers.Dualstaking.GetProviderDelegatedChains(ts.Ctx, provider1Addr, ts.EpochStart())
+	require.Equal(t, chainIDs, chains)
+
+	chains = ts.Keepers.Dualstaking.GetProviderDelegatedChains(ts.Ctx, client1Addr, ts.EpochStart())
+	require.Empty(t, chains)
+}
+
+func TestDelegateToProviderChains(t *testing.T) {
+	ts := newTester(t)
+
+	// 1 delegator, 1 provider staked, 0 provider unstaked, 0 provider unstaking
+	ts.setupForDelegation(1, 1, 0, 0)
+
+	client1Acct, client1Addr := ts.GetAccount(common.CONSUMER, 0)
+	provider1Acct, provider1Addr := ts.GetAccount(common.PROVIDER, 0)
+	validator, _ := ts.GetAccount(common.VALIDATOR, 0)
+	validatorAddr := sdk.ValAddress(validator.Addr).String()
+
+	// stake the provider on a second chain
+	spec1 := common.CreateMockSpec()
+	spec1.Index = "mock1"
+	spec1.Name = "mock1"
+	ts.AddSpec(spec1.Index, spec1)
+	err := ts.StakeProvider(provider1Addr, spec1, testStake)
+	require.NoError(t, err)
+
+	// the delegations update the current stake entries right away
+	delegateTotal := func(chainID string) sdk.Coin {
+		stakeEntry, found, _ := ts.Keepers.Epochstorage.GetStakeEntryByAddressCurrent(ts.Ctx, chainID, provider1Acct.Addr)
+		require.True(t, found)
+		return stakeEntry.DelegateTotal
+	}
+	delegateTotal0 := delegateTotal(ts.spec.Index)
+	delegateTotal1 := delegateTotal(spec1.Index)
+
+	amount0 := sdk.NewCoin(commontypes.TokenDenom, sdk.NewInt(10000))
+	amount1 := sdk.NewCoin(commontypes.TokenDenom, sdk.NewInt(20000))
+
+	// a single invalid chain (delegated last, in sorted order) fails all the delegations
+	err = ts.Keepers.Dualstaking.DelegateToProviderChains(ts.Ctx, client1Addr, validatorAddr, provider1Addr,
+		map[string]sdk.Coin{ts.spec.Index: amount0, spec1.Index: amount1, "unknown": amount0})
+	require.Error(t, err)
+	require.Equal(t, delegateTotal0, delegateTotal(ts.spec.Index))
+	require.Equal(t, delegateTotal1, delegateTotal(spec1.Index))
+	_, found := ts.Keepers.Dualstaking.GetDelegation(ts.Ctx, client1Addr, provider1Addr, ts.spec.Index, ts.GetNextEpoch())
+	require.False(t, found)
+
+	// (the mock bank keeper's balances are kept outside the store, so take them after the failure)
+	balance := ts.GetBalance(client1Acct.Addr)
+	err = ts.Keepers.Dualstaking.DelegateToProviderChains(ts.Ctx, client1Addr, validatorAddr, provider1Addr,
+		map[string]sdk.Coin{ts.spec.Index: amount0, spec1.Index: amount1})
+	require.NoError(t, err)
+	require.Equal(t, delegateTotal0.Add(amount0), delegateTotal(ts.spec.Index))
+	require.Equal(t, delegateTotal1.Add(amount1), delegateTotal(spec1.Index))
+	require.Equal(t, balance-amount0.Amount.Int64()-amount1.Amount.Int64(), ts.GetBalance(client1Acct.Addr))
+
+	ts.AdvanceEpoch()
+	delegations, err := ts.Keepers.Dualstaking.GetDelegatorProviders(ts.Ctx, client1Addr, ts.EpochStart())
+	require.NoError(t, err)
+	require.Equal(t, []string{provider1Addr}, delegations)
+}
+
+func TestGetProvidersRankedByEffectiveStake(t *testing.T) {
+	ts := newTester(t)
+
+	// 1 delegator, 0 provider staked, 0 provider unstaked, 0 provider unstaking
+	ts.setupForDelegation(1, 0, 0, 0)
+	_, client1Addr := ts.GetAccount(common.CONSUMER, 0)
+
+	// providers with different self stake/delegation mixes: provider0 and provider3
+	// only have self stake (tied), provider1 has a double self stake and provider2
+	// has delegations that raise its effective stake above both
+	err := ts.addProviders(4)
+	require.NoError(t, err)
+	providers := make([]string, 4)
+	for i, stake := range []int64{testStake, 2 * testStake, testStake, testStake} {
+		_, providers[i] = ts.GetAccount(common.PROVIDER, i)
+		err := ts.StakeProvider(providers[i], ts.spec, stake)
+		require.NoError(t, err)
+	}
+
+	provider2Acct, _ := ts.GetAccount(common.PROVIDER, 2)
+	stakeEntry, found, index := ts.Keepers.Epochstorage.GetStakeEntryByAddressCurrent(ts.Ctx, ts.spec.Index, provider2Acct.Addr)
+	require.True(t, found)
+	stakeEntry.DelegateLimit = sdk.NewCoin(commontypes.TokenDenom, sdk.NewInt(10*testStake))
+	ts.Keepers.Epochstorage.ModifyStakeEntryCurrent(ts.Ctx, ts.spec.Index, stakeEntry, index)
+
+	_, err = ts.TxDualstakingDelegate(client1Addr, providers[2], ts.spec.Index, sdk.NewCoin(commontypes.TokenDenom, sdk.NewInt(2*testStake)))
+	require.NoError(t, err)
+	// beyond the delegation limit, so it doesn't count
+	_, err = ts.TxDualstakingDelegate(client1Addr, providers[0], ts.spec.Index, sdk.NewCoin(commontypes.TokenDenom, sdk.NewInt(testStake)))
+	require.NoError(t, err)
+	ts.AdvanceEpoch()
+
+	ranked, err := ts.Keepers.Dualstaking.GetProvidersRankedByEffectiveStake(ts.Ctx, ts.spec.Index, ts.EpochStart(), 0)
+	require.NoError(t, err)
+	require.Len(t, ranked, 4)
+
+	tied := []string{providers[0], providers[3]}
+	slices.Sort(tied)
+	expected := append([]string{providers[2], providers[1]}, tied...)
+	for i := range ranked {
+		require.Equal(t, expected[i], ranked[i].Address)
+	}
+	require.Equal(t, sdk.NewInt(3*testStake), ranked[0].EffectiveStake)
+	require.Equal(t, sdk.NewInt(2*testStake), ranked[1].EffectiveStake)
+
+	ranked, err = ts.Keepers.Dualstaking.GetProvidersRankedByEffectiveStake(ts.Ctx, ts.spec.Index, ts.EpochStart(), 2)
+	require.NoError(t, err)
+	require.Len(t, ranked, 2)
+	require.Equal(t, providers[2], ranked[0].Address)
+	require.Equal(t, providers[1], ranked[1].Address)
+
+	_, err = ts.Keepers.Dualstaking.GetProvidersRankedByEffectiveStake(ts.Ctx, "invalid", ts.EpochStart(), 0)
+	require.Error(t, err)
+}
+
+func TestGetDelegationAtHeight(t *testing.T) {
+	ts := newTester(t)
+
+	// 1 delegator, 1 provider staked, 0 provider unstaked, 0 provider unstaking
+	ts.setupForDelegation(1, 1, 0, 0)
+
+	_, client1Addr := ts.GetAccount(common.CONSUMER, 0)
+	_, provider1Addr := ts.GetAccount(common.PROVIDER, 0)
+
+	amount := sdk.NewCoin(commontypes.TokenDenom, sdk.NewInt(10000))
+	_, err := ts.TxDualstakingDelegate(client1Addr, provider1Addr, ts.spec.Index, amount)
+	require.NoError(t, err)
+	ts.AdvanceEpoch()
+	ts.AdvanceBlock()
+	height := ts.BlockHeight()
+
+	// a later change doesn't affect the delegation at the earlier height
+	_, err = ts.TxDualstakingDelegate(client1Addr, provider1Addr, ts.spec.Index, amount)
+	require.NoError(t, err)
+	ts.AdvanceEpoch()
+
+	delegation, err := ts.Keepers.Dualstaking.GetDelegationAtHeight(ts.Ctx, client1Addr, provider1Addr, ts.spec.Index, height)
+	require.NoError(t, err)
+	require.True(t, amount.IsEqual(delegation.Amount))
+
+	delegation, err = ts.Keepers.Dualstaking.GetDelegationAtHeight(ts.Ctx, client1Addr, provider1Addr, ts.spec.Index, ts.BlockHeight())
+	require.NoError(t, err)
+	require.True(t, amount.Add(amount).IsEqual(delegation.Amount))
+
+	_, err = ts.Keepers.Dualstaking.GetDelegationAtHeight(ts.Ctx, client1Addr, provider1Addr, ts.spec.Index, ts.BlockHeight()+1)
+	require.Error(t, err)
+
+	res, err := ts.QueryDualstakingDelegationAtHeight(client1Addr, provider1Addr, ts.spec.Index, height)
+	require.NoError(t, err)
+	require.True(t, amount.IsEqual(res.Delegation.Amount))
+
+	// once the height is no longer saved, it is rejected
+	ts.AdvanceEpochUntilStale()
+	_, err = ts.Keepers.Dualstaking.GetDelegationAtHeight(ts.Ctx, client1Addr, provider1Addr, ts.spec.Index, height)
+	require.ErrorIs(t, err, types.ErrHeightPruned)
+
+	_, err = ts.QueryDualstakingDelegationAtHeight(client1Addr, provider1Addr, ts.spec.Index, height)
+	require.ErrorIs(t, err, types.ErrHeightPruned)
+}
+
+func TestRecomputeProviderDelegateTotal(t *testing.T) {
+	ts := newTester(t)
+
+	// 1 delegator, 1 provider staked, 0 provider unstaked, 0 provider unstaking
+	ts.setupForDelegation(1, 1, 0, 0)
+
+	_, client1Addr := ts.GetAccount(common.CONSUMER, 0)
+	provider1Acct, provider1Addr := ts.GetAccount(common.PROVIDER, 0)
+
+	amount := sdk.NewCoin(commontypes.TokenDenom, sdk.NewInt(10000))
+	_, err := ts.TxDualstakingDelegate(client1Addr, provider1Addr, ts.spec.Index, amount)
+	require.NoError(t, err)
+
+	keeper := ts.Keepers.Epochstorage
+	stakeEntry, found, index := keeper.GetStakeEntryByAddressCurrent(ts.Ctx, ts.spec.Index, provider1Acct.Addr)
+	require.True(t, found)
+	require.True(t, amount.IsEqual(stakeEntry.DelegateTotal))
+	expectedStake := stakeEntry.Stake
+
+	// corrupt the stake entry
+	stakeEntry.DelegateTotal = stakeEntry.DelegateTotal.AddAmount(sdk.NewInt(1234))
+	stakeEntry.Stake = stakeEntry.Stake.SubAmount(sdk.NewInt(1))
+	keeper.ModifyStakeEntryCurrent(ts.Ctx, ts.spec.Index, stakeEntry, index)
+
+	err = ts.Keepers.Dualstaking.RecomputeProviderDelegateTotal(ts.Ctx, provider1Addr, ts.spec.Index)
+	require.NoError(t, err)
+
+	stakeEntry, found, _ = keeper.GetStakeEntryByAddressCurrent(ts.Ctx, ts.spec.Index, provider1Acct.Addr)
+	require.True(t, found)
+	require.True(t, amount.IsEqual(stakeEntry.DelegateTotal))
+	require.True(t, expectedStake.IsEqual(stakeEntry.Stake))
+
+	// the delegations and the stake entry are in sync again
+	ts.AdvanceEpoch()
+	ts.verifyDelegatorsBalance()
+
+	err = ts.Keepers.Dualstaking.RecomputeProviderDelegateTotal(ts.Ctx, provider1Addr, "mock1")
+	require.Error(t, err)
+}
+
+func TestProviderQueriesExcludeEmptyChain(t *testing.T) {
+	ts := newTester(t)
+
+	// 1 delegator, 1 provider staked, 0 provider unstaked, 0 provider unstaking
+	ts.setupForDelegation(1, 1, 0, 0)
+
+	_, client1Addr := ts.GetAccount(common.CONSUMER, 0)
+	_, provider1Addr := ts.GetAccount(common.PROVIDER, 0)
+
+	amount := sdk.NewCoin(commontypes.TokenDenom, sdk.NewInt(10000))
+	_, err := ts.TxDualstakingDelegate(client1Addr, provider1Addr, ts.spec.Index, amount)
+	require.NoError(t, err)
+
+	// a (legacy) delegation to a real provider in the empty chain bucket
+	emptyChainDelegation := types.NewDelegation(client1Addr, provider1Addr, types.EMPTY_PROVIDER_CHAINID, ts.Ctx.BlockTime(), commontypes.TokenDenom)
+	emptyChainDelegation.AddAmount(amount)
+	err = ts.Keepers.Dualstaking.AppendDelegationForTesting(ts.Ctx, emptyChainDelegation, ts.GetNextEpoch())
+	require.NoError(t, err)
+
+	ts.AdvanceEpoch()
+
+	delegations := ts.Keepers.Dualstaking.GetProviderDelegatorDelegations(ts.Ctx, client1Addr, provider1Addr, ts.EpochStart(), false)
+	require.Len(t, delegations, 1)
+	require.Equal(t, ts.spec.Index, delegations[0].ChainID)
+
+	delegations = ts.Keepers.Dualstaking.GetProviderDelegatorDelegations(ts.Ctx, client1Addr, provider1Addr, ts.EpochStart(), true)
+	require.Len(t, delegations, 2)
+	delegations = ts.Keepers.Dualstaking.GetAllProviderDelegatorDelegations(ts.Ctx, client1Addr, provider1Addr, ts.EpochStart())
+	require.Len(t, delegations, 2)
+
+	// provider-scoped queries exclude the empty chain bucket
+	delegations, err = ts.Keepers.Dualstaking.GetProviderDelegators(ts.Ctx, provider1Addr, ts.EpochStart())
+	require.NoError(t, err)
+	for _, d := range delegations {
+		require.NotEqual(t, types.EMPTY_PROVIDER_CHAINID, d.ChainID)
+	}
+
+	// but not for the empty provider, whose delegations are all in that bucket
+	delegations, err = ts.Keepers.Dualstaking.GetProviderDelegators(ts.Ctx, types.EMPTY_PROVIDER, ts.EpochStart())
+	require.NoError(t, err)
+	require.NotEmpty(t, delegations)
+}
+
+func TestGetProviderDelegatorsCancelled(t *testing.T) {
+	ts := newTester(t)
+
+	// 3 delegators, 1 provider staked, 0 provider unstaked, 0 provider unstaking
+	ts.setupForDelegation(3, 1, 0, 0)
+
+	_, provider1Addr := ts.GetAccount(common.PROVIDER, 0)
+
+	amount := sdk.NewCoin(commontypes.TokenDenom, sdk.NewInt(10000))
+	for i := 0; i < 3; i++ {
+		_, clientAddr := ts.GetAccount(common.CONSUMER, i)
+		_, err := ts.TxDualstakingDelegate(clientAddr, provider1Addr, ts.spec.Index, amount)
+		require.NoError(t, err)
+	}
+	ts.AdvanceEpoch()
+
+	delegations, err := ts.Keepers.Dualstaking.GetProviderDelegators(ts.Ctx, provider1Addr, ts.EpochStart())
+	require.NoError(t, err)
+	require.Len(t, delegations, 4) // 3 delegators + self delegation
+
+	// a cancelled query returns without looking up the delegators
+	cancelCtx, cancel := context.WithCancel(ts.Ctx.Context())
+	cancel()
+	goCtx := sdk.WrapSDKContext(ts.Ctx.WithContext(cancelCtx))
+	res, err := ts.Keepers.Dualstaking.ProviderDelegators(goCtx, &types.QueryProviderDelegatorsRequest{Provider: provider1Addr})
+	require.Equal(t, codes.Canceled, status.Code(err))
+	require.Nil(t, res)
+
+	// the keeper ignores the cancellation, so block processing is never affected
+	delegations, err = ts.Keepers.Dualstaking.GetProviderDelegators(ts.Ctx.WithContext(cancelCtx), provider1Addr, ts.EpochStart())
+	require.NoError(t, err)
+	require.Len(t, delegations, 4)
+}
+
+func TestDelegateProviderNotStakedOnChain(t *testing.T) {
+	ts := newTester(t)
+
+	// 1 delegator, 1 provider staked, 0 provider unstaked, 0 provider unstaking
+	ts.setupForDelegation(1, 1, 0, 0)
+
+	// a second chain, on which the provider isn't staked
+	spec1 := common.CreateMockSpec()
+	spec1.Index = "mock1"
+	spec1.Name = "mock1"
+	ts.AddSpec(spec1.Index, spec1)
+
+	_, client1Addr := ts.GetAccount(common.CONSUMER, 0)
+	_, provider1Addr := ts.GetAccount(common.PROVIDER, 0)
+
+	require.True(t, ts.Keepers.Dualstaking.IsProviderStakedOnChain(ts.Ctx, provider1Addr, ts.spec.Index))
+	require.False(t, ts.Keepers.Dualstaking.IsProviderStakedOnChain(ts.Ctx, provider1Addr, spec1.Index))
+	require.False(t, ts.Keepers.Dualstaking.IsProviderStakedOnChain(ts.Ctx, "invalid", ts.spec.Index))
+
+	amount := sdk.NewCoin(commontypes.TokenDenom, sdk.NewInt(10000))
+	_, err := ts.TxDualstakingDelegate(client1Addr, provider1Addr, spec1.Index, amount)
+	require.ErrorIs(t, err, epochstoragetypes.ErrProviderNotStaked)
+	require.ErrorContains(t, err, "provider "+provider1Addr+" is not staked on chain "+spec1.Index)
+
+	_, err = ts.TxDualstakingDelegate(client1Addr, provider1Addr, ts.spec.Index, amount)
+	require.NoError(t, err)
+}
+
+func TestReconcilePool(t *testing.T) {
+	ts := newTester(t)
+
+	// 2 delegators, 1 provider staked, 0 provider unstaked, 0 provider unstaking
+	ts.setupForDelegation(2, 1, 0, 0)
+
+	_, client1Addr := ts.GetAccount(common.CONSUMER, 0)
+	_, client2Addr := ts.GetAccount(common.CONSUMER, 1)
+	_, provider1Addr := ts.GetAccount(common.PROVIDER, 0)
+
+	amount := sdk.NewCoin(commontypes.TokenDenom, sdk.NewInt(10000))
+	_, err := ts.TxDualstakingDelegate(client1Addr, provider1Addr, ts.spec.Index, amount)
+	require.NoError(t, err)
+	_, err = ts.TxDualstakingDelegate(client2Addr, provider1Addr, ts.spec.Index, amount.Add(amount))
+	require.NoError(t, err)
+	ts.AdvanceEpoch()
+
+	pool, summed, diff, err := ts.Keepers.Dualstaking.ReconcilePool(ts.Ctx)
+	require.NoError(t, err)
+	require.True(t, diff.IsZero())
+	require.Equal(t, pool, summed)
+	require.True(t, summed.Amount.GTE(amount.Amount.MulRaw(3)))
+
+	// corrupt the state with a delegation that has no staking module counterpart
+	corrupt := types.NewDelegation(client1Addr, provider1Addr, ts.spec.Index, ts.Ctx.BlockTime(), commontypes.TokenDenom)
+	corrupt.AddAmount(amount.Add(amount))
+	err = ts.Keepers.Dualstaking.AppendDelegationForTesting(ts.Ctx, corrupt, ts.GetNextEpoch())
+	require.NoError(t, err)
+
+	_, summedAfter, diff, err := ts.Keepers.Dualstaking.ReconcilePool(ts.Ctx)
+	require.NoError(t, err)
+	require.Equal(t, amount.Amount.Neg(), diff)
+	require.Equal(t, summed.Add(amount), summedAfter)
+
+	res, err := ts.QueryDualstakingPoolReconciliation()
+	require.NoError(t, err)
+	require.Equal(t, pool, res.PoolBalance)
+	require.Equal(t, summedAfter, res.SummedDelegations)
+	require.Equal(t, diff, res.Diff)
+}
+
+func TestRedelegateDenomMismatch(t *testing.T) {
+	ts := newTester(t)
+
+	// 1 delegator, 2 provider staked, 0 provider unstaked, 0 provider unstaking
+	ts.setupForDelegation(1, 2, 0, 0)
+
+	_, client1Addr := ts.GetAccount(common.CONSUMER, 0)
+	_, provider1Addr := ts.GetAccount(common.PROVIDER, 0)
+	_, provider2Addr := ts.GetAccount(common.PROVIDER, 1)
+
+	// a (corrupt) delegation in another denom
+	delegation := types.NewDelegation(client1Addr, provider1Addr, ts.spec.Index, ts.Ctx.BlockTime(), "otherdenom")
+	delegation.AddAmount(sdk.NewCoin("otherdenom", sdk.NewInt(10000)))
+	err := ts.Keepers.Dualstaking.AppendDelegationForTesting(ts.Ctx, delegation, ts.GetNextEpoch())
+	require.NoError(t, err)
+
+	amount := sdk.NewCoin(commontypes.TokenDenom, sdk.NewInt(5000))
+	_, err = ts.TxDualstakingRedelegate(client1Addr, provider1Addr, provider2Addr, ts.spec.Index, ts.spec.Index, amount)
+	require.ErrorIs(t, err, types.ErrBadDelegationAmount)
+
+	_, found := ts.Keepers.Dualstaking.GetDelegation(ts.Ctx, client1Addr, provider2Addr, ts.spec.Index, ts.GetNextEpoch())
+	require.False(t, found)
+}
+
+func TestDelegatorChainTotals(t *testing.T) {
+	ts := newTester(t)
+
+	// 1 delegator, 2 provider staked, 0 provider unstaked, 0 provider unstaking
+	ts.setupForDelegation(1, 2, 0, 0)
+
+	_, client1Addr := ts.GetAccount(common.CONSUMER, 0)
+	_, provider1Addr := ts.GetAccount(common.PROVIDER, 0)
+	_, provider2Addr := ts.GetAccount(common.PROVIDER, 1)
+
+	// stake the second provider on a second chain
+	spec1 := common.CreateMockSpec()
+	spec1.Index = "mock1"
+	spec1.Name = "mock1"
+	ts.AddSpec(spec1.Index, spec1)
+	err := ts.StakeProvider(provider2Addr, spec1, testStake)
+	require.NoError(t, err)
+
+	amount := sdk.NewCoin(commontypes.TokenDenom, sdk.NewInt(10000))
+	_, err = ts.TxDualstakingDelegate(client1Addr, provider1Addr, ts.spec.Index, amount)
+	require.NoError(t, err)
+	_, err = ts.TxDualstakingDelegate(client1Addr, provider2Addr, ts.spec.Index, amount)
+	require.NoError(t, err)
+	_, err = ts.TxDualstakingDelegate(client1Addr, provider2Addr, spec1.Index, amount)
+	require.NoError(t, err)
+	ts.AdvanceEpoch()
+
+	half := sdk.NewCoin(commontypes.TokenDenom, sdk.NewInt(5000))
+	_, err = ts.TxDualstakingRedelegate(client1Addr, provider1Addr, provider2Addr, ts.spec.Index, spec1.Index, half)
+	require.NoError(t, err)
+	_, err = ts.TxDualstakingUnbond(client1Addr, provider2Addr, ts.spec.Index, amount)
+	require.NoError(t, err)
+	_, err = ts.TxDualstakingUnbond(client1Addr, provider2Addr, spec1.Index, half)
+	require.NoError(t, err)
+
+	// recompute the totals from the delegations themselves
+	expected := map[string]math.Int{}
+	providers, err := ts.Keepers.Dualstaking.GetDelegatorProviders(ts.Ctx, client1Addr, ts.GetNextEpoch())
+	require.NoError(t, err)
+	for _, provider := range providers {
+		delegations := ts.Keepers.Dualstaking.GetAllProviderDelegatorDelegations(ts.Ctx, client1Addr, provider, ts.GetNextEpoch())
+		for _, d := range delegations {
+			if total, ok := expected[d.ChainID]; ok {
+				expected[d.ChainID] = total.Add(d.Amount.Amount)
+			} else {
+				expected[d.ChainID] = d.Amount.Amount
+			}
+		}
+	}
+
+	totals := ts.Keepers.Dualstaking.GetDelegatorChainTotals(ts.Ctx, client1Addr)
+	require.Len(t, totals, len(expected))
+	for chainID, total := range expected {
+		require.True(t, total.Equal(totals[chainID]), "chain %s: expected %s got %s", chainID, total, totals[chainID])
+	}
+	require.True(t, totals[ts.spec.Index].Equal(sdk.NewInt(5000)))
+	require.True(t, totals[spec1.Index].Equal(sdk.NewInt(10000)))
+
+	// rebuilding from the delegations yields the same totals
+	ts.Keepers.Dualstaking.RebuildDelegatorChainTotals(ts.Ctx)
+	rebuilt := ts.Keepers.Dualstaking.GetDelegatorChainTotals(ts.Ctx, client1Addr)
+	require.Len(t, rebuilt, len(totals))
+	for chainID, total := range totals {
+		require.True(t, total.Equal(rebuilt[chainID]))
+	}
+}
+
+func TestSelfUnbondMissingSpec(t *testing.T) {
+	ts := newTester(t)
+
+	// 0 delegator, 1 provider staked, 0 provider unstaked, 0 provider unstaking
+	ts.setupForDelegation(0, 1, 0, 0)
+
+	provider1Acct, provider1Addr := ts.GetAccount(common.PROVIDER, 0)
+
+	stakeEntry, found, _ := ts.Keepers.Epochstorage.GetStakeEntryByAddressCurrent(ts.Ctx, ts.spec.Index, provider1Acct.Addr)
+	require.True(t, found)
+	expectedStake := stakeEntry.Stake
+
+	// the spec goes missing while the provider is still staked
+	ts.Keepers.Spec.RemoveSpec(ts.Ctx, ts.spec.Index)
+
+	// the min stake can't be determined, so the self unbond is rejected (rather
+	// than checked against a zero min stake)
+	amount := sdk.NewCoin(commontypes.TokenDenom, sdk.NewInt(1))
+	_, err := ts.TxDualstakingUnbond(provider1Addr, provider1Addr, ts.spec.Index, amount)
+	require.Error(t, err)
+
+	err = ts.Keepers.Dualstaking.RecomputeProviderDelegateTotal(ts.Ctx, provider1Addr, ts.spec.Index)
+	require.ErrorIs(t, err, spectypes.ErrSpecNotFound)
+
+	stakeEntry, found, _ = ts.Keepers.Epochstorage.GetStakeEntryByAddressCurrent(ts.Ctx, ts.spec.Index, provider1Acct.Addr)
+	require.True(t, found)
+	require.True(t, expectedStake.IsEqual(stakeEntry.Stake))
+}
+
+func TestGetMaturingUnbondings(t *testing.T) {
+	ts := newTester(t)
+
+	// 1 delegator, 1 provider staked, 0 provider unstaked, 0 provider unstaking
+	ts.setupForDelegation(1, 1, 0, 0)
+
+	_, client1Addr := ts.GetAccount(common.CONSUMER, 0)
+	_, provider1Addr := ts.GetAccount(common.PROVIDER, 0)
+
+	amount := sdk.NewCoin(commontypes.TokenDenom, sdk.NewInt(10000))
+	_, err := ts.TxDualstakingDelegate(client1Addr, provider1Addr, ts.spec.Index, amount)
+	require.NoError(t, err)
+	ts.AdvanceEpoch()
+
+	// two unbondings, released an hour apart
+	unbondAmount := sdk.NewCoin(commontypes.TokenDenom, sdk.NewInt(1000))
+	_, err = ts.TxDualstakingUnbond(client1Addr, provider1Addr, ts.spec.Index, unbondAmount)
+	require.NoError(t, err)
+	firstRelease := ts.BlockTime().Add(ts.Keepers.StakingKeeper.UnbondingTime(ts.Ctx))
+
+	ts.AdvanceBlock(time.Hour)
+	_, err = ts.TxDualstakingUnbond(client1Addr, provider1Addr, ts.spec.Index, unbondAmount)
+	require.NoError(t, err)
+	secondRelease := ts.BlockTime().Add(ts.Keepers.StakingKeeper.UnbondingTime(ts.Ctx))
+
+	countEntries := func(within time.Duration) int {
+		unbondings, err := ts.Keepers.Dualstaking.GetMaturingUnbondings(ts.Ctx, client1Addr, within)
+		require.NoError(t, err)
+		count := 0
+		for _, unbonding := range unbondings {
+			require.Equal(t, client1Addr, unbonding.DelegatorAddress)
+			count += len(unbonding.Entries)
+		}
+		return count
+	}
+
+	require.Equal(t, 0, countEntries(0))
+	require.Equal(t, 1, countEntries(firstRelease.Sub(ts.BlockTime())))
+	require.Equal(t, 1, countEntries(secondRelease.Sub(ts.BlockTime())-time.Second))
+	require.Equal(t, 2, countEntries(secondRelease.Sub(ts.BlockTime())))
+
+	_, err = ts.Keepers.Dualstaking.GetMaturingUnbondings(ts.Ctx, "invalid", time.Hour)
+	require.Error(t, err)
+}
+
+// TestUnbondReleasedAfterHoldPeriod checks that the unbonded funds return to the
+// delegator once the unbonding time passes (released by the staking module's
+// EndBlock, not by a dualstaking sweep)
+func TestUnbondReleasedAfterHoldPeriod(t *testing.T) {
+	ts := newTester(t)
+
+	// 1 delegator, 1 provider staked, 0 provider unstaked, 0 provider unstaking
+	ts.setupForDelegation(1, 1, 0, 0)
+
+	client1Acct, client1Addr := ts.GetAccount(common.CONSUMER, 0)
+	_, provider1Addr := ts.GetAccount(common.PROVIDER, 0)
+
+	amount := sdk.NewCoin(commontypes.TokenDenom, sdk.NewInt(10000))
+	_, err := ts.TxDualstakingDelegate(client1Addr, provider1Addr, ts.spec.Index, amount)
+	require.NoError(t, err)
+	ts.AdvanceEpoch()
+
+	balance := ts.GetBalance(client1Acct.Addr)
+	unbondAmount := sdk.NewCoin(commontypes.TokenDenom, sdk.NewInt(4000))
+	_, err = ts.TxDualstakingUnbond(client1Addr, provider1Addr, ts.spec.Index, unbondAmount)
+	require.NoError(t, err)
+
+	// within the hold period, the funds are not released
+	ts.AdvanceBlock(ts.Keepers.StakingKeeper.UnbondingTime(ts.Ctx) - time.Minute)
+	require.Equal(t, balance, ts.GetBalance(client1Acct.Addr))
+
+	// past the hold period, the funds are released at the end of the block
+	ts.AdvanceBlock(time.Minute + time.Second)
+	ts.AdvanceBlock()
+	require.Equal(t, balance+unbondAmount.Amount.Int64(), ts.GetBalance(client1Acct.Addr))
+
+	unbondings, err := ts.Keepers.Dualstaking.GetMaturingUnbondings(ts.Ctx, client1Addr, 0)
+	require.NoError(t, err)
+	require.Empty(t, unbondings)
+}
+
+func TestGetDelegatableProviders(t *testing.T) {
+	ts := newTester(t)
+
+	// 2 delegator, 5 provider staked, 0 provider unstaked, 0 provider unstaking
+	ts.setupForDelegation(2, 5, 0, 0)
+
+	_, client1Addr := ts.GetAccount(common.CONSUMER, 0)
+	_, client2Addr := ts.GetAccount(common.CONSUMER, 1)
+
+	var providers []string
+	setDelegateLimit := func(i int, limit int64) {
+		acct, addr := ts.GetAccount(common.PROVIDER, i)
+		providers = append(providers, addr)
+		stakeEntry, found, index := ts.Keepers.Epochstorage.GetStakeEntryByAddressCurrent(ts.Ctx, ts.spec.Index, acct.Addr)
+		require.True(t, found)
+		stakeEntry.DelegateLimit = sdk.NewCoin(commontypes.TokenDenom, sdk.NewInt(limit))
+		ts.Keepers.Epochstorage.ModifyStakeEntryCurrent(ts.Ctx, ts.spec.Index, stakeEntry, index)
+	}
+	setDelegateLimit(0, 0)      // doesn't accept delegations
+	setDelegateLimit(1, 100000) // allowlist without client1
+	setDelegateLimit(2, 5000)   // reaches its limit
+	setDelegateLimit(3, 100000) // delegatable
+	setDelegateLimit(4, 100000) // already backed by client1
+
+	ts.Keepers.Dualstaking.AddDelegatorToAllowlist(ts.Ctx, providers[1], client2Addr)
+
+	amount := sdk.NewCoin(commontypes.TokenDenom, sdk.NewInt(5000))
+	_, err := ts.TxDualstakingDelegate(client2Addr, providers[2], ts.spec.Index, amount)
+	require.NoError(t, err)
+	_, err = ts.TxDualstakingDelegate(client1Addr, providers[4], ts.spec.Index, amount)
+	require.NoError(t, err)
+	ts.AdvanceEpoch()
+
+	res, err := ts.Keepers.Dualstaking.GetDelegatableProviders(ts.Ctx, client1Addr, ts.spec.Index, ts.EpochStart())
+	require.NoError(t, err)
+	require.Equal(t, []string{providers[3]}, res)
+
+	// client2 is allowlisted by provider1 and doesn't back provider4
+	res, err = ts.Keepers.Dualstaking.GetDelegatableProviders(ts.Ctx, client2Addr, ts.spec.Index, ts.EpochStart())
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{providers[1], providers[3], providers[4]}, res)
+}
+
+func TestSwapDelegationChain(t *testing.T) {
+	ts := newTester(t)
+
+	// 1 delegator, 2 provider staked, 0 provider unstaked, 0 provider unstaking
+	ts.setupForDelegation(1, 2, 0, 0)
+
+	_, client1Addr := ts.GetAccount(common.CONSUMER, 0)
+	provider1Acct, provider1Addr := ts.GetAccount(common.PROVIDER, 0)
+	_, provider2Addr := ts.GetAccount(common.PROVIDER, 1)
+
+	// stake the provider on a second chain
+	spec1 := common.CreateMockSpec()
+	spec1.Index = "mock1"
+	spec1.Name = "mock1"
+	ts.AddSpec(spec1.Index, spec1)
+	err := ts.StakeProvider(provider1Addr, spec1, testStake)
+	require.NoError(t, err)
+
+	amount := sdk.NewCoin(commontypes.TokenDenom, sdk.NewInt(10000))
+	_, err = ts.TxDualstakingDelegate(client1Addr, provider1Addr, ts.spec.Index, amount)
+	require.NoError(t, err)
+	ts.AdvanceEpoch()
+
+	swapped := sdk.NewCoin(commontypes.TokenDenom, sdk.NewInt(4000))
+	err = ts.Keepers.Dualstaking.SwapDelegationChain(ts.Ctx, client1Addr, provider1Addr, ts.spec.Index, spec1.Index, swapped)
+	require.NoError(t, err)
+
+	fromEntry, found, _ := ts.Keepers.Epochstorage.GetStakeEntryByAddressCurrent(ts.Ctx, ts.spec.Index, provider1Acct.Addr)
+	require.True(t, found)
+	require.True(t, amount.Sub(swapped).IsEqual(fromEntry.DelegateTotal))
+	toEntry, found, _ := ts.Keepers.Epochstorage.GetStakeEntryByAddressCurrent(ts.Ctx, spec1.Index, provider1Acct.Addr)
+	require.True(t, found)
+	require.True(t, swapped.IsEqual(toEntry.DelegateTotal))
+
+	ts.AdvanceEpoch()
+	delegation, found := ts.Keepers.Dualstaking.GetDelegation(ts.Ctx, client1Addr, provider1Addr, spec1.Index, ts.GetNextEpoch())
+	require.True(t, found)
+	require.True(t, swapped.IsEqual(delegation.Amount))
+
+	// swapping the full remainder keeps the provider in the delegator's providers
+	err = ts.Keepers.Dualstaking.SwapDelegationChain(ts.Ctx, client1Addr, provider1Addr, ts.spec.Index, spec1.Index, amount.Sub(swapped))
+	require.NoError(t, err)
+	ts.AdvanceEpoch()
+	_, found = ts.Keepers.Dualstaking.GetDelegation(ts.Ctx, client1Addr, provider1Addr, ts.spec.Index, ts.GetNextEpoch())
+	require.False(t, found)
+	delegation, found = ts.Keepers.Dualstaking.GetDelegation(ts.Ctx, client1Addr, provider1Addr, spec1.Index, ts.GetNextEpoch())
+	require.True(t, found)
+	require.True(t, amount.IsEqual(delegation.Amount))
+	providers, err := ts.Keepers.Dualstaking.GetDelegatorProviders(ts.Ctx, client1Addr, ts.GetNextEpoch())
+	require.NoError(t, err)
+	require.Contains(t, providers, provider1Addr)
+
+	// the second provider is not staked on the second chain
+	_, err = ts.TxDualstakingDelegate(client1Addr, provider2Addr, ts.spec.Index, amount)
+	require.NoError(t, err)
+	err = ts.Keepers.Dualstaking.SwapDelegationChain(ts.Ctx, client1Addr, provider2Addr, ts.spec.Index, spec1.Index, swapped)
+	require.ErrorIs(t, err, epochstoragetypes.ErrProviderNotStaked)
+}
+
+func TestProviderMinStakeReachedEvent(t *testing.T) {
+	ts := newTester(t)
+
+	// 0 delegator, 1 provider staked, 0 provider unstaked, 0 provider unstaking
+	ts.setupForDelegation(0, 1, 0, 0)
+
+	_, provider1Addr := ts.GetAccount(common.PROVIDER, 0)
+	minStake := ts.spec.MinStakeProvider
+
+	countEvents := func() int {
+		count := 0
+		for _, event := range ts.Ctx.EventManager().Events() {
+			if event.Type == utils.EventPrefix+types.ProviderMinStakeReachedEventName {
+				count++
+			}
+		}
+		return count
+	}
+
+	// self unbond to just below the min stake
+	unbondAmount := sdk.NewCoin(commontypes.TokenDenom, sdk.NewInt(testStake).Sub(minStake.Amount).AddRaw(1))
+	_, err := ts.TxDualstakingUnbond(provider1Addr, provider1Addr, ts.spec.Index, unbondAmount)
+	require.NoError(t, err)
+	ts.AdvanceEpoch()
+
+	// delegating just enough to reach the min stake fires the event
+	one := sdk.NewCoin(commontypes.TokenDenom, sdk.NewInt(1))
+	before := countEvents()
+	_, err = ts.TxDualstakingDelegate(provider1Addr, provider1Addr, ts.spec.Index, one)
+	require.NoError(t, err)
+	require.Equal(t, before+1, countEvents())
+
+	// delegating above the min stake doesn't fire it again
+	_, err = ts.TxDualstakingDelegate(provider1Addr, provider1Addr, ts.spec.Index, one)
+	require.NoError(t, err)
+	require.Equal(t, before+1, countEvents())
+}
+
+func TestDelegateInsufficientFunds(t *testing.T) {
+	ts := newTester(t)
+
+	// 1 delegator, 1 provider staked, 0 provider unstaked, 0 provider unstaking
+	ts.setupForDelegation(1, 1, 0, 0)
+
+	client1Acct, client1Addr := ts.GetAccount(common.CONSUMER, 0)
+	_, provider1Addr := ts.GetAccount(common.PROVIDER, 0)
+
+	balance := ts.GetBalance(client1Acct.Addr)
+	amount := sdk.NewCoin(commontypes.TokenDenom, sdk.NewInt(balance+1))
+	_, err := ts.TxDualstakingDelegate(client1Addr, provider1Addr, ts.spec.Index, amount)
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFunds)
+
+	_, found := ts.Keepers.Dualstaking.GetDelegation(ts.Ctx, client1Addr, provider1Addr, ts.spec.Index, ts.GetNextEpoch())
+	require.False(t, found)
+	_, found = ts.Keepers.Dualstaking.GetDelegation(ts.Ctx, client1Addr, types.EMPTY_PROVIDER, types.EMPTY_PROVIDER_CHAINID, ts.GetNextEpoch())
+	require.False(t, found)
+	require.Equal(t, balance, ts.GetBalance(client1Acct.Addr))
+}
+
+func TestGetDelegationPendingChange(t *testing.T) {
+	ts := newTester(t)
+
+	// 1 delegator, 1 provider staked, 0 provider unstaked, 0 provider unstaking
+	ts.setupForDelegation(1, 1, 0, 0)
+
+	_, client1Addr := ts.GetAccount(common.CONSUMER, 0)
+	_, provider1Addr := ts.GetAccount(common.PROVIDER, 0)
+
+	amount := sdk.NewCoin(commontypes.TokenDenom, sdk.NewInt(10000))
+	zero := sdk.NewCoin(commontypes.TokenDenom, sdk.ZeroInt())
+
+	_, err := ts.TxDualstakingDelegate(client1Addr, provider1Addr, ts.spec.Index, amount)
+	require.NoError(t, err)
+
+	current, next, err := ts.Keepers.Dualstaking.GetDelegationPendingChange(ts.Ctx, client1Addr, provider1Addr, ts.spec.Index)
+	require.NoError(t, err)
+	require.True(t, zero.IsEqual(current))
+	require.True(t, amount.IsEqual(next))
+
+	ts.AdvanceEpoch()
+
+	// the delegation is in effect, and increased again for the next epoch
+	_, err = ts.TxDualstakingDelegate(client1Addr, provider1Addr, ts.spec.Index, amount)
+	require.NoError(t, err)
+
+	current, next, err = ts.Keepers.Dualstaking.GetDelegationPendingChange(ts.Ctx, client1Addr, provider1Addr, ts.spec.Index)
+	require.NoError(t, err)
+	require.True(t, amount.IsEqual(current))
+	require.True(t, amount.Add(amount).IsEqual(next))
+
+	_, _, err = ts.Keepers.Dualstaking.GetDelegationPendingChange(ts.Ctx, "invalid", provider1Addr, ts.spec.Index)
+	require.Error(t, err)
+}
+
+func TestRedelegateSplit(t *testing.T) {
+	ts := newTester(t)
+
+	// 1 delegator, 4 provider staked, 0 provider unstaked, 0 provider unstaking
+	ts.setupForDelegation(1, 4, 0, 0)
+
+	_, client1Addr := ts.GetAccount(common.CONSUMER, 0)
+	_, provider1Addr := ts.GetAccount(common.PROVIDER, 0)
+
+	amount := sdk.NewCoin(commontypes.TokenDenom, sdk.NewInt(10000))
+	_, err := ts.TxDualstakingDelegate(client1Addr, provider1Addr, ts.spec.Index, amount)
+	require.NoError(t, err)
+	ts.AdvanceEpoch()
+
+	var targets []types.RedelegateTarget
+	total := sdk.NewCoin(commontypes.TokenDenom, sdk.ZeroInt())
+	for i := 1; i <= 3; i++ {
+		_, providerAddr := ts.GetAccount(common.PROVIDER, i)
+		target := types.RedelegateTarget{
+			Provider: providerAddr,
+			ChainID:  ts.spec.Index,
+			Amount:   sdk.NewCoin(commontypes.TokenDenom, sdk.NewInt(int64(1000*i))),
+		}
+		targets = append(targets, target)
+		total = total.Add(target.Amount)
+	}
+
+	// the total exceeds the delegation: nothing is redelegated
+	tooMuch := append([]types.RedelegateTarget{}, targets...)
+	tooMuch[0].Amount = amount
+	err = ts.Keepers.Dualstaking.RedelegateSplit(ts.Ctx, client1Addr, provider1Addr, ts.spec.Index, tooMuch)
+	require.ErrorIs(t, err, types.ErrInsufficientDelegation)
+	delegation, found := ts.Keepers.Dualstaking.GetDelegation(ts.Ctx, client1Addr, provider1Addr, ts.spec.Index, ts.GetNextEpoch())
+	require.True(t, found)
+	require.True(t, amount.IsEqual(delegation.Amount))
+
+	err = ts.Keepers.Dualstaking.RedelegateSplit(ts.Ctx, client1Addr, provider1Addr, ts.spec.Index, targets)
+	require.NoError(t, err)
+
+	nextEpoch := ts.GetNextEpoch()
+	delegation, found = ts.Keepers.Dualstaking.GetDelegation(ts.Ctx, client1Addr, provider1Addr, ts.spec.Index, nextEpoch)
+	require.True(t, found)
+	require.True(t, amount.Sub(total).IsEqual(delegation.Amount))
+	for _, target := range targets {
+		delegation, found := ts.Keepers.Dualstaking.GetDelegation(ts.Ctx, client1Addr, target.Provider, target.ChainID, nextEpoch)
+		require.True(t, found)
+		require.True(t, target.Amount.IsEqual(delegation.Amount))
+	}
+}
+
+func TestEmptyProviderChainIDMismatch(t *testing.T) {
+	ts := newTester(t)
+
+	// 1 delegator, 1 provider staked, 0 provider unstaked, 0 provider unstaking
+	ts.setupForDelegation(1, 1, 0, 0)
+
+	_, client1Addr := ts.GetAccount(common.CONSUMER, 0)
+	_, provider1Addr := ts.GetAccount(common.PROVIDER, 0)
+
+	amount := sdk.NewCoin(commontypes.TokenDenom, sdk.NewInt(10000))
+	_, err := ts.TxDualstakingDelegate(client1Addr, provider1Addr, ts.spec.Index, amount)
+	require.NoError(t, err)
+	ts.AdvanceEpoch()
+
+	// the empty provider with a real chain ID is rejected, on either side
+	keeper := ts.Keepers.Dualstaking
+	err = keeper.Redelegate(ts.Ctx, client1Addr, provider1Addr, types.EMPTY_PROVIDER, ts.spec.Index, ts.spec.Index, amount)
+	require.ErrorIs(t, err, types.ErrEmptyProviderChainID)
+	err = keeper.Redelegate(ts.Ctx, client1Addr, types.EMPTY_PROVIDER, provider1Addr, ts.spec.Index, ts.spec.Index, amount)
+	require.ErrorIs(t, err, types.ErrEmptyProviderChainID)
+
+	nextEpoch := ts.GetNextEpoch()
+	_, found := keeper.GetDelegation(ts.Ctx, client1Addr, types.EMPTY_PROVIDER, ts.spec.Index, nextEpoch)
+	require.False(t, found)
+	delegation, found := keeper.GetDelegation(ts.Ctx, client1Addr, provider1Addr, ts.spec.Index, nextEpoch)
+	require.True(t, found)
+	require.True(t, amount.IsEqual(delegation.Amount))
+}
+
+func TestGetEpochModifiedDelegations(t *testing.T) {
+	ts := newTester(t)
+
+	// 1 delegator, 1 provider staked, 0 provider unstaked, 0 provider unstaking
+	ts.setupForDelegation(1, 1, 0, 0)
+
+	_, client1Addr := ts.GetAccount(common.CONSUMER, 0)
+	_, provider1Addr := ts.GetAccount(common.PROVIDER, 0)
+
+	priorEpoch := ts.GetNextEpoch()
+	ts.AdvanceEpoch()
+
+	amount := sdk.NewCoin(commontypes.TokenDenom, sdk.NewInt(10000))
+	_, err := ts.TxDualstakingDelegate(client1Addr, provider1Addr, ts.spec.Index, amount)
+	require.NoError(t, err)
+	epoch := ts.GetNextEpoch()
+
+	isModified := func(delegations []types.Delegation) bool {
+		for _, d := range delegations {
+			if d.Delegator == client1Addr && d.Provider == provider1Addr && d.ChainID == ts.spec.Index {
+				return true
+			}
+		}
+		return false
+	}
+
+	delegations, err := ts.Keepers.Dualstaking.GetEpochModifiedDelegations(ts.Ctx, epoch)
+	require.NoError(t, err)
+	require.True(t, isModified(delegations))
+
+	delegations, err = ts.Keepers.Dualstaking.GetEpochModifiedDelegations(ts.Ctx, priorEpoch)
+	require.NoError(t, err)
+	require.False(t, isModified(delegations))
+
+	// epochs beyond the next epoch are not known yet
+	_, err = ts.Keepers.Dualstaking.GetEpochModifiedDelegations(ts.Ctx, epoch+1)
+	require.Error(t, err)
+}
+
+func TestAssertPoolInvariants(t *testing.T) {
+	ts := newTester(t)
+
+	// 2 delegators, 2 provider staked, 0 provider unstaked, 0 provider unstaking
+	ts.setupForDelegation(2, 2, 0, 0)
+
+	_, client1Addr := ts.GetAccount(common.CONSUMER, 0)
+	_, client2Addr := ts.GetAccount(common.CONSUMER, 1)
+	_, provider1Addr := ts.GetAccount(common.PROVIDER, 0)
+	_, provider2Addr := ts.GetAccount(common.PROVIDER, 1)
+
+	require.NoError(t, ts.Keepers.Dualstaking.AssertPoolInvariants(ts.Ctx))
+
+	// a mix of delegations, redelegations and (partial and full) unbondings
+	amount := sdk.NewCoin(commontypes.TokenDenom, sdk.NewInt(10000))
+	_, err := ts.TxDualstakingDelegate(client1Addr, provider1Addr, ts.spec.Index, amount)
+	require.NoError(t, err)
+	_, err = ts.TxDualstakingDelegate(client2Addr, provider2Addr, ts.spec.Index, amount)
+	require.NoError(t, err)
+	ts.AdvanceEpoch()
+	require.NoError(t, ts.Keepers.Dualstaking.AssertPoolInvariants(ts.Ctx))
+
+	half := sdk.NewCoin(commontypes.TokenDenom, amount.Amount.QuoRaw(2))
+	_, err = ts.TxDualstakingRedelegate(client1Addr, provider1Addr, provider2Addr, ts.spec.Index, ts.spec.Index, half)
+	require.NoError(t, err)
+	_, err = ts.TxDualstakingUnbond(client1Addr, provider1Addr, ts.spec.Index, half)
+	require.NoError(t, err)
+	_, err = ts.TxDualstakingUnbond(client2Addr, provider2Addr, ts.spec.Index, amount)
+	require.NoError(t, err)
+	ts.AdvanceEpoch()
+	require.NoError(t, ts.Keepers.Dualstaking.AssertPoolInvariants(ts.Ctx))
+
+	// a delegation without a staking module counterpart breaks the invariant
+	corrupt := types.NewDelegation(client1Addr, provider1Addr, ts.spec.Index, ts.Ctx.BlockTime(), commontypes.TokenDenom)
+	corrupt.AddAmount(amount)
+	err = ts.Keepers.Dualstaking.AppendDelegationForTesting(ts.Ctx, corrupt, ts.GetNextEpoch())
+	require.NoError(t, err)
+	require.Error(t, ts.Keepers.Dualstaking.AssertPoolInvariants(ts.Ctx))
+}
+
+func TestGetDelegatorPortfolio(t *testing.T) {
+	ts := newTester(t)
+
+	// 1 delegator, 2 provider staked, 0 provider unstaked, 0 provider unstaking
+	ts.setupForDelegation(1, 2, 0, 0)
+
+	client1Acct, client1Addr := ts.GetAccount(common.CONSUMER, 0)
+	_, provider1Addr := ts.GetAccount(common.PROVIDER, 0)
+	_, provider2Addr := ts.GetAccount(common.PROVIDER, 1)
+	validator, _ := ts.GetAccount(common.VALIDATOR, 0)
+
+	// stake provider1 on a second chain and provider2 on a third chain
+	specs := []string{ts.spec.Index}
+	for i, provider := range []string{provider1Addr, provider2Addr} {
+		spec := common.CreateMockSpec()
+		spec.Index = "mock" + strconv.Itoa(i+1)
+		spec.Name = spec.Index
+		ts.AddSpec(spec.Index, spec)
+		err := ts.StakeProvider(provider, spec, testStake)
+		require.NoError(t, err)
+		specs = append(specs, spec.Index)
+	}
+
+	coin := func(amount int64) sdk.Coin {
+		return sdk.NewCoin(commontypes.TokenDenom, sdk.NewInt(amount))
+	}
+	_, err := ts.TxDualstakingDelegate(client1Addr, provider1Addr, specs[0], coin(1000))
+	require.NoError(t, err)
+	_, err = ts.TxDualstakingDelegate(client1Addr, provider1Addr, specs[1], coin(2000))
+	require.NoError(t, err)
+	_, err = ts.TxDualstakingDelegate(client1Addr, provider2Addr, specs[2], coin(4000))
+	require.NoError(t, err)
+	// a validator delegation goes to the empty provider
+	_, err = ts.TxDelegateValidator(client1Acct, validator, math.NewInt(8000))
+	require.NoError(t, err)
+
+	portfolio, err := ts.Keepers.Dualstaking.GetDelegatorPortfolio(ts.Ctx, client1Addr, ts.GetNextEpoch())
+	require.NoError(t, err)
+	require.Len(t, portfolio, 3)
+
+	groups := map[string]types.ProviderGroup{}
+	for _, group := range portfolio {
+		groups[group.Provider] = group
+	}
+
+	// the delegations are sorted by chain ("mock1" before "mockspec")
+	group := groups[provider1Addr]
+	require.Len(t, group.Delegations, 2)
+	require.Equal(t, specs[1], group.Delegations[0].ChainID)
+	require.True(t, coin(2000).IsEqual(group.Delegations[0].Amount))
+	require.Equal(t, specs[0], group.Delegations[1].ChainID)
+	require.True(t, coin(1000).IsEqual(group.Delegations[1].Amount))
+	require.True(t, coin(3000).IsEqual(group.Subtotal))
+
+	group = groups[provider2Addr]
+	require.Len(t, group.Delegations, 1)
+	require.Equal(t, specs[2], group.Delegations[0].ChainID)
+	require.True(t, coin(4000).IsEqual(group.Subtotal))
+
+	group = groups[types.EMPTY_PROVIDER]
+	require.Len(t, group.Delegations, 1)
+	require.Equal(t, types.EMPTY_PROVIDER_CHAINID, group.Delegations[0].ChainID)
+	require.True(t, coin(8000).IsEqual(group.Subtotal))
+}
+
+func TestGetProviderStakeRatioHistory(t *testing.T) {
+	ts := newTester(t)
+
+	// 1 delegator, 1 provider staked, 0 provider unstaked, 0 provider unstaking
+	ts.setupForDelegation(1, 1, 0, 0)
+
+	_, client1Addr := ts.GetAccount(common.CONSUMER, 0)
+	_, provider1Addr := ts.GetAccount(common.PROVIDER, 0)
+
+	keeper := ts.Keepers.Dualstaking
+	amount := sdk.NewCoin(commontypes.TokenDenom, sdk.NewInt(testStake/10))
+
+	// the provider was staked during the previous epoch, and has no delegations
+	stakedEpoch := ts.EpochStart()
+	unstakedEpoch := stakedEpoch - ts.EpochBlocks()
+
+	// each delegation shows in the stake entries from the following epoch
+	_, err := ts.TxDualstakingDelegate(client1Addr, provider1Addr, ts.spec.Index, amount)
+	require.NoError(t, err)
+	ts.AdvanceEpoch()
+	_, err = ts.TxDualstakingDelegate(client1Addr, provider1Addr, ts.spec.Index, amount)
+	require.NoError(t, err)
+	ts.AdvanceEpoch()
+
+	points, err := keeper.GetProviderStakeRatioHistory(ts.Ctx, provider1Addr, ts.spec.Index, unstakedEpoch, ts.EpochStart())
+	require.NoError(t, err)
+	require.Len(t, points, 4)
+
+	require.Equal(t, unstakedEpoch, points[0].Epoch)
+	require.False(t, points[0].Staked)
+	require.True(t, points[0].Ratio.IsZero())
+
+	require.Equal(t, stakedEpoch, points[1].Epoch)
+	require.True(t, points[1].Staked)
+	require.True(t, points[1].DelegateTotal.IsZero())
+	require.True(t, points[1].Ratio.IsZero())
+
+	require.True(t, points[2].Staked)
+	require.True(t, amount.IsEqual(points[2].DelegateTotal))
+	require.Equal(t, math.LegacyNewDec(10), points[2].Ratio)
+
+	require.Equal(t, ts.EpochStart(), points[3].Epoch)
+	require.True(t, amount.Add(amount).IsEqual(points[3].DelegateTotal))
+	require.Equal(t, math.LegacyNewDec(5), points[3].Ratio)
+
+	// the range must not be reversed or in the future
+	_, err = keeper.GetProviderStakeRatioHistory(ts.Ctx, provider1Addr, ts.spec.Index, ts.EpochStart(), stakedEpoch)
+	require.Error(t, err)
+	_, err = keeper.GetProviderStakeRatioHistory(ts.Ctx, provider1Addr, ts.spec.Index, stakedEpoch, ts.GetNextEpoch())
+	require.Error(t, err)
+}
+
+func TestDelegateReceipt(t *testing.T) {
+	ts := newTester(t)
+
+	// 2 delegators, 1 provider staked, 0 provider unstaked, 0 provider unstaking
+	ts.setupForDelegation(2, 1, 0, 0)
+
+	_, client1Addr := ts.GetAccount(common.CONSUMER, 0)
+	_, client2Addr := ts.GetAccount(common.CONSUMER, 1)
+	provider1Acct, provider1Addr := ts.GetAccount(common.PROVIDER, 0)
+	validator, _ := ts.GetAccount(common.VALIDATOR, 0)
+	validatorAddr := sdk.ValAddress(validator.Addr).String()
+
+	keeper := ts.Keepers.Dualstaking
+	amount := sdk.NewCoin(commontypes.TokenDenom, sdk.NewInt(10000))
+
+	_, err := keeper.DelegateFullWithReceipt(ts.Ctx, client1Addr, validatorAddr, provider1Addr, ts.spec.Index, amount)
+	require.NoError(t, err)
+	_, err = keeper.DelegateFullWithReceipt(ts.Ctx, client2Addr, validatorAddr, provider1Addr, ts.spec.Index, amount)
+	require.NoError(t, err)
+	receipt, err := keeper.DelegateFullWithReceipt(ts.Ctx, client1Addr, validatorAddr, provider1Addr, ts.spec.Index, amount)
+	require.NoError(t, err)
+
+	// the receipt matches the resulting state
+	delegation, found := keeper.GetDelegation(ts.Ctx, client1Addr, provider1Addr, ts.spec.Index, ts.GetNextEpoch())
+	require.True(t, found)
+	stakeEntry, found, _ := ts.Keepers.Epochstorage.GetStakeEntryByAddressCurrent(ts.Ctx, ts.spec.Index, provider1Acct.Addr)
+	require.True(t, found)
+
+	require.Equal(t, client1Addr, receipt.Delegator)
+	require.Equal(t, provider1Addr, receipt.Provider)
+	require.Equal(t, ts.spec.Index, receipt.ChainID)
+	require.True(t, amount.IsEqual(receipt.Amount))
+	require.True(t, delegation.Amount.IsEqual(receipt.Delegation))
+	require.True(t, amount.Add(amount).IsEqual(receipt.Delegation))
+	require.Equal(t, ts.GetNextEpoch(), receipt.EffectiveEpoch)
+	require.True(t, stakeEntry.DelegateTotal.IsEqual(receipt.ProviderDelegateTotal))
+	require.Equal(t, int64(30000), receipt.ProviderDelegateTotal.Amount.Int64())
+
+	// and is reported in the delegate response
+	res, err := ts.TxDualstakingDelegate(client2Addr, provider1Addr, ts.spec.Index, amount)
+	require.NoError(t, err)
+	require.True(t, amount.Add(amount).IsEqual(res.Delegation))
+	require.Equal(t, ts.GetNextEpoch(), res.EffectiveEpoch)
+	require.True(t, receipt.ProviderDelegateTotal.Add(amount).IsEqual(res.ProviderDelegateTotal))
+}
+
+func TestGetDelegatorChains(t *testing.T) {
+	ts := newTester(t)
+
+	// 1 delegator, 2 provider staked, 0 provider unstaked, 0 provider unstaking
+	ts.setupForDelegation(1, 2, 0, 0)
+
+	client1Acct, client1Addr := ts.GetAccount(common.CONSUMER, 0)
+	_, provider1Addr := ts.GetAccount(common.PROVIDER, 0)
+	_, provider2Addr := ts.GetAccount(common.PROVIDER, 1)
+	validator, _ := ts.GetAccount(common.VALIDATOR, 0)
+
+	chains, err := ts.Keepers.Dualstaking.GetDelegatorChains(ts.Ctx, client1Addr, ts.GetNextEpoch())
+	require.NoError(t, err)
+	require.Empty(t, chains)
+
+	// stake provider1 on a second chain and provider2 on a third chain
+	for i, provider := range []string{provider1Addr, provider2Addr} {
+		spec := common.CreateMockSpec()
+		spec.Index = "mock" + strconv.Itoa(i+1)
+		spec.Name = spec.Index
+		ts.AddSpec(spec.Index, spec)
+		err := ts.StakeProvider(provider, spec, testStake)
+		require.NoError(t, err)
+	}
+
+	// both providers share a chain, and each has a chain of its own
+	amount := sdk.NewCoin(commontypes.TokenDenom, sdk.NewInt(1000))
+	for _, delegation := range []struct{ provider, chainID string }{
+		{provider1Addr, ts.spec.Index},
+		{provider1Addr, "mock1"},
+		{provider2Addr, ts.spec.Index},
+		{provider2Addr, "mock2"},
+	} {
+		_, err := ts.TxDualstakingDelegate(client1Addr, delegation.provider, delegation.chainID, amount)
+		require.NoError(t, err)
+	}
+	// the empty provider's delegation is not on any chain
+	_, err = ts.TxDelegateValidator(client1Acct, validator, amount.Amount)
+	require.NoError(t, err)
+
+	chains, err = ts.Keepers.Dualstaking.GetDelegatorChains(ts.Ctx, client1Addr, ts.GetNextEpoch())
+	require.NoError(t, err)
+	require.Equal(t, []string{"mock1", "mock2", ts.spec.Index}, chains)
+
+	_, err = ts.Keepers.Dualstaking.GetDelegatorChains(ts.Ctx, "invalid", ts.GetNextEpoch())
+	require.Error(t, err)
+}
+
+func TestDelegationIndexWithoutEntryCounter(t *testing.T) {
+	ts := newTester(t)
+
+	// 1 delegator, 1 provider staked, 0 provider unstaked, 0 provider unstaking
+	ts.setupForDelegation(1, 1, 0, 0)
+
+	_, client1Addr := ts.GetAccount(common.CONSUMER, 0)
+	_, provider1Addr := ts.GetAccount(common.PROVIDER, 0)
+
+	sink := metrics.NewInmemSink(time.Hour, time.Hour)
+	cfg := metrics.DefaultConfig("lava")
+	cfg.EnableHostname = false
+	cfg.EnableRuntimeMetrics = false
+	_, err := metrics.NewGlobal(cfg, sink)
+	require.NoError(t, err)
+
+	counter := func() (count int) {
+		for _, interval := range sink.Data() {
+			for _, sample := range interval.Counters {
+				if sample.Name != "lava.dualstaking.delegation_index_without_entry" {
+					continue
+				}
+				for _, label := range sample.Labels {
+					if label.Name == "provider" && label.Value == provider1Addr {
+						count += sample.Count
+					}
+				}
+			}
+		}
+		return count
+	}
+
+	// the delegation's index exists right away, but its entry only takes effect at
+	// the next epoch: looking it up at the current epoch finds an index without entry
+	amount := sdk.NewCoin(commontypes.TokenDenom, sdk.NewInt(10000))
+	_, err = ts.TxDualstakingDelegate(client1Addr, provider1Addr, ts.spec.Index, amount)
+	require.NoError(t, err)
+
+	// (only the provider's self delegation is found)
+	delegations, err := ts.Keepers.Dualstaking.GetProviderDelegators(ts.Ctx, provider1Addr, ts.EpochStart())
+	require.NoError(t, err)
+	require.Len(t, delegations, 1)
+	require.Equal(t, 1, counter())
+
+	delegations = ts.Keepers.Dualstaking.GetAllProviderDelegatorDelegations(ts.Ctx, client1Addr, provider1Addr, ts.EpochStart())
+	require.Empty(t, delegations)
+	require.Equal(t, 2, counter())
+
+	// once in effect, nothing is counted
+	ts.AdvanceEpoch()
+	delegations, err = ts.Keepers.Dualstaking.GetProviderDelegators(ts.Ctx, provider1Addr, ts.EpochStart())
+	require.NoError(t, err)
+	require.Len(t, delegations, 2)
+	require.Equal(t, 2, counter())
+}
+
+func TestGetDelegatorPortfolioWithUnbonding(t *testing.T) {
+	ts := newTester(t)
+
+	// 1 delegator, 1 provider staked, 0 provider unstaked, 0 provider unstaking
+	ts.setupForDelegation(1, 1, 0, 0)
+
+	_, client1Addr := ts.GetAccount(common.CONSUMER, 0)
+	_, provider1Addr := ts.GetAccount(common.PROVIDER, 0)
+
+	coin := func(amount int64) sdk.Coin {
+		return sdk.NewCoin(commontypes.TokenDenom, sdk.NewInt(amount))
+	}
+
+	_, err := ts.TxDualstakingDelegate(client1Addr, provider1Addr, ts.spec.Index, coin(10000))
+	require.NoError(t, err)
+	ts.AdvanceEpoch()
+
+	portfolio, unbonding, err := ts.Keepers.Dualstaking.GetDelegatorPortfolioWithUnbonding(ts.Ctx, client1Addr, ts.GetNextEpoch())
+	require.NoError(t, err)
+	require.Len(t, portfolio, 1)
+	require.True(t, coin(10000).IsEqual(portfolio[0].Subtotal))
+	require.True(t, unbonding.IsZero())
+
+	// a partial unbond: the rest stays bonded, the unbonded part is in the hold
+	_, err = ts.TxDualstakingUnbond(client1Addr, provider1Addr, ts.spec.Index, coin(4000))
+	require.NoError(t, err)
+
+	portfolio, unbonding, err = ts.Keepers.Dualstaking.GetDelegatorPortfolioWithUnbonding(ts.Ctx, client1Addr, ts.GetNextEpoch())
+	require.NoError(t, err)
+	require.Len(t, portfolio, 1)
+	require.Equal(t, provider1Addr, portfolio[0].Provider)
+	require.True(t, coin(6000).IsEqual(portfolio[0].Subtotal))
+	require.True(t, coin(4000).IsEqual(unbonding))
+
+	// once released (at the end of a block past the unbonding time), nothing is in
+	// the hold
+	ts.AdvanceBlock(ts.Keepers.StakingKeeper.UnbondingTime(ts.Ctx) + time.Second)
+	ts.AdvanceBlock()
+	unbonding, err = ts.Keepers.Dualstaking.GetDelegatorUnbondingAmount(ts.Ctx, client1Addr)
+	require.NoError(t, err)
+	require.True(t, unbonding.IsZero())
+
+	_, err = ts.Keepers.Dualstaking.GetDelegatorUnbondingAmount(ts.Ctx, "invalid")
+	require.Error(t, err)
+}
@@ -0,0 +1,106 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/utils"
+	"github.com/lavanet/lava/x/dualstaking/types"
+)
+
+// Delegation locks allow delegation programs to keep funds bonded with a provider
+// for a fixed term (longer than the standard unbond hold period). Until the lock
+// height is reached, the delegation cannot be unbonded or redelegated away, neither
+// directly nor by unbonding from a validator (the uniform unbond skips it). Only
+// slashing and governance forced unbonds ignore the locks. The locks are set by
+// other modules (there is no Msg to set one) and are indexed by the delegation key
+// <provider,delegator,chainID>.
+
+// SetDelegationLock sets the block height until which the delegation is locked
+func (k Keeper) SetDelegationLock(ctx sdk.Context, delegator, provider, chainID string, lockUntil uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DelegationLockPrefix))
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, lockUntil)
+	store.Set([]byte(types.DelegationKey(provider, delegator, chainID)), b)
+}
+
+// GetDelegationLock returns the block height until which the delegation is locked
+func (k Keeper) GetDelegationLock(ctx sdk.Context, delegator, provider, chainID string) (lockUntil uint64, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DelegationLockPrefix))
+	b := store.Get([]byte(types.DelegationKey(provider, delegator, chainID)))
+	if b == nil {
+		return 0, false
+	}
+	return binary.BigEndian.Uint64(b), true
+}
+
+// RemoveDelegationLock removes the lock of the delegation
+func (k Keeper) RemoveDelegationLock(ctx sdk.Context, delegator, provider, chainID string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DelegationLockPrefix))
+	store.Delete([]byte(types.DelegationKey(provider, delegator, chainID)))
+}
+
+// GetAllDelegationLocks returns all the delegation locks (for genesis)
+func (k Keeper) GetAllDelegationLocks(ctx sdk.Context) []types.DelegationLock {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DelegationLockPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	locks := []types.DelegationLock{}
+	for ; iterator.Valid(); iterator.Next() {
+		provider, delegator, chainID := types.DelegationKeyDecode(string(iterator.Key()))
+		locks = append(locks, types.DelegationLock{
+			Delegator: delegator,
+			Provider:  provider,
+			ChainId:   chainID,
+			LockUntil: binary.BigEndian.Uint64(iterator.Value()),
+		})
+	}
+	return locks
+}
+
+// IsDelegationLocked checks whether the delegation is still locked at the current block
+func (k Keeper) IsDelegationLocked(ctx sdk.Context, delegator, provider, chainID string) bool {
+	lockUntil, found := k.GetDelegationLock(ctx, delegator, provider, chainID)
+	return found && uint64(ctx.BlockHeight()) < lockUntil
+}
+
+// DelegateFullWithLock delegates (like DelegateFull) and locks the delegation until
+// the given block height. An existing lock is never shortened. It is keeper-only,
+// for delegation programs implemented by other modules (or upgrade handlers).
+func (k Keeper) DelegateFullWithLock(ctx sdk.Context, delegator, validator, provider, chainID string, amount sdk.Coin, lockUntil uint64) error {
+	if lockUntil <= uint64(ctx.BlockHeight()) {
+		return utils.LavaFormatWarning("invalid delegation lock height", types.ErrDelegationLocked,
+			utils.LogAttr("lock_until", lockUntil),
+			utils.LogAttr("block", ctx.BlockHeight()),
+		)
+	}
+
+	err := k.DelegateFull(ctx, delegator, validator, provider, chainID, amount)
+	if err != nil {
+		return err
+	}
+
+	if current, found := k.GetDelegationLock(ctx, delegator, provider, chainID); !found || current < lockUntil {
+		k.SetDelegationLock(ctx, delegator, provider, chainID, lockUntil)
+	}
+
+	return nil
+}
+
+// setIgnoreDelegationLocks sets whether the uniform unbonds (of the staking hooks)
+// ignore the delegation locks. It is set only for the duration of a forced unbond.
+func (k Keeper) setIgnoreDelegationLocks(ctx sdk.Context, ignore bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.IgnoreDelegationLocksPrefix))
+	if !ignore {
+		store.Delete([]byte{0})
+		return
+	}
+	store.Set([]byte{0}, []byte{1})
+}
+
+func (k Keeper) getIgnoreDelegationLocks(ctx sdk.Context) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.IgnoreDelegationLocksPrefix))
+	return store.Has([]byte{0})
+}
@@ -0,0 +1,105 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	commontypes "github.com/lavanet/lava/common/types"
+	"github.com/lavanet/lava/testutil/common"
+	"github.com/lavanet/lava/x/dualstaking/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDelegationLock(t *testing.T) {
+	ts := newTester(t)
+
+	// 1 delegator, 2 provider staked, 0 provider unstaked, 0 provider unstaking
+	ts.setupForDelegation(1, 2, 0, 0)
+
+	_, client1Addr := ts.GetAccount(common.CONSUMER, 0)
+	_, provider1Addr := ts.GetAccount(common.PROVIDER, 0)
+	_, provider2Addr := ts.GetAccount(common.PROVIDER, 1)
+	validator, _ := ts.GetAccount(common.VALIDATOR, 0)
+
+	keeper := ts.Keepers.Dualstaking
+	lockUntil := ts.BlockHeight() + 3*ts.EpochBlocks()
+
+	// lock height must be in the future
+	amount := sdk.NewCoin(commontypes.TokenDenom, sdk.NewInt(10000))
+	err := keeper.DelegateFullWithLock(ts.Ctx, client1Addr, sdk.ValAddress(validator.Addr).String(),
+		provider1Addr, ts.spec.Index, amount, ts.BlockHeight())
+	require.ErrorIs(t, err, types.ErrDelegationLocked)
+
+	err = keeper.DelegateFullWithLock(ts.Ctx, client1Addr, sdk.ValAddress(validator.Addr).String(),
+		provider1Addr, ts.spec.Index, amount, lockUntil)
+	require.NoError(t, err)
+	ts.AdvanceEpoch()
+
+	lock, found := keeper.GetDelegationLock(ts.Ctx, client1Addr, provider1Addr, ts.spec.Index)
+	require.True(t, found)
+	require.Equal(t, lockUntil, lock)
+
+	// unbond and redelegate before the lock height (fail)
+	unbondAmount := sdk.NewCoin(commontypes.TokenDenom, sdk.NewInt(1000))
+	_, err = ts.TxDualstakingUnbond(client1Addr, provider1Addr, ts.spec.Index, unbondAmount)
+	require.ErrorIs(t, err, types.ErrDelegationLocked)
+	_, err = ts.TxDualstakingRedelegate(client1Addr, provider1Addr, provider2Addr, ts.spec.Index, ts.spec.Index, unbondAmount)
+	require.ErrorIs(t, err, types.ErrDelegationLocked)
+
+	// unbond after the lock height
+	ts.AdvanceToBlock(lockUntil)
+	_, err = ts.TxDualstakingUnbond(client1Addr, provider1Addr, ts.spec.Index, unbondAmount)
+	require.NoError(t, err)
+
+	// the lock is removed once the delegation is fully unbonded
+	ts.AdvanceBlock()
+	_, err = ts.TxDualstakingUnbond(client1Addr, provider1Addr, ts.spec.Index, amount.Sub(unbondAmount))
+	require.NoError(t, err)
+	_, found = keeper.GetDelegationLock(ts.Ctx, client1Addr, provider1Addr, ts.spec.Index)
+	require.False(t, found)
+
+	ts.verifyDelegatorsBalance()
+}
+
+func TestDelegationLockValidatorUnbond(t *testing.T) {
+	ts := newTester(t)
+
+	// 1 delegator, 2 provider staked, 0 provider unstaked, 0 provider unstaking
+	ts.setupForDelegation(1, 2, 0, 0)
+
+	client1Acct, client1Addr := ts.GetAccount(common.CONSUMER, 0)
+	_, provider1Addr := ts.GetAccount(common.PROVIDER, 0)
+	_, provider2Addr := ts.GetAccount(common.PROVIDER, 1)
+	validator, _ := ts.GetAccount(common.VALIDATOR, 0)
+
+	keeper := ts.Keepers.Dualstaking
+	coin := func(amount int64) sdk.Coin {
+		return sdk.NewCoin(commontypes.TokenDenom, sdk.NewInt(amount))
+	}
+
+	// a locked delegation to provider1 and an unlocked one to provider2
+	lockUntil := ts.BlockHeight() + 3*ts.EpochBlocks()
+	err := keeper.DelegateFullWithLock(ts.Ctx, client1Addr, sdk.ValAddress(validator.Addr).String(),
+		provider1Addr, ts.spec.Index, coin(10000), lockUntil)
+	require.NoError(t, err)
+	_, err = ts.TxDualstakingDelegate(client1Addr, provider2Addr, ts.spec.Index, coin(5000))
+	require.NoError(t, err)
+	ts.AdvanceEpoch()
+
+	// unbonding from the validator more than the unlocked delegations fails
+	_, err = ts.TxUnbondValidator(client1Acct, validator, sdk.NewInt(8000))
+	require.ErrorIs(t, err, types.ErrDelegationLocked)
+
+	// otherwise, it is taken from the unlocked delegations only
+	_, err = ts.TxUnbondValidator(client1Acct, validator, sdk.NewInt(3000))
+	require.NoError(t, err)
+
+	delegation, found := keeper.GetDelegation(ts.Ctx, client1Addr, provider1Addr, ts.spec.Index, ts.GetNextEpoch())
+	require.True(t, found)
+	require.Equal(t, coin(10000), delegation.Amount)
+	delegation, found = keeper.GetDelegation(ts.Ctx, client1Addr, provider2Addr, ts.spec.Index, ts.GetNextEpoch())
+	require.True(t, found)
+	require.Equal(t, coin(2000), delegation.Amount)
+
+	ts.verifyDelegatorsBalance()
+}
@@ -0,0 +1,84 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/utils"
+	"github.com/lavanet/lava/x/dualstaking/types"
+)
+
+// A delegation may carry an opaque tag (memo), set by the delegator for off-chain
+// reconciliation (e.g. when managing funds for many clients). The tags are indexed
+// by the delegation key <provider,delegator,chainID> and are removed along with
+// the delegation.
+
+// DelegateWithTag delegates (like DelegateFull) and sets the delegation's tag
+func (k Keeper) DelegateWithTag(ctx sdk.Context, delegator, validator, provider, chainID string, amount sdk.Coin, tag string) error {
+	if err := verifyDelegationTag(tag); err != nil {
+		return err
+	}
+
+	err := k.DelegateFull(ctx, delegator, validator, provider, chainID, amount)
+	if err != nil {
+		return err
+	}
+
+	return k.SetDelegationTag(ctx, delegator, provider, chainID, tag)
+}
+
+// SetDelegationTag sets the delegation's tag. An empty tag removes it.
+func (k Keeper) SetDelegationTag(ctx sdk.Context, delegator, provider, chainID, tag string) error {
+	if err := verifyDelegationTag(tag); err != nil {
+		return err
+	}
+
+	if tag == "" {
+		k.RemoveDelegationTag(ctx, delegator, provider, chainID)
+		return nil
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DelegationTagPrefix))
+	store.Set([]byte(types.DelegationKey(provider, delegator, chainID)), []byte(tag))
+	return nil
+}
+
+// GetDelegationTag returns the delegation's tag (empty if it has none)
+func (k Keeper) GetDelegationTag(ctx sdk.Context, delegator, provider, chainID string) string {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DelegationTagPrefix))
+	return string(store.Get([]byte(types.DelegationKey(provider, delegator, chainID))))
+}
+
+// RemoveDelegationTag removes the tag of the delegation
+func (k Keeper) RemoveDelegationTag(ctx sdk.Context, delegator, provider, chainID string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DelegationTagPrefix))
+	store.Delete([]byte(types.DelegationKey(provider, delegator, chainID)))
+}
+
+// GetAllDelegationTags returns all the delegation tags (for genesis)
+func (k Keeper) GetAllDelegationTags(ctx sdk.Context) []types.DelegationTag {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DelegationTagPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	tags := []types.DelegationTag{}
+	for ; iterator.Valid(); iterator.Next() {
+		provider, delegator, chainID := types.DelegationKeyDecode(string(iterator.Key()))
+		tags = append(tags, types.DelegationTag{
+			Delegator: delegator,
+			Provider:  provider,
+			ChainId:   chainID,
+			Tag:       string(iterator.Value()),
+		})
+	}
+	return tags
+}
+
+func verifyDelegationTag(tag string) error {
+	if len(tag) > types.MaxDelegationTagLength {
+		return utils.LavaFormatWarning("delegation tag is too long", types.ErrDelegationTagTooLong,
+			utils.LogAttr("length", len(tag)),
+			utils.LogAttr("max_length", types.MaxDelegationTagLength),
+		)
+	}
+	return nil
+}
@@ -0,0 +1,46 @@
+package keeper_test
+
+import (
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	commontypes "github.com/lavanet/lava/common/types"
+	"github.com/lavanet/lava/testutil/common"
+	"github.com/lavanet/lava/x/dualstaking/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDelegateWithTag(t *testing.T) {
+	ts := newTester(t)
+
+	// 1 delegator, 1 provider staked, 0 provider unstaked, 0 provider unstaking
+	ts.setupForDelegation(1, 1, 0, 0)
+
+	_, client1Addr := ts.GetAccount(common.CONSUMER, 0)
+	_, provider1Addr := ts.GetAccount(common.PROVIDER, 0)
+	validator, _ := ts.GetAccount(common.VALIDATOR, 0)
+	validatorAddr := sdk.ValAddress(validator.Addr).String()
+
+	amount := sdk.NewCoin(commontypes.TokenDenom, sdk.NewInt(10000))
+
+	// an over-length tag is rejected before delegating
+	tooLong := strings.Repeat("a", types.MaxDelegationTagLength+1)
+	err := ts.Keepers.Dualstaking.DelegateWithTag(ts.Ctx, client1Addr, validatorAddr, provider1Addr, ts.spec.Index, amount, tooLong)
+	require.ErrorIs(t, err, types.ErrDelegationTagTooLong)
+	_, found := ts.Keepers.Dualstaking.GetDelegation(ts.Ctx, client1Addr, provider1Addr, ts.spec.Index, ts.GetNextEpoch())
+	require.False(t, found)
+
+	err = ts.Keepers.Dualstaking.DelegateWithTag(ts.Ctx, client1Addr, validatorAddr, provider1Addr, ts.spec.Index, amount, "client-42")
+	require.NoError(t, err)
+	ts.AdvanceEpoch()
+
+	_, found = ts.Keepers.Dualstaking.GetDelegation(ts.Ctx, client1Addr, provider1Addr, ts.spec.Index, ts.EpochStart())
+	require.True(t, found)
+	require.Equal(t, "client-42", ts.Keepers.Dualstaking.GetDelegationTag(ts.Ctx, client1Addr, provider1Addr, ts.spec.Index))
+
+	// the tag is removed along with the delegation
+	_, err = ts.TxDualstakingUnbond(client1Addr, provider1Addr, ts.spec.Index, amount)
+	require.NoError(t, err)
+	require.Empty(t, ts.Keepers.Dualstaking.GetDelegationTag(ts.Ctx, client1Addr, provider1Addr, ts.spec.Index))
+}
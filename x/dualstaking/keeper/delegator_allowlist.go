@@ -0,0 +1,69 @@
+package keeper
+
+import (
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/x/dualstaking/types"
+)
+
+// A provider may restrict the delegators it accepts delegations from to an
+// explicit allowlist. An empty allowlist accepts all delegators, and the provider
+// itself is always allowed. The allowlist entries are indexed by <provider,delegator>.
+
+// AddDelegatorToAllowlist allows the delegator to delegate to the provider
+func (k Keeper) AddDelegatorToAllowlist(ctx sdk.Context, provider, delegator string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DelegatorAllowlistPrefix))
+	store.Set([]byte(types.DelegatorAllowlistKey(provider, delegator)), []byte{1})
+}
+
+// RemoveDelegatorFromAllowlist removes the delegator from the provider's allowlist
+func (k Keeper) RemoveDelegatorFromAllowlist(ctx sdk.Context, provider, delegator string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DelegatorAllowlistPrefix))
+	store.Delete([]byte(types.DelegatorAllowlistKey(provider, delegator)))
+}
+
+// GetDelegatorAllowlist returns the delegators in the provider's allowlist
+func (k Keeper) GetDelegatorAllowlist(ctx sdk.Context, provider string) []string {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DelegatorAllowlistPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte(types.DelegatorAllowlistKey(provider, "")))
+	defer iterator.Close()
+
+	delegators := []string{}
+	for ; iterator.Valid(); iterator.Next() {
+		delegators = append(delegators, string(iterator.Key()[len(types.DelegatorAllowlistKey(provider, "")):]))
+	}
+	return delegators
+}
+
+// GetAllDelegatorAllowlists returns all the allowlists entries (for genesis)
+func (k Keeper) GetAllDelegatorAllowlists(ctx sdk.Context) []types.DelegatorAllowlistEntry {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DelegatorAllowlistPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	entries := []types.DelegatorAllowlistEntry{}
+	for ; iterator.Valid(); iterator.Next() {
+		provider, delegator, _ := strings.Cut(string(iterator.Key()), " ")
+		entries = append(entries, types.DelegatorAllowlistEntry{Provider: provider, Delegator: delegator})
+	}
+	return entries
+}
+
+// IsDelegatorAllowed checks whether the delegator may delegate to the provider
+func (k Keeper) IsDelegatorAllowed(ctx sdk.Context, provider, delegator string) bool {
+	if provider == types.EMPTY_PROVIDER || delegator == provider {
+		return true
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DelegatorAllowlistPrefix))
+	if store.Has([]byte(types.DelegatorAllowlistKey(provider, delegator))) {
+		return true
+	}
+
+	// an empty allowlist accepts all delegators
+	iterator := sdk.KVStorePrefixIterator(store, []byte(types.DelegatorAllowlistKey(provider, "")))
+	defer iterator.Close()
+	return !iterator.Valid()
+}
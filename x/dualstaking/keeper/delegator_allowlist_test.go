@@ -0,0 +1,123 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	commontypes "github.com/lavanet/lava/common/types"
+	"github.com/lavanet/lava/testutil/common"
+	"github.com/lavanet/lava/x/dualstaking/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDelegatorAllowlist(t *testing.T) {
+	ts := newTester(t)
+
+	// 3 delegators, 2 provider staked, 0 provider unstaked, 0 provider unstaking
+	ts.setupForDelegation(3, 2, 0, 0)
+
+	_, client1Addr := ts.GetAccount(common.CONSUMER, 0)
+	_, client2Addr := ts.GetAccount(common.CONSUMER, 1)
+	_, client3Addr := ts.GetAccount(common.CONSUMER, 2)
+	_, provider1Addr := ts.GetAccount(common.PROVIDER, 0)
+	_, provider2Addr := ts.GetAccount(common.PROVIDER, 1)
+
+	ts.Keepers.Dualstaking.AddDelegatorToAllowlist(ts.Ctx, provider1Addr, client1Addr)
+	require.Equal(t, []string{client1Addr}, ts.Keepers.Dualstaking.GetDelegatorAllowlist(ts.Ctx, provider1Addr))
+	require.Empty(t, ts.Keepers.Dualstaking.GetDelegatorAllowlist(ts.Ctx, provider2Addr))
+
+	amount := sdk.NewCoin(commontypes.TokenDenom, sdk.NewInt(10000))
+
+	// allowed delegator
+	_, err := ts.TxDualstakingDelegate(client1Addr, provider1Addr, ts.spec.Index, amount)
+	require.NoError(t, err)
+
+	// disallowed delegator
+	_, err = ts.TxDualstakingDelegate(client2Addr, provider1Addr, ts.spec.Index, amount)
+	require.ErrorIs(t, err, types.ErrDelegatorNotAllowed)
+	_, found := ts.Keepers.Dualstaking.GetDelegation(ts.Ctx, client2Addr, provider1Addr, ts.spec.Index, ts.GetNextEpoch())
+	require.False(t, found)
+
+	// redelegating into the provider is restricted too
+	_, err = ts.TxDualstakingDelegate(client2Addr, provider2Addr, ts.spec.Index, amount)
+	require.NoError(t, err)
+	ts.AdvanceEpoch()
+	_, err = ts.TxDualstakingRedelegate(client2Addr, provider2Addr, provider1Addr, ts.spec.Index, ts.spec.Index, amount)
+	require.ErrorIs(t, err, types.ErrDelegatorNotAllowed)
+
+	// empty allowlist accepts all delegators
+	_, err = ts.TxDualstakingDelegate(client3Addr, provider2Addr, ts.spec.Index, amount)
+	require.NoError(t, err)
+
+	// the provider itself is always allowed
+	require.True(t, ts.Keepers.Dualstaking.IsDelegatorAllowed(ts.Ctx, provider1Addr, provider1Addr))
+
+	// removing the last entry accepts all delegators again
+	ts.Keepers.Dualstaking.RemoveDelegatorFromAllowlist(ts.Ctx, provider1Addr, client1Addr)
+	_, err = ts.TxDualstakingDelegate(client2Addr, provider1Addr, ts.spec.Index, amount)
+	require.NoError(t, err)
+}
+
+func TestRedelegateToDisallowedProvider(t *testing.T) {
+	ts := newTester(t)
+
+	// 2 delegators, 2 provider staked, 0 provider unstaked, 0 provider unstaking
+	ts.setupForDelegation(2, 2, 0, 0)
+
+	_, client1Addr := ts.GetAccount(common.CONSUMER, 0)
+	_, client2Addr := ts.GetAccount(common.CONSUMER, 1)
+	provider1Acct, provider1Addr := ts.GetAccount(common.PROVIDER, 0)
+	_, provider2Addr := ts.GetAccount(common.PROVIDER, 1)
+
+	amount := sdk.NewCoin(commontypes.TokenDenom, sdk.NewInt(10000))
+	_, err := ts.TxDualstakingDelegate(client1Addr, provider1Addr, ts.spec.Index, amount)
+	require.NoError(t, err)
+	ts.AdvanceEpoch()
+
+	// provider2 accepts only client2's delegations
+	ts.Keepers.Dualstaking.AddDelegatorToAllowlist(ts.Ctx, provider2Addr, client2Addr)
+
+	stakeEntry, found, _ := ts.Keepers.Epochstorage.GetStakeEntryByAddressCurrent(ts.Ctx, ts.spec.Index, provider1Acct.Addr)
+	require.True(t, found)
+	delegateTotal := stakeEntry.DelegateTotal
+
+	err = ts.Keepers.Dualstaking.Redelegate(ts.Ctx, client1Addr, provider1Addr, provider2Addr, ts.spec.Index, ts.spec.Index, amount)
+	require.ErrorIs(t, err, types.ErrDelegatorNotAllowed)
+
+	// the source delegation is untouched, and nothing moved to provider2
+	delegation, found := ts.Keepers.Dualstaking.GetDelegation(ts.Ctx, client1Addr, provider1Addr, ts.spec.Index, ts.GetNextEpoch())
+	require.True(t, found)
+	require.True(t, amount.IsEqual(delegation.Amount))
+	_, found = ts.Keepers.Dualstaking.GetDelegation(ts.Ctx, client1Addr, provider2Addr, ts.spec.Index, ts.GetNextEpoch())
+	require.False(t, found)
+
+	stakeEntry, found, _ = ts.Keepers.Epochstorage.GetStakeEntryByAddressCurrent(ts.Ctx, ts.spec.Index, provider1Acct.Addr)
+	require.True(t, found)
+	require.True(t, delegateTotal.IsEqual(stakeEntry.DelegateTotal))
+}
+
+func TestUpdateDelegatorAllowlistMsg(t *testing.T) {
+	ts := newTester(t)
+
+	// 2 delegators, 1 provider staked, 0 provider unstaked, 0 provider unstaking
+	ts.setupForDelegation(2, 1, 0, 0)
+
+	_, client1Addr := ts.GetAccount(common.CONSUMER, 0)
+	_, client2Addr := ts.GetAccount(common.CONSUMER, 1)
+	_, provider1Addr := ts.GetAccount(common.PROVIDER, 0)
+
+	_, err := ts.TxDualstakingUpdateDelegatorAllowlist(provider1Addr, []string{client1Addr, client2Addr}, nil)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{client1Addr, client2Addr}, ts.Keepers.Dualstaking.GetDelegatorAllowlist(ts.Ctx, provider1Addr))
+
+	_, err = ts.TxDualstakingUpdateDelegatorAllowlist(provider1Addr, nil, []string{client2Addr})
+	require.NoError(t, err)
+	require.Equal(t, []string{client1Addr}, ts.Keepers.Dualstaking.GetDelegatorAllowlist(ts.Ctx, provider1Addr))
+
+	amount := sdk.NewCoin(commontypes.TokenDenom, sdk.NewInt(10000))
+	_, err = ts.TxDualstakingDelegate(client2Addr, provider1Addr, ts.spec.Index, amount)
+	require.ErrorIs(t, err, types.ErrDelegatorNotAllowed)
+
+	_, err = ts.TxDualstakingUpdateDelegatorAllowlist(provider1Addr, []string{"invalid"}, nil)
+	require.Error(t, err)
+}
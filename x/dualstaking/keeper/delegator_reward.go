@@ -131,7 +131,7 @@ func (k Keeper) ClaimRewards(ctx sdk.Context, delegator string, provider string)
 	}
 
 	for _, reward := range res.Rewards {
-		delegatorAcc, err := sdk.AccAddressFromBech32(delegator)
+		withdrawAcc, err := k.GetWithdrawAddress(ctx, delegator)
 		if err != nil {
 			utils.LavaFormatError("critical: could not claim delegator reward from provider", err,
 				utils.Attribute{Key: "delegator", Value: delegator},
@@ -144,7 +144,7 @@ func (k Keeper) ClaimRewards(ctx sdk.Context, delegator string, provider string)
 
 		// not minting new coins because they're minted when the provider
 		// asked for payment (and the delegator reward map was updated)
-		err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, delegatorAcc, rewardCoins)
+		err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, withdrawAcc, rewardCoins)
 		if err != nil {
 			// panic:ok: reward transfer should never fail
 			utils.LavaFormatPanic("critical: failed to send reward to delegator for provider", err,
@@ -182,12 +182,8 @@ func (k Keeper) RewardProvidersAndDelegators(ctx sdk.Context, providerAddr sdk.A
 	}
 	claimableRewards = totalReward
 	// make sure this is post boost when rewards pool is introduced
-	contributorAddresses, contributorPart := k.specKeeper.GetContributorReward(ctx, chainID)
-	contributorsNum := int64(len(contributorAddresses))
-	if contributorsNum != 0 && contributorPart.GT(math.LegacyZeroDec()) {
-		contributorReward := totalReward.MulRaw(contributorPart.MulInt64(spectypes.ContributorPrecision).RoundInt64()).QuoRaw(spectypes.ContributorPrecision)
-		// make sure to round it down for the integers division
-		contributorReward = contributorReward.QuoRaw(contributorsNum).MulRaw(contributorsNum)
+	contributorAddresses, contributorReward := k.calcContributorReward(ctx, chainID, totalReward)
+	if !contributorReward.IsZero() {
 		claimableRewards = totalReward.Sub(contributorReward)
 		if !calcOnlyContributer {
 			err = k.PayContributors(ctx, senderModule, contributorAddresses, contributorReward, chainID)
@@ -197,10 +193,7 @@ func (k Keeper) RewardProvidersAndDelegators(ctx sdk.Context, providerAddr sdk.A
 		}
 	}
 
-	relevantDelegations := slices.Filter(delegations,
-		func(d types.Delegation) bool {
-			return d.ChainID == chainID && d.IsFirstMonthPassed(ctx.BlockTime().UTC().Unix()) && d.Delegator != d.Provider
-		})
+	relevantDelegations := k.rewardableDelegations(ctx, chainID, delegations)
 
 	providerReward, delegatorsReward := k.CalcRewards(*stakeEntry, claimableRewards, relevantDelegations)
 
@@ -213,13 +206,183 @@ func (k Keeper) RewardProvidersAndDelegators(ctx sdk.Context, providerAddr sdk.A
 		}
 	}
 
+	if !calcOnlyProvider && !calcOnlyDelegators {
+		k.SetProviderLastReward(ctx, providerAddr.String(), chainID, totalReward)
+	}
+
 	return fullProviderReward, claimableRewards, nil
 }
 
+// calcContributorReward returns the chain's contributors and the part of the total
+// reward that goes to them (rounded down to divide evenly between them)
+func (k Keeper) calcContributorReward(ctx sdk.Context, chainID string, totalReward math.Int) ([]sdk.AccAddress, math.Int) {
+	contributorAddresses, contributorPart := k.specKeeper.GetContributorReward(ctx, chainID)
+	contributorsNum := int64(len(contributorAddresses))
+	if contributorsNum == 0 || !contributorPart.GT(math.LegacyZeroDec()) {
+		return contributorAddresses, math.ZeroInt()
+	}
+	contributorReward := totalReward.MulRaw(contributorPart.MulInt64(spectypes.ContributorPrecision).RoundInt64()).QuoRaw(spectypes.ContributorPrecision)
+	// make sure to round it down for the integers division
+	return contributorAddresses, contributorReward.QuoRaw(contributorsNum).MulRaw(contributorsNum)
+}
+
+// rewardableDelegations filters the delegations that are eligible for rewards on the
+// chain: past their first month, and not the provider's self delegation
+func (k Keeper) rewardableDelegations(ctx sdk.Context, chainID string, delegations []types.Delegation) []types.Delegation {
+	return slices.Filter(delegations,
+		func(d types.Delegation) bool {
+			return d.ChainID == chainID && d.IsFirstMonthPassed(ctx.BlockTime().UTC().Unix()) && d.Delegator != d.Provider
+		})
+}
+
+// SetProviderLastReward sets the total reward of the provider's last payout on the chain
+func (k Keeper) SetProviderLastReward(ctx sdk.Context, provider, chainID string, reward math.Int) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ProviderLastRewardPrefix))
+	b, err := reward.Marshal()
+	if err != nil {
+		utils.LavaFormatError("failed to marshal provider last reward", err,
+			utils.LogAttr("provider", provider),
+			utils.LogAttr("chain_id", chainID),
+		)
+		return
+	}
+	store.Set([]byte(types.ProviderLastRewardKey(provider, chainID)), b)
+}
+
+// GetAllProviderLastRewards returns all the providers' last rewards (for genesis)
+func (k Keeper) GetAllProviderLastRewards(ctx sdk.Context) []types.ProviderLastReward {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ProviderLastRewardPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	rewards := []types.ProviderLastReward{}
+	for ; iterator.Valid(); iterator.Next() {
+		var reward math.Int
+		if err := reward.Unmarshal(iterator.Value()); err != nil {
+			utils.LavaFormatError("failed to unmarshal provider last reward", err,
+				utils.LogAttr("key", string(iterator.Key())),
+			)
+			continue
+		}
+		provider, chainID := types.ProviderLastRewardKeyDecode(string(iterator.Key()))
+		rewards = append(rewards, types.ProviderLastReward{Provider: provider, ChainId: chainID, Reward: reward})
+	}
+	return rewards
+}
+
+// GetProviderLastReward returns the total reward of the provider's last payout on
+// the chain (zero if it was never paid)
+func (k Keeper) GetProviderLastReward(ctx sdk.Context, provider, chainID string) math.Int {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ProviderLastRewardPrefix))
+	b := store.Get([]byte(types.ProviderLastRewardKey(provider, chainID)))
+	if b == nil {
+		return math.ZeroInt()
+	}
+
+	var reward math.Int
+	if err := reward.Unmarshal(b); err != nil {
+		utils.LavaFormatError("failed to unmarshal provider last reward", err,
+			utils.LogAttr("provider", provider),
+			utils.LogAttr("chain_id", chainID),
+		)
+		return math.ZeroInt()
+	}
+	return reward
+}
+
+// ProjectDelegatorEpochReward projects the delegator's reward from the provider's
+// next payout on the chain, given the upcoming epoch's stake distribution. The
+// projection assumes the payout stays the same as the provider's last one, and
+// applies the same split as RewardProvidersAndDelegators: the contributors' part,
+// then the provider's stake and commission, then the delegator's share of the
+// provider's DelegateTotal.
+func (k Keeper) ProjectDelegatorEpochReward(ctx sdk.Context, delegator, provider, chainID string) (sdk.Coins, error) {
+	providerAddr, err := sdk.AccAddressFromBech32(provider)
+	if err != nil {
+		return nil, utils.LavaFormatWarning("cannot project delegator reward", err,
+			utils.LogAttr("provider", provider),
+		)
+	}
+
+	stakeEntry, found, _ := k.epochstorageKeeper.GetStakeEntryByAddressCurrent(ctx, chainID, providerAddr)
+	if !found {
+		return nil, utils.LavaFormatWarning("cannot project delegator reward", epochstoragetypes.ErrProviderNotStaked,
+			utils.LogAttr("provider", provider),
+			utils.LogAttr("chain_id", chainID),
+		)
+	}
+
+	payout := k.GetProviderLastReward(ctx, provider, chainID)
+	if payout.IsZero() {
+		return sdk.NewCoins(), nil
+	}
+
+	nextEpoch := k.epochstorageKeeper.GetCurrentNextEpoch(ctx)
+	delegations, err := k.GetProviderDelegators(ctx, provider, nextEpoch)
+	if err != nil {
+		return nil, err
+	}
+	relevantDelegations := k.rewardableDelegations(ctx, chainID, delegations)
+
+	_, contributorReward := k.calcContributorReward(ctx, chainID, payout)
+	_, delegatorsReward := k.CalcRewards(stakeEntry, payout.Sub(contributorReward), relevantDelegations)
+
+	for _, delegation := range relevantDelegations {
+		if delegation.Delegator == delegator {
+			reward := k.CalcDelegatorReward(delegatorsReward, stakeEntry.DelegateTotal.Amount, delegation)
+			return sdk.NewCoins(sdk.NewCoin(k.stakingKeeper.BondDenom(ctx), reward)), nil
+		}
+	}
+
+	return sdk.NewCoins(), nil
+}
+
+// ComputeDelegatorsRewardShare splits the provider's gross reward on the chain between
+// the provider and its delegators, given the epoch's stake distribution: the split is
+// the same as RewardProvidersAndDelegators' (the provider's stake and commission vs.
+// its rewardable delegations), only computed per coin of the reward (and without the
+// contributors' part). Without rewardable delegations, the whole reward goes to the
+// provider.
+func (k Keeper) ComputeDelegatorsRewardShare(ctx sdk.Context, provider, chainID string, grossReward sdk.Coins, epoch uint64) (delegatorsShare, providerShare sdk.Coins, err error) {
+	providerAddr, err := sdk.AccAddressFromBech32(provider)
+	if err != nil {
+		return nil, nil, utils.LavaFormatWarning("cannot compute delegators reward share", err,
+			utils.LogAttr("provider", provider),
+		)
+	}
+	stakeEntry, err := k.epochstorageKeeper.GetStakeEntryForProviderEpoch(ctx, chainID, providerAddr, epoch)
+	if err != nil {
+		return nil, nil, err
+	}
+	delegations, err := k.GetProviderDelegators(ctx, provider, epoch)
+	if err != nil {
+		return nil, nil, err
+	}
+	relevantDelegations := k.rewardableDelegations(ctx, chainID, delegations)
+	if len(relevantDelegations) == 0 {
+		return sdk.NewCoins(), grossReward, nil
+	}
+
+	delegatorsShare = sdk.NewCoins()
+	providerShare = sdk.NewCoins()
+	for _, coin := range grossReward {
+		providerReward, delegatorsReward := k.CalcRewards(*stakeEntry, coin.Amount, relevantDelegations)
+		if providerReward.IsZero() && delegatorsReward.IsZero() {
+			// no effective stake to split by
+			providerReward = coin.Amount
+		}
+		delegatorsShare = delegatorsShare.Add(sdk.NewCoin(coin.Denom, delegatorsReward))
+		providerShare = providerShare.Add(sdk.NewCoin(coin.Denom, providerReward))
+	}
+
+	return delegatorsShare, providerShare, nil
+}
+
 // updateDelegatorsReward updates the delegator rewards map
 func (k Keeper) updateDelegatorsReward(ctx sdk.Context, totalDelegations math.Int, delegations []types.Delegation, totalReward math.Int, delegatorsReward math.Int, senderModule string, calcOnly bool) (leftoverRewards math.Int) {
 	usedDelegatorRewards := math.ZeroInt() // the delegator rewards are calculated using int division, so there might be leftovers
 
+	delegations, totalDelegations = k.weightDelegationsByLoyalty(ctx, delegations, totalDelegations)
 	for _, delegation := range delegations {
 		delegatorRewardAmount := k.CalcDelegatorReward(delegatorsReward, totalDelegations, delegation)
 
@@ -252,7 +415,10 @@ func (k Keeper) rewardDelegator(ctx sdk.Context, delegation types.Delegation, am
 	err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, senderModule, types.ModuleName, sdk.NewCoins(sdk.NewCoin(k.stakingKeeper.BondDenom(ctx), amount)))
 	if err != nil {
 		utils.LavaFormatError("failed to send rewards to module", err, utils.LogAttr("sender", senderModule), utils.LogAttr("amount", amount.String()))
+		return
 	}
+
+	k.compoundDelegatorReward(ctx, delegation, amount)
 }
 
 func (k Keeper) PayContributors(ctx sdk.Context, senderModule string, contributorAddresses []sdk.AccAddress, contributorReward math.Int, specId string) error {
@@ -4,7 +4,10 @@ import (
 	"strconv"
 	"testing"
 
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	commontypes "github.com/lavanet/lava/common/types"
+	"github.com/lavanet/lava/testutil/common"
 	keepertest "github.com/lavanet/lava/testutil/keeper"
 	"github.com/lavanet/lava/testutil/nullify"
 	"github.com/lavanet/lava/x/dualstaking/keeper"
@@ -65,3 +68,197 @@ func TestDelegatorRewardGetAll(t *testing.T) {
 		nullify.Fill(keeper.GetAllDelegatorReward(ctx)),
 	)
 }
+
+func TestProjectDelegatorEpochReward(t *testing.T) {
+	ts := newTester(t)
+
+	// 2 delegators, 1 provider staked, 0 provider unstaked, 0 provider unstaking
+	ts.setupForDelegation(2, 1, 0, 0)
+
+	_, client1Addr := ts.GetAccount(common.CONSUMER, 0)
+	_, client2Addr := ts.GetAccount(common.CONSUMER, 1)
+	provider1Acct, provider1Addr := ts.GetAccount(common.PROVIDER, 0)
+
+	// let the delegators share the rewards
+	stakeEntry, found, index := ts.Keepers.Epochstorage.GetStakeEntryByAddressCurrent(ts.Ctx, ts.spec.Index, provider1Acct.Addr)
+	require.True(t, found)
+	stakeEntry.DelegateLimit = sdk.NewCoin(commontypes.TokenDenom, sdk.NewInt(10*testStake))
+	stakeEntry.DelegateCommission = 50
+	ts.Keepers.Epochstorage.ModifyStakeEntryCurrent(ts.Ctx, ts.spec.Index, stakeEntry, index)
+
+	_, err := ts.TxDualstakingDelegate(client1Addr, provider1Addr, ts.spec.Index, sdk.NewCoin(commontypes.TokenDenom, sdk.NewInt(10000)))
+	require.NoError(t, err)
+	_, err = ts.TxDualstakingDelegate(client2Addr, provider1Addr, ts.spec.Index, sdk.NewCoin(commontypes.TokenDenom, sdk.NewInt(30000)))
+	require.NoError(t, err)
+
+	// delegations are rewarded only after their first month
+	ts.AdvanceMonths(1)
+	ts.AdvanceEpoch()
+
+	// no payout yet, nothing to project from
+	projected, err := ts.Keepers.Dualstaking.ProjectDelegatorEpochReward(ts.Ctx, client1Addr, provider1Addr, ts.spec.Index)
+	require.NoError(t, err)
+	require.True(t, projected.IsZero())
+
+	payout := math.NewInt(1000000)
+	err = ts.Keepers.BankKeeper.MintCoins(ts.Ctx, types.ModuleName, sdk.NewCoins(sdk.NewCoin(ts.BondDenom(), payout.MulRaw(3))))
+	require.NoError(t, err)
+	_, _, err = ts.Keepers.Dualstaking.RewardProvidersAndDelegators(ts.Ctx, provider1Acct.Addr, ts.spec.Index, payout, types.ModuleName, false, false, false)
+	require.NoError(t, err)
+	require.True(t, payout.Equal(ts.Keepers.Dualstaking.GetProviderLastReward(ts.Ctx, provider1Addr, ts.spec.Index)))
+
+	// simulate another identical payout and compare against the projection
+	for _, clientAddr := range []string{client1Addr, client2Addr} {
+		projected, err := ts.Keepers.Dualstaking.ProjectDelegatorEpochReward(ts.Ctx, clientAddr, provider1Addr, ts.spec.Index)
+		require.NoError(t, err)
+		require.False(t, projected.IsZero())
+
+		index := types.DelegationKey(provider1Addr, clientAddr, ts.spec.Index)
+		before, found := ts.Keepers.Dualstaking.GetDelegatorReward(ts.Ctx, index)
+		require.True(t, found)
+
+		_, _, err = ts.Keepers.Dualstaking.RewardProvidersAndDelegators(ts.Ctx, provider1Acct.Addr, ts.spec.Index, payout, types.ModuleName, true, false, true)
+		require.NoError(t, err)
+
+		after, found := ts.Keepers.Dualstaking.GetDelegatorReward(ts.Ctx, index)
+		require.True(t, found)
+		require.Equal(t, projected, sdk.NewCoins(after.Amount.Sub(before.Amount)))
+	}
+
+	// a delegator without a delegation to the provider projects nothing
+	projected, err = ts.Keepers.Dualstaking.ProjectDelegatorEpochReward(ts.Ctx, provider1Addr, provider1Addr, ts.spec.Index)
+	require.NoError(t, err)
+	require.True(t, projected.IsZero())
+}
+
+func TestComputeDelegatorsRewardShare(t *testing.T) {
+	ts := newTester(t)
+
+	// 2 delegators, 2 provider staked, 0 provider unstaked, 0 provider unstaking
+	ts.setupForDelegation(2, 2, 0, 0)
+
+	_, client1Addr := ts.GetAccount(common.CONSUMER, 0)
+	_, client2Addr := ts.GetAccount(common.CONSUMER, 1)
+	provider1Acct, provider1Addr := ts.GetAccount(common.PROVIDER, 0)
+	_, provider2Addr := ts.GetAccount(common.PROVIDER, 1)
+
+	keeper := ts.Keepers.Dualstaking
+	grossReward := sdk.NewCoins(sdk.NewCoin(ts.BondDenom(), math.NewInt(1000000)))
+
+	// (provider1's stake is testStake, and its delegations total 40000)
+	_, err := ts.TxDualstakingDelegate(client1Addr, provider1Addr, ts.spec.Index, sdk.NewCoin(commontypes.TokenDenom, sdk.NewInt(10000)))
+	require.NoError(t, err)
+	_, err = ts.TxDualstakingDelegate(client2Addr, provider1Addr, ts.spec.Index, sdk.NewCoin(commontypes.TokenDenom, sdk.NewInt(30000)))
+	require.NoError(t, err)
+	ts.AdvanceEpoch()
+
+	// delegations within their first month are not rewarded: all to the provider
+	delegatorsShare, providerShare, err := keeper.ComputeDelegatorsRewardShare(ts.Ctx, provider1Addr, ts.spec.Index, grossReward, ts.EpochStart())
+	require.NoError(t, err)
+	require.True(t, delegatorsShare.IsZero())
+	require.Equal(t, grossReward, providerShare)
+
+	ts.AdvanceMonths(1)
+	ts.AdvanceEpoch()
+
+	for _, tt := range []struct {
+		name            string
+		commission      uint64
+		limit           int64
+		delegatorsShare int64
+	}{
+		// providerReward = 1000000 * 100000/140000 + commission% of 1000000 * 40000/140000
+		{"no commission", 0, 10 * testStake, 285715},
+		{"half commission", 50, 10 * testStake, 142858},
+		{"full commission", 100, 10 * testStake, 1},
+		// the delegations count up to the limit:
+		// providerReward = 1000000 * 100000/120000 + commission% of 1000000 * 20000/120000
+		{"limited delegations", 50, 20000, 83334},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			stakeEntry, found, index := ts.Keepers.Epochstorage.GetStakeEntryByAddressCurrent(ts.Ctx, ts.spec.Index, provider1Acct.Addr)
+			require.True(t, found)
+			stakeEntry.DelegateLimit = sdk.NewCoin(commontypes.TokenDenom, sdk.NewInt(tt.limit))
+			stakeEntry.DelegateCommission = tt.commission
+			ts.Keepers.Epochstorage.ModifyStakeEntryCurrent(ts.Ctx, ts.spec.Index, stakeEntry, index)
+			ts.AdvanceEpoch()
+
+			delegatorsShare, providerShare, err := keeper.ComputeDelegatorsRewardShare(ts.Ctx, provider1Addr, ts.spec.Index, grossReward, ts.EpochStart())
+			require.NoError(t, err)
+			require.Equal(t, sdk.NewCoins(sdk.NewCoin(ts.BondDenom(), math.NewInt(tt.delegatorsShare))), delegatorsShare)
+			require.Equal(t, grossReward, delegatorsShare.Add(providerShare...))
+		})
+	}
+
+	// no delegations: all to the provider
+	delegatorsShare, providerShare, err = keeper.ComputeDelegatorsRewardShare(ts.Ctx, provider2Addr, ts.spec.Index, grossReward, ts.EpochStart())
+	require.NoError(t, err)
+	require.True(t, delegatorsShare.IsZero())
+	require.Equal(t, grossReward, providerShare)
+
+	// not a provider on the chain
+	_, _, err = keeper.ComputeDelegatorsRewardShare(ts.Ctx, client1Addr, ts.spec.Index, grossReward, ts.EpochStart())
+	require.Error(t, err)
+}
+
+func TestDelegationAutoCompound(t *testing.T) {
+	ts := newTester(t)
+
+	// 1 delegator, 1 provider staked, 0 provider unstaked, 0 provider unstaking
+	ts.setupForDelegation(1, 1, 0, 0)
+
+	client1Acct, client1Addr := ts.GetAccount(common.CONSUMER, 0)
+	provider1Acct, provider1Addr := ts.GetAccount(common.PROVIDER, 0)
+
+	stakeEntry, found, index := ts.Keepers.Epochstorage.GetStakeEntryByAddressCurrent(ts.Ctx, ts.spec.Index, provider1Acct.Addr)
+	require.True(t, found)
+	stakeEntry.DelegateLimit = sdk.NewCoin(commontypes.TokenDenom, sdk.NewInt(10*testStake))
+	ts.Keepers.Epochstorage.ModifyStakeEntryCurrent(ts.Ctx, ts.spec.Index, stakeEntry, index)
+
+	amount := sdk.NewCoin(commontypes.TokenDenom, sdk.NewInt(10000))
+	_, err := ts.TxDualstakingDelegate(client1Addr, provider1Addr, ts.spec.Index, amount)
+	require.NoError(t, err)
+
+	// only existing delegations can compound
+	_, err = ts.TxDualstakingSetAutoCompound(provider1Addr, client1Addr, ts.spec.Index, true)
+	require.ErrorIs(t, err, types.ErrDelegationNotFound)
+	_, err = ts.TxDualstakingSetAutoCompound(client1Addr, provider1Addr, ts.spec.Index, true)
+	require.NoError(t, err)
+	require.True(t, ts.Keepers.Dualstaking.GetDelegationAutoCompound(ts.Ctx, client1Addr, provider1Addr, ts.spec.Index))
+
+	// delegations are rewarded only after their first month
+	ts.AdvanceMonths(1)
+	ts.AdvanceEpoch()
+
+	balance := ts.GetBalance(client1Acct.Addr)
+	payout := math.NewInt(1000000)
+	err = ts.Keepers.BankKeeper.MintCoins(ts.Ctx, types.ModuleName, sdk.NewCoins(sdk.NewCoin(ts.BondDenom(), payout.MulRaw(3))))
+	require.NoError(t, err)
+
+	delegated := amount
+	for i := 0; i < 2; i++ {
+		_, _, err = ts.Keepers.Dualstaking.RewardProvidersAndDelegators(ts.Ctx, provider1Acct.Addr, ts.spec.Index, payout, types.ModuleName, false, false, false)
+		require.NoError(t, err)
+
+		// the reward was delegated rather than left to claim or sent to the wallet
+		delegation, found := ts.Keepers.Dualstaking.GetDelegation(ts.Ctx, client1Addr, provider1Addr, ts.spec.Index, ts.GetNextEpoch())
+		require.True(t, found)
+		require.True(t, delegation.Amount.IsGTE(delegated))
+		require.False(t, delegation.Amount.IsEqual(delegated))
+		delegated = delegation.Amount
+
+		_, found = ts.Keepers.Dualstaking.GetDelegatorReward(ts.Ctx, types.DelegationKey(provider1Addr, client1Addr, ts.spec.Index))
+		require.False(t, found)
+		require.Equal(t, balance, ts.GetBalance(client1Acct.Addr))
+
+		ts.AdvanceEpoch()
+	}
+
+	// without compounding the reward is left to claim
+	_, err = ts.TxDualstakingSetAutoCompound(client1Addr, provider1Addr, ts.spec.Index, false)
+	require.NoError(t, err)
+	_, _, err = ts.Keepers.Dualstaking.RewardProvidersAndDelegators(ts.Ctx, provider1Acct.Addr, ts.spec.Index, payout, types.ModuleName, false, false, false)
+	require.NoError(t, err)
+	_, found = ts.Keepers.Dualstaking.GetDelegatorReward(ts.Ctx, types.DelegationKey(provider1Addr, client1Addr, ts.spec.Index))
+	require.True(t, found)
+}
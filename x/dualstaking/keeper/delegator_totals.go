@@ -0,0 +1,107 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/utils"
+	"github.com/lavanet/lava/x/dualstaking/types"
+)
+
+// The delegators' totals per chain (summed over all providers, including the empty
+// provider's empty chain) are cached so per-delegator breakdowns don't require
+// iterating all the delegator's delegations. The totals are updated together with
+// the delegations (as of the next epoch) and indexed by <delegator,chainID>.
+
+// GetDelegatorChainTotals returns the delegator's delegation totals per chain
+func (k Keeper) GetDelegatorChainTotals(ctx sdk.Context, delegator string) map[string]math.Int {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DelegatorChainTotalPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte(types.DelegatorChainTotalKey(delegator, "")))
+	defer iterator.Close()
+
+	totals := map[string]math.Int{}
+	for ; iterator.Valid(); iterator.Next() {
+		_, chainID := types.DelegatorChainTotalKeyDecode(string(iterator.Key()))
+		var total math.Int
+		if err := total.Unmarshal(iterator.Value()); err != nil {
+			utils.LavaFormatError("failed to unmarshal delegator chain total", err,
+				utils.LogAttr("delegator", delegator),
+				utils.LogAttr("chain_id", chainID),
+			)
+			continue
+		}
+		totals[chainID] = total
+	}
+	return totals
+}
+
+// GetDelegatorChainTotal returns the delegator's delegation total on the chain
+func (k Keeper) GetDelegatorChainTotal(ctx sdk.Context, delegator, chainID string) math.Int {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DelegatorChainTotalPrefix))
+	b := store.Get([]byte(types.DelegatorChainTotalKey(delegator, chainID)))
+	if b == nil {
+		return math.ZeroInt()
+	}
+
+	var total math.Int
+	if err := total.Unmarshal(b); err != nil {
+		utils.LavaFormatError("failed to unmarshal delegator chain total", err,
+			utils.LogAttr("delegator", delegator),
+			utils.LogAttr("chain_id", chainID),
+		)
+		return math.ZeroInt()
+	}
+	return total
+}
+
+// addDelegatorChainTotal adds the (possibly negative) amount to the delegator's
+// total on the chain, and removes the total once it reaches zero
+func (k Keeper) addDelegatorChainTotal(ctx sdk.Context, delegator, chainID string, amount math.Int) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DelegatorChainTotalPrefix))
+	key := []byte(types.DelegatorChainTotalKey(delegator, chainID))
+
+	total := k.GetDelegatorChainTotal(ctx, delegator, chainID).Add(amount)
+	if !total.IsPositive() {
+		store.Delete(key)
+		return
+	}
+
+	b, err := total.Marshal()
+	if err != nil {
+		utils.LavaFormatError("failed to marshal delegator chain total", err,
+			utils.LogAttr("delegator", delegator),
+			utils.LogAttr("chain_id", chainID),
+		)
+		return
+	}
+	store.Set(key, b)
+}
+
+// RebuildDelegatorChainTotals recomputes all the delegators' per chain totals from
+// the delegations (as of the next epoch)
+func (k Keeper) RebuildDelegatorChainTotals(ctx sdk.Context) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DelegatorChainTotalPrefix))
+	iterator := store.Iterator(nil, nil)
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+
+	indices := k.delegationFS.GetAllEntryIndices(ctx)
+	if len(indices) == 0 {
+		return
+	}
+
+	nextEpoch := k.epochstorageKeeper.GetCurrentNextEpoch(ctx)
+	for _, ind := range indices {
+		var delegation types.Delegation
+		if !k.delegationFS.FindEntry(ctx, ind, nextEpoch, &delegation) {
+			continue
+		}
+		k.addDelegatorChainTotal(ctx, delegation.Delegator, delegation.ChainID, delegation.Amount.Amount)
+	}
+}
@@ -0,0 +1,40 @@
+package keeper
+
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
+// A chain's extension (e.g. archive) may require a minimum effective stake (the
+// provider's stake and its delegations, up to its delegation limit), so providers
+// only advertise the extensions they can fund. An extension without a requirement
+// (default) is available to every provider. The requirements are set by the
+// ExtensionStakeRequirements param.
+
+// GetExtensionStakeRequirement returns the effective stake required to serve the
+// chain's extension, if one is set
+func (k Keeper) GetExtensionStakeRequirement(ctx sdk.Context, chainID, extension string) (sdk.Coin, bool) {
+	for _, entry := range k.ExtensionStakeRequirements(ctx) {
+		if entry.ChainId == chainID && entry.Extension == extension {
+			return entry.Requirement, true
+		}
+	}
+	return sdk.Coin{}, false
+}
+
+// ProviderMeetsExtensionStakeRequirement checks whether the provider's (current)
+// effective stake on the chain meets the extension's stake requirement. A provider
+// that is not staked on the chain never meets it.
+func (k Keeper) ProviderMeetsExtensionStakeRequirement(ctx sdk.Context, provider, chainID, extension string) bool {
+	providerAddr, err := sdk.AccAddressFromBech32(provider)
+	if err != nil {
+		return false
+	}
+	stakeEntry, found, _ := k.epochstorageKeeper.GetStakeEntryByAddressCurrent(ctx, chainID, providerAddr)
+	if !found {
+		return false
+	}
+
+	requirement, found := k.GetExtensionStakeRequirement(ctx, chainID, extension)
+	if !found {
+		return true
+	}
+	return stakeEntry.EffectiveStake().GTE(requirement.Amount)
+}
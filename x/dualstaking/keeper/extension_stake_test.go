@@ -0,0 +1,77 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	commontypes "github.com/lavanet/lava/common/types"
+	"github.com/lavanet/lava/testutil/common"
+	"github.com/lavanet/lava/x/dualstaking/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProviderMeetsExtensionStakeRequirement(t *testing.T) {
+	ts := newTester(t)
+
+	// 1 delegator, 1 provider staked, 0 provider unstaked, 0 provider unstaking
+	ts.setupForDelegation(1, 1, 0, 0)
+
+	_, client1Addr := ts.GetAccount(common.CONSUMER, 0)
+	provider1Acct, provider1Addr := ts.GetAccount(common.PROVIDER, 0)
+	keeper := ts.Keepers.Dualstaking
+
+	coin := func(amount int64) sdk.Coin {
+		return sdk.NewCoin(commontypes.TokenDenom, sdk.NewInt(amount))
+	}
+
+	// without a requirement, every staked provider meets it
+	require.True(t, keeper.ProviderMeetsExtensionStakeRequirement(ts.Ctx, provider1Addr, ts.spec.Index, "archive"))
+	require.False(t, keeper.ProviderMeetsExtensionStakeRequirement(ts.Ctx, client1Addr, ts.spec.Index, "archive"))
+
+	setRequirements := func(requirements ...types.ExtensionStakeRequirement) {
+		params := keeper.GetParams(ts.Ctx)
+		params.ExtensionStakeRequirements = requirements
+		keeper.SetParams(ts.Ctx, params)
+	}
+
+	setRequirements(types.ExtensionStakeRequirement{ChainId: ts.spec.Index, Extension: "archive", Requirement: coin(2 * testStake)})
+	requirement, found := keeper.GetExtensionStakeRequirement(ts.Ctx, ts.spec.Index, "archive")
+	require.True(t, found)
+	require.True(t, coin(2*testStake).IsEqual(requirement))
+
+	// the provider's own stake is not enough (other extensions are unaffected)
+	require.False(t, keeper.ProviderMeetsExtensionStakeRequirement(ts.Ctx, provider1Addr, ts.spec.Index, "archive"))
+	require.True(t, keeper.ProviderMeetsExtensionStakeRequirement(ts.Ctx, provider1Addr, ts.spec.Index, "debug"))
+
+	// delegations count up to the provider's delegation limit
+	stakeEntry, found, index := ts.Keepers.Epochstorage.GetStakeEntryByAddressCurrent(ts.Ctx, ts.spec.Index, provider1Acct.Addr)
+	require.True(t, found)
+	stakeEntry.DelegateLimit = coin(testStake / 2)
+	ts.Keepers.Epochstorage.ModifyStakeEntryCurrent(ts.Ctx, ts.spec.Index, stakeEntry, index)
+
+	_, err := ts.TxDualstakingDelegate(client1Addr, provider1Addr, ts.spec.Index, coin(testStake))
+	require.NoError(t, err)
+	require.False(t, keeper.ProviderMeetsExtensionStakeRequirement(ts.Ctx, provider1Addr, ts.spec.Index, "archive"))
+
+	stakeEntry, found, index = ts.Keepers.Epochstorage.GetStakeEntryByAddressCurrent(ts.Ctx, ts.spec.Index, provider1Acct.Addr)
+	require.True(t, found)
+	stakeEntry.DelegateLimit = coin(testStake)
+	ts.Keepers.Epochstorage.ModifyStakeEntryCurrent(ts.Ctx, ts.spec.Index, stakeEntry, index)
+	require.True(t, keeper.ProviderMeetsExtensionStakeRequirement(ts.Ctx, provider1Addr, ts.spec.Index, "archive"))
+
+	// invalid or duplicate requirements are rejected
+	params := keeper.GetParams(ts.Ctx)
+	params.ExtensionStakeRequirements = []types.ExtensionStakeRequirement{
+		{ChainId: ts.spec.Index, Extension: "archive", Requirement: sdk.Coin{Denom: "", Amount: sdk.NewInt(1)}},
+	}
+	require.Error(t, params.Validate())
+	params.ExtensionStakeRequirements = []types.ExtensionStakeRequirement{
+		{ChainId: ts.spec.Index, Extension: "archive", Requirement: coin(testStake)},
+		{ChainId: ts.spec.Index, Extension: "archive", Requirement: coin(testStake)},
+	}
+	require.Error(t, params.Validate())
+
+	setRequirements()
+	_, found = keeper.GetExtensionStakeRequirement(ts.Ctx, ts.spec.Index, "archive")
+	require.False(t, found)
+}
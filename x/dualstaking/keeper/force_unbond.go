@@ -0,0 +1,95 @@
+package keeper
+
+import (
+	"strconv"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/lavanet/lava/utils"
+	"github.com/lavanet/lava/x/dualstaking/types"
+)
+
+// ForceUnbondDelegator unbonds all of the delegator's funds, for emergency and
+// compliance use by governance. Every validator delegation of the delegator is
+// unbonded, and the staking hooks unbond the delegator's provider delegations
+// accordingly (ignoring delegation locks). With skipHoldPeriod the funds are
+// returned to the delegator right away, otherwise they go through the staking
+// module's unbonding period like a regular unbond. Either all the delegations are
+// unbonded or none is.
+func (k Keeper) ForceUnbondDelegator(ctx sdk.Context, delegator string, skipHoldPeriod bool) error {
+	delegatorAddr, err := sdk.AccAddressFromBech32(delegator)
+	if err != nil {
+		return utils.LavaFormatWarning("invalid delegator address", err,
+			utils.LogAttr("delegator", delegator),
+		)
+	}
+
+	delegations := k.stakingKeeper.GetAllDelegatorDelegations(ctx, delegatorAddr)
+	if len(delegations) == 0 {
+		return utils.LavaFormatWarning("cannot force unbond: delegator has no delegations", types.ErrDelegationNotFound,
+			utils.LogAttr("delegator", delegator),
+		)
+	}
+
+	// the staking hooks unbond the provider delegations, ignoring their locks
+	cacheCtx, writeCache := ctx.CacheContext()
+	k.setIgnoreDelegationLocks(cacheCtx, true)
+	total := math.ZeroInt()
+	for _, d := range delegations {
+		amount, err := k.forceUnbondValidatorDelegation(cacheCtx, delegatorAddr, d, skipHoldPeriod)
+		if err != nil {
+			return utils.LavaFormatWarning("failed to force unbond delegator", err,
+				utils.LogAttr("delegator", delegator),
+				utils.LogAttr("validator", d.ValidatorAddress),
+			)
+		}
+		total = total.Add(amount)
+	}
+	k.setIgnoreDelegationLocks(cacheCtx, false)
+	writeCache()
+
+	details := map[string]string{
+		"delegator":        delegator,
+		"amount":           sdk.NewCoin(k.stakingKeeper.BondDenom(ctx), total).String(),
+		"skip_hold_period": strconv.FormatBool(skipHoldPeriod),
+	}
+	utils.LogLavaEvent(ctx, k.Logger(ctx), types.ForceUnbondDelegatorEventName, details, "Force unbond delegator")
+
+	return nil
+}
+
+// forceUnbondValidatorDelegation unbonds all of a validator delegation and returns
+// the unbonded amount
+func (k Keeper) forceUnbondValidatorDelegation(ctx sdk.Context, delegatorAddr sdk.AccAddress, d stakingtypes.Delegation, skipHoldPeriod bool) (math.Int, error) {
+	valAddr, err := sdk.ValAddressFromBech32(d.ValidatorAddress)
+	if err != nil {
+		return math.ZeroInt(), err
+	}
+	validator, found := k.stakingKeeper.GetValidator(ctx, valAddr)
+	if !found {
+		return math.ZeroInt(), stakingtypes.ErrNoValidatorFound
+	}
+
+	if !skipHoldPeriod {
+		amount := validator.TokensFromShares(d.Shares).TruncateInt()
+		_, err := k.stakingKeeper.Undelegate(ctx, delegatorAddr, valAddr, d.Shares)
+		return amount, err
+	}
+
+	// unbond the shares without an unbonding entry, and release the validator's
+	// tokens from the pool that holds them
+	amount, err := k.stakingKeeper.Unbond(ctx, delegatorAddr, valAddr, d.Shares)
+	if err != nil {
+		return math.ZeroInt(), err
+	}
+	if !amount.IsPositive() {
+		return amount, nil
+	}
+	pool := stakingtypes.NotBondedPoolName
+	if validator.IsBonded() {
+		pool = stakingtypes.BondedPoolName
+	}
+	coins := sdk.NewCoins(sdk.NewCoin(k.stakingKeeper.BondDenom(ctx), amount))
+	return amount, k.bankKeeper.UndelegateCoinsFromModuleToAccount(ctx, pool, delegatorAddr, coins)
+}
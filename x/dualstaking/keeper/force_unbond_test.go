@@ -0,0 +1,74 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	commontypes "github.com/lavanet/lava/common/types"
+	"github.com/lavanet/lava/testutil/common"
+	"github.com/lavanet/lava/x/dualstaking/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestForceUnbondDelegator(t *testing.T) {
+	ts := newTester(t)
+
+	// 2 delegators, 2 provider staked, 0 provider unstaked, 0 provider unstaking
+	ts.setupForDelegation(2, 2, 0, 0)
+
+	client1Acct, client1Addr := ts.GetAccount(common.CONSUMER, 0)
+	client2Acct, client2Addr := ts.GetAccount(common.CONSUMER, 1)
+	_, provider1Addr := ts.GetAccount(common.PROVIDER, 0)
+	_, provider2Addr := ts.GetAccount(common.PROVIDER, 1)
+
+	keeper := ts.Keepers.Dualstaking
+	amount := sdk.NewCoin(commontypes.TokenDenom, sdk.NewInt(10000))
+
+	// only the gov authority may force unbond
+	authority := keeper.GetAuthority()
+	_, err := ts.TxDualstakingForceUnbondDelegator(client2Addr, client1Addr, true)
+	require.ErrorIs(t, err, govtypes.ErrInvalidSigner)
+
+	// nothing to unbond
+	_, err = ts.TxDualstakingForceUnbondDelegator(authority, client1Addr, true)
+	require.ErrorIs(t, err, types.ErrDelegationNotFound)
+
+	balances := map[string]int64{
+		client1Addr: ts.GetBalance(client1Acct.Addr),
+		client2Addr: ts.GetBalance(client2Acct.Addr),
+	}
+	for _, client := range []string{client1Addr, client2Addr} {
+		for _, provider := range []string{provider1Addr, provider2Addr} {
+			_, err := ts.TxDualstakingDelegate(client, provider, ts.spec.Index, amount)
+			require.NoError(t, err)
+		}
+	}
+	// a locked delegation is force unbonded as well
+	keeper.SetDelegationLock(ts.Ctx, client1Addr, provider1Addr, ts.spec.Index, ts.BlockHeight()+10*ts.EpochBlocks())
+	ts.AdvanceEpoch()
+
+	// skipping the hold period, the funds are returned right away
+	_, err = ts.TxDualstakingForceUnbondDelegator(authority, client1Addr, true)
+	require.NoError(t, err)
+	require.Equal(t, balances[client1Addr], ts.GetBalance(client1Acct.Addr))
+
+	// otherwise, the funds are returned after the unbonding period
+	_, err = ts.TxDualstakingForceUnbondDelegator(authority, client2Addr, false)
+	require.NoError(t, err)
+	require.Equal(t, balances[client2Addr]-2*amount.Amount.Int64(), ts.GetBalance(client2Acct.Addr))
+	require.NotEmpty(t, ts.Keepers.StakingKeeper.GetUnbondingDelegations(ts.Ctx, client2Acct.Addr, 10))
+
+	ts.AdvanceEpoch()
+	for _, client := range []string{client1Addr, client2Addr} {
+		providers, err := keeper.GetDelegatorProviders(ts.Ctx, client, ts.EpochStart())
+		require.NoError(t, err)
+		require.Empty(t, providers)
+		for _, provider := range []string{provider1Addr, provider2Addr} {
+			_, found := keeper.GetDelegation(ts.Ctx, client, provider, ts.spec.Index, ts.EpochStart())
+			require.False(t, found)
+		}
+	}
+	require.False(t, keeper.IsDelegationLocked(ts.Ctx, client1Addr, provider1Addr, ts.spec.Index))
+	require.NoError(t, keeper.AssertPoolInvariants(ts.Ctx))
+}
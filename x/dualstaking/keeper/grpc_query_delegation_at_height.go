@@ -0,0 +1,25 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/x/dualstaking/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) DelegationAtHeight(goCtx context.Context, req *types.QueryDelegationAtHeightRequest) (*types.QueryDelegationAtHeightResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	delegation, err := k.GetDelegationAtHeight(ctx, req.Delegator, req.Provider, req.ChainId, req.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDelegationAtHeightResponse{Delegation: delegation}, nil
+}
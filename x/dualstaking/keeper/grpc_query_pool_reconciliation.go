@@ -0,0 +1,29 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/x/dualstaking/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) PoolReconciliation(goCtx context.Context, req *types.QueryPoolReconciliationRequest) (*types.QueryPoolReconciliationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	poolBalance, summedDelegations, diff, err := k.ReconcilePool(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryPoolReconciliationResponse{
+		PoolBalance:       poolBalance,
+		SummedDelegations: summedDelegations,
+		Diff:              diff,
+	}, nil
+}
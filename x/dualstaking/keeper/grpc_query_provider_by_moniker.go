@@ -0,0 +1,25 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/x/dualstaking/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) ProviderByMoniker(goCtx context.Context, req *types.QueryProviderByMonikerRequest) (*types.QueryProviderByMonikerResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	provider, err := k.ResolveProviderByMoniker(ctx, req.ChainId, req.Moniker)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryProviderByMonikerResponse{Provider: provider}, nil
+}
@@ -0,0 +1,30 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/x/dualstaking/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) ProviderDelegatorCount(goCtx context.Context, req *types.QueryProviderDelegatorCountRequest) (*types.QueryProviderDelegatorCountResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	epoch := uint64(ctx.BlockHeight())
+	if req.WithPending {
+		epoch = k.epochstorageKeeper.GetCurrentNextEpoch(ctx)
+	}
+
+	count, err := k.GetProviderDelegatorCount(ctx, req.Provider, req.ChainId, epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryProviderDelegatorCountResponse{Count: count}, nil
+}
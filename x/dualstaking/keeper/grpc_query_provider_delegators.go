@@ -14,6 +14,11 @@ func (k Keeper) ProviderDelegators(goCtx context.Context, req *types.QueryProvid
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
 	}
 
+	// don't look up the delegators of a cancelled query
+	if err := goCtx.Err(); err != nil {
+		return nil, status.FromContextError(err).Err()
+	}
+
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
 	epoch := uint64(ctx.BlockHeight())
@@ -0,0 +1,27 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/x/dualstaking/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) StoreStats(goCtx context.Context, req *types.QueryStoreStatsRequest) (*types.QueryStoreStatsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	stats := k.GetStoreStats(ctx)
+
+	return &types.QueryStoreStatsResponse{
+		DelegationEntries: stats.DelegationEntries,
+		DelegationIndices: stats.DelegationIndices,
+		DelegatorEntries:  stats.DelegatorEntries,
+		DelegatorIndices:  stats.DelegatorIndices,
+	}, nil
+}
@@ -91,6 +91,13 @@ func (ts *tester) addValidators(count int) {
 	}
 }
 
+// setMinDelegation sets the MinDelegation param
+func (ts *tester) setMinDelegation(minDelegation sdk.Int) {
+	params := ts.Keepers.Dualstaking.GetParams(ts.Ctx)
+	params.MinDelegation = minDelegation
+	ts.Keepers.Dualstaking.SetParams(ts.Ctx, params)
+}
+
 // getStakeEntry find the stake entry of a given provider + chainID
 func (ts *tester) getStakeEntry(provider sdk.AccAddress, chainID string) epochstoragetypes.StakeEntry {
 	epoch := ts.EpochStart()
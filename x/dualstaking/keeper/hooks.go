@@ -61,11 +61,12 @@ func (h Hooks) AfterDelegationModified(ctx sdk.Context, delAddr sdk.AccAddress,
 		return nil
 	} else if diff.IsPositive() {
 		// less provider delegations,a delegation operation was done, delegate to empty provider
-		err = h.k.delegate(ctx, delAddr.String(), types.EMPTY_PROVIDER, types.EMPTY_PROVIDER_CHAINID,
-			sdk.NewCoin(h.k.stakingKeeper.BondDenom(ctx), diff))
+		amount := sdk.NewCoin(h.k.stakingKeeper.BondDenom(ctx), diff)
+		err = h.k.delegate(ctx, delAddr.String(), types.EMPTY_PROVIDER, types.EMPTY_PROVIDER_CHAINID, amount)
 		if err != nil {
 			return err
 		}
+		h.k.emitEmptyProviderRebalance(ctx, delAddr.String(), amount, types.EmptyProviderRebalanceValidatorDelegate)
 	} else if diff.IsNegative() {
 		// more provider delegation, unbond operation was done, unbond from providers
 		err = h.k.UnbondUniformProviders(ctx, delAddr.String(), sdk.NewCoin(h.k.stakingKeeper.BondDenom(ctx), diff.Neg()))
@@ -112,7 +113,8 @@ func (h Hooks) BeforeValidatorSlashed(ctx sdk.Context, valAddr sdk.ValAddress, f
 			tokensToSlash = remainingTokensToSlash
 		}
 		if tokensToSlash.IsPositive() {
-			err := h.k.UnbondUniformProviders(ctx, d.DelegatorAddress, sdk.NewCoin(commontypes.TokenDenom, tokensToSlash))
+			// slashing cannot be refused, so it ignores the delegation locks
+			err := h.k.unbondUniformProviders(ctx, d.DelegatorAddress, sdk.NewCoin(commontypes.TokenDenom, tokensToSlash), true)
 			if err != nil {
 				utils.LavaFormatError("slash hook failed", err,
 					utils.Attribute{Key: "validator_address", Value: valAddr.String()},
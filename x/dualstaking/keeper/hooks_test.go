@@ -9,6 +9,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/lavanet/lava/testutil/common"
+	"github.com/lavanet/lava/utils"
 	dualstakingtypes "github.com/lavanet/lava/x/dualstaking/types"
 	"github.com/stretchr/testify/require"
 	"golang.org/x/exp/slices"
@@ -235,6 +236,73 @@ func TestUnbondUniformProviders(t *testing.T) {
 	require.True(t, diff.IsZero())
 }
 
+// TestUnbondUniformProvidersSameEpoch checks that all the unbonds made by a uniform unbond
+// take effect on the same (next) epoch, even when it's triggered on the last block of an epoch
+func TestUnbondUniformProvidersSameEpoch(t *testing.T) {
+	ts := newTester(t)
+	ts.addValidators(1)
+	err := ts.addProviders(3)
+	require.NoError(t, err)
+	ts.addClients(1)
+
+	validator, _ := ts.GetAccount(common.VALIDATOR, 0)
+	amount := sdk.NewIntFromUint64(10000)
+	ts.TxCreateValidator(validator, amount)
+
+	var providers []string
+	for i := 0; i < 3; i++ {
+		providerAcc, provider := ts.GetAccount(common.PROVIDER, i)
+		err := ts.StakeProvider(providerAcc.Addr.String(), ts.spec, amount.Int64())
+		require.NoError(t, err)
+		providers = append(providers, provider)
+	}
+
+	ts.AdvanceEpoch()
+
+	delegatorAcc, delegator := ts.GetAccount(common.CONSUMER, 0)
+	_, err = ts.TxDelegateValidator(delegatorAcc, validator, sdk.NewInt(300))
+	require.NoError(t, err)
+
+	for _, provider := range providers {
+		_, err = ts.TxDualstakingRedelegate(delegator,
+			dualstakingtypes.EMPTY_PROVIDER,
+			provider,
+			dualstakingtypes.EMPTY_PROVIDER_CHAINID,
+			ts.spec.Index,
+			sdk.NewCoin(ts.TokenDenom(), sdk.NewInt(100)))
+		require.NoError(t, err)
+	}
+
+	// let the delegations take effect and move to the last block of the epoch
+	ts.AdvanceEpoch()
+	ts.AdvanceBlocks(ts.EpochBlocks() - 1)
+	currentEpoch := ts.EpochStart()
+	nextEpoch := ts.GetNextEpoch()
+	require.Equal(t, currentEpoch+ts.EpochBlocks(), nextEpoch)
+
+	_, err = ts.TxUnbondValidator(delegatorAcc, validator, sdk.NewInt(150))
+	require.NoError(t, err)
+
+	for _, provider := range providers {
+		// the current epoch is untouched
+		d, found := ts.Keepers.Dualstaking.GetDelegation(ts.Ctx, delegator, provider, ts.spec.Index, currentEpoch)
+		require.True(t, found)
+		require.Equal(t, int64(100), d.Amount.Amount.Int64())
+
+		// all the unbonds land on the next epoch
+		d, found = ts.Keepers.Dualstaking.GetDelegation(ts.Ctx, delegator, provider, ts.spec.Index, nextEpoch)
+		require.True(t, found)
+		require.Equal(t, int64(50), d.Amount.Amount.Int64())
+	}
+
+	ts.AdvanceBlock()
+	require.Equal(t, nextEpoch, ts.EpochStart())
+
+	diff, err := ts.Keepers.Dualstaking.VerifyDelegatorBalance(ts.Ctx, delegatorAcc.Addr)
+	require.NoError(t, err)
+	require.True(t, diff.IsZero())
+}
+
 func TestValidatorSlash(t *testing.T) {
 	ts := newTester(t)
 	_, _ = ts.AddAccount(common.VALIDATOR, 0, testBalance*1000000000)
@@ -530,3 +598,193 @@ func TestNotRoundedShares(t *testing.T) {
 	_, err = ts.TxDualstakingDelegate(delegator, provider, ts.spec.Index, sdk.NewCoin(ts.TokenDenom(), delAmount))
 	require.NoError(t, err)
 }
+
+// TestUnbondUniformProvidersRebalanceEvent checks that a uniform unbond that takes funds
+// from the empty provider emits an empty provider rebalance event
+func TestUnbondUniformProvidersRebalanceEvent(t *testing.T) {
+	ts := newTester(t)
+	ts.addValidators(1)
+	err := ts.addProviders(1)
+	require.NoError(t, err)
+	ts.addClients(1)
+
+	validator, _ := ts.GetAccount(common.VALIDATOR, 0)
+	amount := sdk.NewIntFromUint64(10000)
+	ts.TxCreateValidator(validator, amount)
+
+	providerAcc, provider := ts.GetAccount(common.PROVIDER, 0)
+	err = ts.StakeProvider(providerAcc.Addr.String(), ts.spec, amount.Int64())
+	require.NoError(t, err)
+
+	ts.AdvanceEpoch()
+
+	// delegate to validator (automatically delegates to empty provider)
+	delegatorAcc, delegator := ts.GetAccount(common.CONSUMER, 0)
+	_, err = ts.TxDelegateValidator(delegatorAcc, validator, sdk.NewInt(210))
+	require.NoError(t, err)
+
+	_, err = ts.TxDualstakingRedelegate(delegator,
+		dualstakingtypes.EMPTY_PROVIDER,
+		provider,
+		dualstakingtypes.EMPTY_PROVIDER_CHAINID,
+		ts.spec.Index,
+		sdk.NewCoin(ts.TokenDenom(), sdk.NewInt(100)))
+	require.NoError(t, err)
+
+	// unbond more than the empty provider has: all of its 110 tokens are taken
+	_, err = ts.TxUnbondValidator(delegatorAcc, validator, sdk.NewInt(150))
+	require.NoError(t, err)
+
+	var reasons []string
+	for _, event := range ts.Ctx.EventManager().Events() {
+		if event.Type != utils.EventPrefix+dualstakingtypes.EmptyProviderRebalanceEventName {
+			continue
+		}
+		attrs := map[string]string{}
+		for _, attr := range event.Attributes {
+			attrs[attr.Key] = attr.Value
+		}
+		// (staking the provider and creating the validator emit events of their own)
+		if attrs["delegator"] != delegator {
+			continue
+		}
+		reasons = append(reasons, attrs["reason"])
+		if attrs["reason"] == dualstakingtypes.EmptyProviderRebalanceUniformUnbond {
+			require.Equal(t, sdk.NewCoin(ts.TokenDenom(), sdk.NewInt(110)).String(), attrs["amount"])
+		}
+	}
+	require.Contains(t, reasons, dualstakingtypes.EmptyProviderRebalanceValidatorDelegate)
+	require.Contains(t, reasons, dualstakingtypes.EmptyProviderRebalanceUniformUnbond)
+}
+
+// TestUnbondUniformProvidersRemainder checks that the uniform unbond deducts exactly the
+// requested amount when it's not evenly divisible by the number of delegations
+func TestUnbondUniformProvidersRemainder(t *testing.T) {
+	ts := newTester(t)
+	ts.addValidators(1)
+	err := ts.addProviders(3)
+	require.NoError(t, err)
+	ts.addClients(1)
+
+	validator, _ := ts.GetAccount(common.VALIDATOR, 0)
+	amount := sdk.NewIntFromUint64(10000)
+	ts.TxCreateValidator(validator, amount)
+
+	for i := 0; i < 3; i++ {
+		provider, _ := ts.GetAccount(common.PROVIDER, i)
+		err := ts.StakeProvider(provider.Addr.String(), ts.spec, amount.Int64())
+		require.NoError(t, err)
+	}
+
+	ts.AdvanceEpoch()
+
+	// delegate to validator (automatically delegates to empty provider)
+	delegatorAcc, delegator := ts.GetAccount(common.CONSUMER, 0)
+	_, err = ts.TxDelegateValidator(delegatorAcc, validator, sdk.NewInt(300))
+	require.NoError(t, err)
+
+	var providers []string
+	for i := 0; i < 3; i++ {
+		_, provider := ts.GetAccount(common.PROVIDER, i)
+		providers = append(providers, provider)
+		_, err = ts.TxDualstakingRedelegate(delegatorAcc.Addr.String(),
+			dualstakingtypes.EMPTY_PROVIDER,
+			provider,
+			dualstakingtypes.EMPTY_PROVIDER_CHAINID,
+			ts.spec.Index,
+			sdk.NewCoin(ts.TokenDenom(), sdk.NewInt(100)))
+		require.NoError(t, err)
+	}
+
+	// 100 is not divisible by 3 delegations
+	_, err = ts.TxUnbondValidator(delegatorAcc, validator, sdk.NewInt(100))
+	require.NoError(t, err)
+
+	res, err := ts.QueryDualstakingDelegatorProviders(delegator, true)
+	require.NoError(t, err)
+	require.Len(t, res.Delegations, 3)
+	total := sdk.ZeroInt()
+	for _, d := range res.Delegations {
+		require.Contains(t, providers, d.Provider)
+		// each delegation is deducted 33 or 34 tokens
+		require.True(t, d.Amount.Amount.Equal(sdk.NewInt(67)) || d.Amount.Amount.Equal(sdk.NewInt(66)))
+		total = total.Add(d.Amount.Amount)
+	}
+	require.True(t, total.Equal(sdk.NewInt(200)))
+
+	diff, err := ts.Keepers.Dualstaking.VerifyDelegatorBalance(ts.Ctx, delegatorAcc.Addr)
+	require.NoError(t, err)
+	require.True(t, diff.IsZero())
+}
+
+// TestUnbondUniformProvidersSmallAmount checks that unbonding less than the number
+// of delegations skips the delegations that are deducted nothing
+func TestUnbondUniformProvidersSmallAmount(t *testing.T) {
+	ts := newTester(t)
+	ts.addValidators(1)
+	err := ts.addProviders(3)
+	require.NoError(t, err)
+	ts.addClients(1)
+
+	validator, _ := ts.GetAccount(common.VALIDATOR, 0)
+	amount := sdk.NewIntFromUint64(10000)
+	ts.TxCreateValidator(validator, amount)
+
+	for i := 0; i < 3; i++ {
+		provider, _ := ts.GetAccount(common.PROVIDER, i)
+		err := ts.StakeProvider(provider.Addr.String(), ts.spec, amount.Int64())
+		require.NoError(t, err)
+	}
+
+	ts.AdvanceEpoch()
+
+	// delegate to validator (automatically delegates to empty provider)
+	delegatorAcc, delegator := ts.GetAccount(common.CONSUMER, 0)
+	_, err = ts.TxDelegateValidator(delegatorAcc, validator, sdk.NewInt(300))
+	require.NoError(t, err)
+
+	for i := 0; i < 3; i++ {
+		_, provider := ts.GetAccount(common.PROVIDER, i)
+		_, err = ts.TxDualstakingRedelegate(delegatorAcc.Addr.String(),
+			dualstakingtypes.EMPTY_PROVIDER,
+			provider,
+			dualstakingtypes.EMPTY_PROVIDER_CHAINID,
+			ts.spec.Index,
+			sdk.NewCoin(ts.TokenDenom(), sdk.NewInt(100)))
+		require.NoError(t, err)
+	}
+
+	// 2 tokens from 3 delegations: one of them is deducted nothing
+	_, err = ts.TxUnbondValidator(delegatorAcc, validator, sdk.NewInt(2))
+	require.NoError(t, err)
+
+	res, err := ts.QueryDualstakingDelegatorProviders(delegator, true)
+	require.NoError(t, err)
+	require.Len(t, res.Delegations, 3)
+	total := sdk.ZeroInt()
+	untouched := 0
+	for _, d := range res.Delegations {
+		require.True(t, d.Amount.Amount.Equal(sdk.NewInt(100)) || d.Amount.Amount.Equal(sdk.NewInt(99)))
+		if d.Amount.Amount.Equal(sdk.NewInt(100)) {
+			untouched++
+		}
+		total = total.Add(d.Amount.Amount)
+	}
+	require.Equal(t, 1, untouched)
+	require.True(t, total.Equal(sdk.NewInt(298)))
+
+	diff, err := ts.Keepers.Dualstaking.VerifyDelegatorBalance(ts.Ctx, delegatorAcc.Addr)
+	require.NoError(t, err)
+	require.True(t, diff.IsZero())
+}
+
+// TestUnbondUniformProvidersBadDelegator checks that the uniform unbond fails with the
+// delegator's providers lookup error on a malformed delegator address, rather than
+// silently unbonding nothing
+func TestUnbondUniformProvidersBadDelegator(t *testing.T) {
+	ts := newTester(t)
+
+	err := ts.Keepers.Dualstaking.UnbondUniformProviders(ts.Ctx, "not-a-bech32-address", sdk.NewCoin(ts.TokenDenom(), sdk.NewInt(100)))
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "cannot get delegator's providers")
+}
@@ -0,0 +1,232 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/utils"
+	"github.com/lavanet/lava/x/dualstaking/types"
+	epochstoragetypes "github.com/lavanet/lava/x/epochstorage/types"
+)
+
+// ImportDelegations writes delegations in bulk (e.g. delegations migrated from
+// another system, at genesis or after a state-sync), effective from the next epoch.
+// Unlike delegating, no funds are transferred: the delegated funds are assumed to
+// already be in the staking pool, and the import fails if the delegations (all of
+// them, including the existing ones) exceed it. The delegation and delegator
+// fixation stores are written once per entry, and the Stake/DelegateTotal of each
+// affected stake entry is reconstructed from the provider's delegations in one pass.
+// An imported delegation is added to an existing delegation (if any), and the
+// import is all-or-nothing.
+func (k Keeper) ImportDelegations(ctx sdk.Context, delegations []types.Delegation) error {
+	cacheCtx, writeCache := ctx.CacheContext()
+
+	denom := k.stakingKeeper.BondDenom(cacheCtx)
+	nextEpoch := k.epochstorageKeeper.GetCurrentNextEpoch(cacheCtx)
+
+	// the delegators' and providers' updates are applied in the order of their first
+	// delegation (and not in a map's random order)
+	imported := map[string]struct{}{}
+	var delegators []string
+	delegatorProviders := map[string][]string{}
+	var providers []string
+	providerChains := map[string][]string{}
+
+	for _, delegation := range delegations {
+		index := types.DelegationKey(delegation.Provider, delegation.Delegator, delegation.ChainID)
+		if err := types.DelegationKeyValidate(index); err != nil {
+			return utils.LavaFormatWarning("cannot import delegation", err)
+		}
+		if _, ok := imported[index]; ok {
+			return utils.LavaFormatWarning("cannot import delegation", fmt.Errorf("duplicate delegation"),
+				utils.LogAttr("delegator", delegation.Delegator),
+				utils.LogAttr("provider", delegation.Provider),
+				utils.LogAttr("chain_id", delegation.ChainID),
+			)
+		}
+		imported[index] = struct{}{}
+
+		if delegation.Amount.Denom != denom || !delegation.Amount.IsPositive() {
+			return utils.LavaFormatWarning("cannot import delegation", types.ErrBadDelegationAmount,
+				utils.LogAttr("delegator", delegation.Delegator),
+				utils.LogAttr("provider", delegation.Provider),
+				utils.LogAttr("amount", delegation.Amount),
+			)
+		}
+		if delegation.Provider != types.EMPTY_PROVIDER {
+			if err := k.verifyProviderStaked(cacheCtx, delegation.Provider, delegation.ChainID); err != nil {
+				return err
+			}
+		}
+
+		var delegationEntry types.Delegation
+		if !k.delegationFS.FindEntry(cacheCtx, index, nextEpoch, &delegationEntry) {
+			delegationEntry = types.NewDelegation(delegation.Delegator, delegation.Provider, delegation.ChainID, cacheCtx.BlockTime(), denom)
+			// keep the imported delegation's first month (if set)
+			if delegation.Timestamp != 0 {
+				delegationEntry.Timestamp = delegation.Timestamp
+			}
+		}
+		delegationEntry.AddAmount(delegation.Amount)
+		if err := k.delegationFS.AppendEntry(cacheCtx, index, nextEpoch, &delegationEntry); err != nil {
+			return utils.LavaFormatError("critical: append delegation entry", err,
+				utils.LogAttr("delegator", delegation.Delegator),
+				utils.LogAttr("provider", delegation.Provider),
+				utils.LogAttr("chain_id", delegation.ChainID),
+			)
+		}
+		k.SetDelegationStartEpoch(cacheCtx, delegation.Delegator, delegation.Provider, delegation.ChainID, nextEpoch)
+		k.addDelegatorChainTotal(cacheCtx, delegation.Delegator, delegation.ChainID, delegation.Amount.Amount)
+
+		if _, ok := delegatorProviders[delegation.Delegator]; !ok {
+			delegators = append(delegators, delegation.Delegator)
+		}
+		delegatorProviders[delegation.Delegator] = append(delegatorProviders[delegation.Delegator], delegation.Provider)
+
+		if delegation.Provider != types.EMPTY_PROVIDER {
+			if _, ok := providerChains[delegation.Provider]; !ok {
+				providers = append(providers, delegation.Provider)
+			}
+			providerChains[delegation.Provider] = append(providerChains[delegation.Provider], delegation.ChainID)
+		}
+	}
+
+	for _, delegator := range delegators {
+		var delegatorEntry types.Delegator
+		index := types.DelegatorKey(delegator)
+		_ = k.delegatorFS.FindEntry(cacheCtx, index, nextEpoch, &delegatorEntry)
+		for _, provider := range delegatorProviders[delegator] {
+			delegatorEntry.AddProvider(provider)
+		}
+		if err := k.delegatorFS.AppendEntry(cacheCtx, index, nextEpoch, &delegatorEntry); err != nil {
+			return utils.LavaFormatError("critical: append delegator entry", err,
+				utils.LogAttr("delegator", delegator),
+			)
+		}
+	}
+
+	for _, provider := range providers {
+		if err := k.rebuildStakeEntryTotals(cacheCtx, provider, providerChains[provider], nextEpoch); err != nil {
+			return err
+		}
+	}
+
+	_, summedDelegations, diff, err := k.ReconcilePool(cacheCtx)
+	if err != nil {
+		return err
+	}
+	if diff.IsNegative() {
+		return utils.LavaFormatWarning("cannot import delegations: the delegations exceed the pool", types.ErrBadDelegationAmount,
+			utils.LogAttr("delegations", summedDelegations),
+			utils.LogAttr("diff", diff),
+		)
+	}
+
+	writeCache()
+	return nil
+}
+
+// The delegations imported at genesis depend on the state of modules that are
+// initialized after the dualstaking module (the specs, the providers' stake entries
+// and the validators' delegations from the genesis transactions). So InitGenesis
+// only keeps them as pending, and they are imported at the first BeginBlock.
+
+// SetPendingImportedDelegations keeps the delegations to import at the next
+// BeginBlock (in the given order)
+func (k Keeper) SetPendingImportedDelegations(ctx sdk.Context, delegations []types.Delegation) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PendingImportedDelegationsPrefix))
+	for i := range delegations {
+		store.Set(sdk.Uint64ToBigEndian(uint64(i)), k.cdc.MustMarshal(&delegations[i]))
+	}
+}
+
+// GetPendingImportedDelegations returns the delegations waiting to be imported
+func (k Keeper) GetPendingImportedDelegations(ctx sdk.Context) []types.Delegation {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PendingImportedDelegationsPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	delegations := []types.Delegation{}
+	for ; iterator.Valid(); iterator.Next() {
+		var delegation types.Delegation
+		k.cdc.MustUnmarshal(iterator.Value(), &delegation)
+		delegations = append(delegations, delegation)
+	}
+	return delegations
+}
+
+// importPendingDelegations imports the delegations pending since genesis (if any).
+// Like a failure in InitGenesis, a failed import halts the chain.
+func (k Keeper) importPendingDelegations(ctx sdk.Context) {
+	delegations := k.GetPendingImportedDelegations(ctx)
+	if len(delegations) == 0 {
+		return
+	}
+
+	if err := k.ImportDelegations(ctx, delegations); err != nil {
+		panic(utils.LavaFormatError("critical: failed to import the genesis delegations", err,
+			utils.LogAttr("count", len(delegations)),
+		))
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PendingImportedDelegationsPrefix))
+	for i := range delegations {
+		store.Delete(sdk.Uint64ToBigEndian(uint64(i)))
+	}
+}
+
+// rebuildStakeEntryTotals sets the Stake/DelegateTotal of the provider's (current)
+// stake entries on the chains to the sums of its self delegation and third-party
+// delegations (reading the provider's delegations once)
+func (k Keeper) rebuildStakeEntryTotals(ctx sdk.Context, provider string, chainIDs []string, nextEpoch uint64) error {
+	providerAddr, err := sdk.AccAddressFromBech32(provider)
+	if err != nil {
+		return err
+	}
+	delegations, err := k.GetProviderDelegators(ctx, provider, nextEpoch)
+	if err != nil {
+		return err
+	}
+
+	denom := k.stakingKeeper.BondDenom(ctx)
+	stakes := map[string]sdk.Coin{}
+	delegateTotals := map[string]sdk.Coin{}
+	for _, chainID := range chainIDs {
+		stakes[chainID] = sdk.NewCoin(denom, sdk.ZeroInt())
+		delegateTotals[chainID] = sdk.NewCoin(denom, sdk.ZeroInt())
+	}
+	for _, d := range delegations {
+		if _, ok := stakes[d.ChainID]; !ok {
+			continue
+		}
+		if d.Delegator == provider {
+			stakes[d.ChainID] = stakes[d.ChainID].Add(d.Amount)
+		} else {
+			delegateTotals[d.ChainID] = delegateTotals[d.ChainID].Add(d.Amount)
+		}
+	}
+
+	for _, chainID := range chainIDs {
+		stakeEntry, exists, index := k.epochstorageKeeper.GetStakeEntryByAddressCurrent(ctx, chainID, providerAddr)
+		if !exists {
+			return utils.LavaFormatWarning("cannot rebuild stake entry totals", epochstoragetypes.ErrProviderNotStaked,
+				utils.LogAttr("provider", provider),
+				utils.LogAttr("chain_id", chainID),
+			)
+		}
+		minStake, err := k.getMinStake(ctx, chainID)
+		if err != nil {
+			return err
+		}
+
+		stakeEntry.Stake = stakes[chainID]
+		stakeEntry.DelegateTotal = delegateTotals[chainID]
+		if stakeEntry.Stake.IsLT(minStake) {
+			stakeEntry.Freeze()
+		}
+		k.epochstorageKeeper.ModifyStakeEntryCurrent(ctx, chainID, stakeEntry, index)
+	}
+
+	return nil
+}
@@ -0,0 +1,149 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	commontypes "github.com/lavanet/lava/common/types"
+	"github.com/lavanet/lava/testutil/common"
+	"github.com/lavanet/lava/x/dualstaking"
+	"github.com/lavanet/lava/x/dualstaking/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImportDelegations(t *testing.T) {
+	ts := newTester(t)
+
+	// 50 delegators, 2 provider staked, 0 provider unstaked, 0 provider unstaking
+	ts.setupForDelegation(50, 2, 0, 0)
+
+	client1Acct, _ := ts.GetAccount(common.CONSUMER, 0)
+	validator, _ := ts.GetAccount(common.VALIDATOR, 0)
+	keeper := ts.Keepers.Dualstaking
+
+	// 100 delegations: each delegator delegates to both providers
+	var delegations []types.Delegation
+	expected := map[string]math.Int{}
+	total := math.ZeroInt()
+	for i := 0; i < 50; i++ {
+		_, delegator := ts.GetAccount(common.CONSUMER, i)
+		for j := 0; j < 2; j++ {
+			_, provider := ts.GetAccount(common.PROVIDER, j)
+			amount := sdk.NewCoin(commontypes.TokenDenom, sdk.NewInt(int64(100*(i+1)+j)))
+			delegations = append(delegations, types.Delegation{
+				Delegator: delegator,
+				Provider:  provider,
+				ChainID:   ts.spec.Index,
+				Amount:    amount,
+			})
+			if _, ok := expected[provider]; !ok {
+				expected[provider] = math.ZeroInt()
+			}
+			expected[provider] = expected[provider].Add(amount.Amount)
+			total = total.Add(amount.Amount)
+		}
+	}
+	require.Len(t, delegations, 100)
+
+	// the funds are not in the pool yet
+	err := keeper.ImportDelegations(ts.Ctx, delegations)
+	require.ErrorIs(t, err, types.ErrBadDelegationAmount)
+	_, found := keeper.GetDelegation(ts.Ctx, delegations[0].Delegator, delegations[0].Provider, ts.spec.Index, ts.GetNextEpoch())
+	require.False(t, found)
+
+	// put the funds in the pool (bypassing the dualstaking hooks)
+	keeper.SetDisableDualstakingHook(ts.Ctx, true)
+	_, err = ts.TxDelegateValidator(client1Acct, validator, total)
+	require.NoError(t, err)
+	keeper.SetDisableDualstakingHook(ts.Ctx, false)
+
+	// duplicates are rejected
+	err = keeper.ImportDelegations(ts.Ctx, append(delegations, delegations[0]))
+	require.Error(t, err)
+
+	// the providers' self delegations are kept
+	stakes := map[string]sdk.Coin{}
+	for j := 0; j < 2; j++ {
+		providerAcct, provider := ts.GetAccount(common.PROVIDER, j)
+		stakes[provider] = ts.getStakeEntry(providerAcct.Addr, ts.spec.Index).Stake
+	}
+
+	err = keeper.ImportDelegations(ts.Ctx, delegations)
+	require.NoError(t, err)
+	require.NoError(t, keeper.AssertPoolInvariants(ts.Ctx))
+
+	for j := 0; j < 2; j++ {
+		providerAcct, provider := ts.GetAccount(common.PROVIDER, j)
+		stakeEntry, found, _ := ts.Keepers.Epochstorage.GetStakeEntryByAddressCurrent(ts.Ctx, ts.spec.Index, providerAcct.Addr)
+		require.True(t, found)
+		require.True(t, expected[provider].Equal(stakeEntry.DelegateTotal.Amount))
+		require.True(t, stakes[provider].IsEqual(stakeEntry.Stake))
+	}
+
+	ts.AdvanceEpoch()
+	for _, delegation := range delegations {
+		imported, found := keeper.GetDelegation(ts.Ctx, delegation.Delegator, delegation.Provider, delegation.ChainID, ts.EpochStart())
+		require.True(t, found)
+		require.True(t, delegation.Amount.IsEqual(imported.Amount))
+	}
+	providers, err := keeper.GetDelegatorProviders(ts.Ctx, delegations[0].Delegator, ts.EpochStart())
+	require.NoError(t, err)
+	require.Len(t, providers, 2)
+}
+
+// TestImportDelegationsAtGenesis follows the app's genesis order: the dualstaking
+// genesis (with the imported delegations) is initialized before the providers are
+// staked and before the staking pool holds the funds, and the delegations are
+// imported at the first BeginBlock
+func TestImportDelegationsAtGenesis(t *testing.T) {
+	ts := newTester(t)
+	keeper := ts.Keepers.Dualstaking
+
+	ts.addClients(2)
+	_, client1Addr := ts.GetAccount(common.CONSUMER, 0)
+	_, client2Addr := ts.GetAccount(common.CONSUMER, 1)
+	err := ts.addProviders(1)
+	require.NoError(t, err)
+	_, provider1Addr := ts.GetAccount(common.PROVIDER, 0)
+
+	amount := sdk.NewCoin(commontypes.TokenDenom, sdk.NewInt(10000))
+	delegations := []types.Delegation{
+		{Delegator: client1Addr, Provider: provider1Addr, ChainID: ts.spec.Index, Amount: amount},
+		{Delegator: client2Addr, Provider: provider1Addr, ChainID: ts.spec.Index, Amount: amount},
+	}
+
+	// the provider is not staked yet, so importing the delegations now would fail
+	genesis := types.DefaultGenesis()
+	genesis.ImportedDelegations = delegations
+	require.NoError(t, genesis.Validate())
+	dualstaking.InitGenesis(ts.Ctx, keeper, *genesis)
+	require.Len(t, keeper.GetPendingImportedDelegations(ts.Ctx), 2)
+
+	// the pending delegations are exported as they were given
+	require.Equal(t, delegations, dualstaking.ExportGenesis(ts.Ctx, keeper).ImportedDelegations)
+
+	// the modules initialized later: the validator and the provider's stake, and
+	// the delegated funds in the pool (not mirrored by the hooks)
+	ts.addValidators(1)
+	validatorAcct, _ := ts.GetAccount(common.VALIDATOR, 0)
+	ts.TxCreateValidator(validatorAcct, math.NewIntFromUint64(uint64(testStake)))
+	err = ts.StakeProvider(provider1Addr, ts.spec, testStake)
+	require.NoError(t, err)
+
+	client1Acct, _ := ts.GetAccount(common.CONSUMER, 0)
+	keeper.SetDisableDualstakingHook(ts.Ctx, true)
+	_, err = ts.TxDelegateValidator(client1Acct, validatorAcct, amount.Amount.MulRaw(2))
+	require.NoError(t, err)
+	keeper.SetDisableDualstakingHook(ts.Ctx, false)
+
+	// the first BeginBlock imports them
+	ts.AdvanceBlock()
+	require.Empty(t, keeper.GetPendingImportedDelegations(ts.Ctx))
+	require.NoError(t, keeper.AssertPoolInvariants(ts.Ctx))
+	for _, delegation := range delegations {
+		imported, found := keeper.GetDelegation(ts.Ctx, delegation.Delegator, delegation.Provider, delegation.ChainID, ts.GetNextEpoch())
+		require.True(t, found)
+		require.True(t, delegation.Amount.IsEqual(imported.Amount))
+	}
+}
@@ -0,0 +1,48 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/utils"
+	"github.com/lavanet/lava/x/dualstaking/types"
+)
+
+// A provider is inactive when it is jailed (its stake entry is frozen) or when its
+// spec is disabled. Delegating to an inactive provider locks the funds behind a
+// provider that can't earn rewards, so depending on the RejectInactiveProviders param
+// such delegations are either accepted with a warning (default) or rejected. The
+// provider's own (self) delegations are never affected, so it can always add stake
+// to get unfrozen.
+
+// verifyProviderActive checks whether the provider is active on the chain. For an
+// inactive provider it returns ErrProviderInactive if the RejectInactiveProviders
+// param is set, and only logs a warning otherwise.
+func (k Keeper) verifyProviderActive(ctx sdk.Context, delegator, provider, chainID string) error {
+	if provider == types.EMPTY_PROVIDER || delegator == provider {
+		return nil
+	}
+
+	reason := ""
+	if active, _, _ := k.specKeeper.IsSpecFoundAndActive(ctx, chainID); !active {
+		reason = "spec is not active"
+	} else if providerAddr, err := sdk.AccAddressFromBech32(provider); err == nil {
+		stakeEntry, found, _ := k.epochstorageKeeper.GetStakeEntryByAddressCurrent(ctx, chainID, providerAddr)
+		if found && stakeEntry.IsFrozen() {
+			reason = "provider is jailed"
+		}
+	}
+	if reason == "" {
+		return nil
+	}
+
+	attrs := []utils.Attribute{
+		{Key: "delegator", Value: delegator},
+		{Key: "provider", Value: provider},
+		{Key: "chainID", Value: chainID},
+		{Key: "reason", Value: reason},
+	}
+	if k.RejectInactiveProviders(ctx) {
+		return utils.LavaFormatWarning("delegation to inactive provider rejected", types.ErrProviderInactive, attrs...)
+	}
+	utils.LavaFormatWarning("delegation to inactive provider", types.ErrProviderInactive, attrs...)
+	return nil
+}
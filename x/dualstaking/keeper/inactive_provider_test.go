@@ -0,0 +1,65 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	commontypes "github.com/lavanet/lava/common/types"
+	"github.com/lavanet/lava/testutil/common"
+	"github.com/lavanet/lava/x/dualstaking/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDelegateToJailedProvider(t *testing.T) {
+	playbook := []struct {
+		name   string
+		reject bool
+		valid  bool
+	}{
+		{name: "warn", reject: false, valid: true},
+		{name: "reject", reject: true, valid: false},
+	}
+
+	for _, play := range playbook {
+		t.Run(play.name, func(t *testing.T) {
+			ts := newTester(t)
+
+			// 1 delegator, 1 provider staked, 0 provider unstaked, 0 provider unstaking
+			ts.setupForDelegation(1, 1, 0, 0)
+
+			clientAcct, client1Addr := ts.GetAccount(common.CONSUMER, 0)
+			_, provider1Addr := ts.GetAccount(common.PROVIDER, 0)
+
+			params := ts.Keepers.Dualstaking.GetParams(ts.Ctx)
+			params.RejectInactiveProviders = play.reject
+			ts.Keepers.Dualstaking.SetParams(ts.Ctx, params)
+			require.Equal(t, play.reject, ts.Keepers.Dualstaking.RejectInactiveProviders(ts.Ctx))
+
+			// jail the provider
+			_, err := ts.TxPairingFreezeProvider(provider1Addr, ts.spec.Index)
+			require.NoError(t, err)
+
+			balance := ts.GetBalance(clientAcct.Addr)
+
+			amount := sdk.NewCoin(commontypes.TokenDenom, sdk.NewInt(10000))
+			_, err = ts.TxDualstakingDelegate(client1Addr, provider1Addr, ts.spec.Index, amount)
+			if play.valid {
+				require.NoError(t, err)
+				ts.AdvanceEpoch()
+				_, found := ts.Keepers.Dualstaking.GetDelegation(ts.Ctx, client1Addr, provider1Addr, ts.spec.Index, ts.EpochStart())
+				require.True(t, found)
+				require.Equal(t, balance-amount.Amount.Int64(), ts.GetBalance(clientAcct.Addr))
+			} else {
+				require.ErrorIs(t, err, types.ErrProviderInactive)
+				ts.AdvanceEpoch()
+				_, found := ts.Keepers.Dualstaking.GetDelegation(ts.Ctx, client1Addr, provider1Addr, ts.spec.Index, ts.EpochStart())
+				require.False(t, found)
+				require.Equal(t, balance, ts.GetBalance(clientAcct.Addr))
+			}
+
+			// the provider itself can always add stake
+			_, err = ts.TxDualstakingDelegate(provider1Addr, provider1Addr, ts.spec.Index, amount)
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/utils"
+	"github.com/lavanet/lava/x/dualstaking/types"
+)
+
+const poolAccountingInvariantName = "pool-accounting"
+
+// RegisterInvariants registers the dualstaking module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, poolAccountingInvariantName, PoolAccountingInvariant(k))
+}
+
+// PoolAccountingInvariant checks that the provider delegations match the tokens
+// held by the staking module for them (see AssertPoolInvariants). While the
+// dualstaking hooks are disabled (e.g. during upgrades or bulk imports) the
+// validators' delegations are not mirrored to the providers, so it is skipped.
+func PoolAccountingInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		if k.GetDisableDualstakingHook(ctx) {
+			return sdk.FormatInvariant(types.ModuleName, poolAccountingInvariantName, "skipped: dualstaking hooks are disabled"), false
+		}
+
+		msg := "delegations match the validators' tokens"
+		err := k.AssertPoolInvariants(ctx)
+		if err != nil {
+			msg = err.Error()
+		}
+		return sdk.FormatInvariant(types.ModuleName, poolAccountingInvariantName, msg), err != nil
+	}
+}
+
+// AssertPoolInvariants checks that the sum of the provider delegations equals the
+// validators' tokens (see ReconcilePool). Since the staking module converts each
+// delegation's shares to tokens with truncation, a difference of up to one token
+// per validator delegation is tolerated.
+func (k Keeper) AssertPoolInvariants(ctx sdk.Context) error {
+	_, summedDelegations, diff, err := k.ReconcilePool(ctx)
+	if err != nil {
+		return err
+	}
+
+	tolerance := int64(0)
+	for _, v := range k.stakingKeeper.GetAllValidators(ctx) {
+		tolerance += int64(len(k.stakingKeeper.GetValidatorDelegations(ctx, v.GetOperator())))
+	}
+
+	if diff.Abs().GT(math.NewInt(tolerance)) {
+		return utils.LavaFormatError("delegations do not match the validators' tokens", fmt.Errorf("pool invariant broken"),
+			utils.LogAttr("delegations", summedDelegations),
+			utils.LogAttr("diff", diff),
+			utils.LogAttr("tolerance", tolerance),
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,39 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/testutil/common"
+	"github.com/lavanet/lava/x/dualstaking/keeper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPoolAccountingInvariantHookDisabled(t *testing.T) {
+	ts := newTester(t)
+
+	// 1 delegator, 1 provider staked, 0 provider unstaked, 0 provider unstaking
+	ts.setupForDelegation(1, 1, 0, 0)
+
+	client1Acct, _ := ts.GetAccount(common.CONSUMER, 0)
+	validator, _ := ts.GetAccount(common.VALIDATOR, 0)
+
+	invariant := keeper.PoolAccountingInvariant(ts.Keepers.Dualstaking)
+	_, broken := invariant(ts.Ctx)
+	require.False(t, broken)
+
+	// a validator delegation with the hooks disabled is not mirrored to the empty
+	// provider, so the pool does not match the delegations
+	ts.Keepers.Dualstaking.SetDisableDualstakingHook(ts.Ctx, true)
+	_, err := ts.TxDelegateValidator(client1Acct, validator, sdk.NewInt(10000))
+	require.NoError(t, err)
+	require.Error(t, ts.Keepers.Dualstaking.AssertPoolInvariants(ts.Ctx))
+
+	// but the invariant is skipped while the hooks are disabled
+	_, broken = invariant(ts.Ctx)
+	require.False(t, broken)
+
+	ts.Keepers.Dualstaking.SetDisableDualstakingHook(ts.Ctx, false)
+	_, broken = invariant(ts.Ctx)
+	require.True(t, broken)
+}
@@ -27,6 +27,9 @@ type (
 		epochstorageKeeper types.EpochstorageKeeper
 		specKeeper         types.SpecKeeper
 
+		// the address capable of executing governance-only messages (the gov module account)
+		authority string
+
 		delegationFS fixationtypes.FixationStore // map proviers/chainID -> delegations
 		delegatorFS  fixationtypes.FixationStore // map delegators -> providers
 	}
@@ -43,6 +46,7 @@ func NewKeeper(
 	epochstorageKeeper types.EpochstorageKeeper,
 	specKeeper types.SpecKeeper,
 	fixationStoreKeeper types.FixationStoreKeeper,
+	authority string,
 ) *Keeper {
 	// set KeyTable if it has not already been set
 	if !ps.HasKeyTable() {
@@ -60,6 +64,8 @@ func NewKeeper(
 		accountKeeper:      accountKeeper,
 		epochstorageKeeper: epochstorageKeeper,
 		specKeeper:         specKeeper,
+
+		authority: authority,
 	}
 
 	delegationFS := *fixationStoreKeeper.NewFixationStore(storeKey, types.DelegationPrefix)
@@ -71,6 +77,11 @@ func NewKeeper(
 	return keeper
 }
 
+// GetAuthority returns the address capable of executing governance-only messages
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
 // ExportDelegations exports dualstaking delegations data (for genesis)
 func (k Keeper) ExportDelegations(ctx sdk.Context) fixationtypes.GenesisState {
 	return k.delegationFS.Export(ctx)
@@ -105,3 +116,13 @@ func (k Keeper) ChangeDelegationTimestampForTesting(ctx sdk.Context, index strin
 	k.delegationFS.ModifyEntry(ctx, index, entryBlock, &d)
 	return nil
 }
+
+func (k Keeper) AppendDelegationForTesting(ctx sdk.Context, delegation types.Delegation, block uint64) error {
+	index := types.DelegationKey(delegation.Provider, delegation.Delegator, delegation.ChainID)
+	return k.delegationFS.AppendEntry(ctx, index, block, &delegation)
+}
+
+func (k Keeper) AppendLegacyDelegationForTesting(ctx sdk.Context, delegation types.Delegation, block uint64) error {
+	index := delegation.Provider + " " + delegation.Delegator
+	return k.delegationFS.AppendEntry(ctx, index, block, &delegation)
+}
@@ -0,0 +1,103 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/x/dualstaking/types"
+)
+
+// To discourage delegations that hop between providers every epoch, a delegator's
+// share of the delegators' rewards may be weighted by a loyalty multiplier that
+// grows linearly with the delegation's continuous duration: from 1 for a fresh
+// delegation, up to the max multiplier after the ramp epochs. The duration is
+// counted from the delegation's start epoch, which is reset whenever the delegation
+// is increased (delegate or redelegate-in). The max multiplier and the ramp epochs
+// are module params; with the default max multiplier of 1, the loyalty multiplier
+// has no effect.
+
+// DelegationLoyaltyMultiplier returns the delegation's loyalty multiplier, according
+// to the number of epochs since its start epoch
+func (k Keeper) DelegationLoyaltyMultiplier(ctx sdk.Context, delegator, provider, chainID string) math.LegacyDec {
+	maxMultiplier, rampEpochs := k.LoyaltyMaxMultiplier(ctx), k.LoyaltyRampEpochs(ctx)
+	if rampEpochs == 0 || maxMultiplier.LTE(math.LegacyOneDec()) {
+		return math.LegacyOneDec()
+	}
+
+	startEpoch, found := k.getDelegationStartEpoch(ctx, delegator, provider, chainID)
+	epochBlocks := k.epochstorageKeeper.EpochBlocksRaw(ctx)
+	currentEpoch := k.epochstorageKeeper.GetEpochStart(ctx)
+	if !found || epochBlocks == 0 || currentEpoch <= startEpoch {
+		return math.LegacyOneDec()
+	}
+
+	elapsedEpochs := (currentEpoch - startEpoch) / epochBlocks
+	if elapsedEpochs > rampEpochs {
+		elapsedEpochs = rampEpochs
+	}
+
+	bonus := maxMultiplier.Sub(math.LegacyOneDec()).MulInt64(int64(elapsedEpochs)).QuoInt64(int64(rampEpochs))
+	return math.LegacyOneDec().Add(bonus)
+}
+
+// weightDelegationsByLoyalty returns copies of the delegations with their amounts
+// multiplied by their loyalty multipliers, and the total delegations adjusted by
+// the added weight (so the rewards of non-rewarded delegations are kept aside)
+func (k Keeper) weightDelegationsByLoyalty(ctx sdk.Context, delegations []types.Delegation, totalDelegations math.Int) ([]types.Delegation, math.Int) {
+	if k.LoyaltyMaxMultiplier(ctx).LTE(math.LegacyOneDec()) {
+		return delegations, totalDelegations
+	}
+
+	weighted := make([]types.Delegation, len(delegations))
+	for i, d := range delegations {
+		multiplier := k.DelegationLoyaltyMultiplier(ctx, d.Delegator, d.Provider, d.ChainID)
+		weight := multiplier.MulInt(d.Amount.Amount).TruncateInt()
+		totalDelegations = totalDelegations.Add(weight.Sub(d.Amount.Amount))
+		d.Amount.Amount = weight
+		weighted[i] = d
+	}
+	return weighted, totalDelegations
+}
+
+// SetDelegationStartEpoch sets the epoch from which the delegation's duration is counted
+func (k Keeper) SetDelegationStartEpoch(ctx sdk.Context, delegator, provider, chainID string, epoch uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DelegationStartEpochPrefix))
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, epoch)
+	store.Set([]byte(types.DelegationKey(provider, delegator, chainID)), b)
+}
+
+func (k Keeper) getDelegationStartEpoch(ctx sdk.Context, delegator, provider, chainID string) (uint64, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DelegationStartEpochPrefix))
+	b := store.Get([]byte(types.DelegationKey(provider, delegator, chainID)))
+	if b == nil {
+		return 0, false
+	}
+	return binary.BigEndian.Uint64(b), true
+}
+
+func (k Keeper) removeDelegationStartEpoch(ctx sdk.Context, delegator, provider, chainID string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DelegationStartEpochPrefix))
+	store.Delete([]byte(types.DelegationKey(provider, delegator, chainID)))
+}
+
+// GetAllDelegationStartEpochs returns all the delegations' start epochs (for genesis)
+func (k Keeper) GetAllDelegationStartEpochs(ctx sdk.Context) []types.DelegationStartEpoch {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DelegationStartEpochPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	startEpochs := []types.DelegationStartEpoch{}
+	for ; iterator.Valid(); iterator.Next() {
+		provider, delegator, chainID := types.DelegationKeyDecode(string(iterator.Key()))
+		startEpochs = append(startEpochs, types.DelegationStartEpoch{
+			Delegator: delegator,
+			Provider:  provider,
+			ChainId:   chainID,
+			Epoch:     binary.BigEndian.Uint64(iterator.Value()),
+		})
+	}
+	return startEpochs
+}
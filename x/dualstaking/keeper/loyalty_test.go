@@ -0,0 +1,58 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	commontypes "github.com/lavanet/lava/common/types"
+	"github.com/lavanet/lava/testutil/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDelegationLoyaltyMultiplier(t *testing.T) {
+	ts := newTester(t)
+
+	// 1 delegator, 1 provider staked, 0 provider unstaked, 0 provider unstaking
+	ts.setupForDelegation(1, 1, 0, 0)
+
+	_, client1Addr := ts.GetAccount(common.CONSUMER, 0)
+	_, provider1Addr := ts.GetAccount(common.PROVIDER, 0)
+
+	multiplier := func() math.LegacyDec {
+		return ts.Keepers.Dualstaking.DelegationLoyaltyMultiplier(ts.Ctx, client1Addr, provider1Addr, ts.spec.Index)
+	}
+
+	amount := sdk.NewCoin(commontypes.TokenDenom, sdk.NewInt(10000))
+	_, err := ts.TxDualstakingDelegate(client1Addr, provider1Addr, ts.spec.Index, amount)
+	require.NoError(t, err)
+	ts.AdvanceEpochs(5)
+
+	// disabled by default
+	require.True(t, math.LegacyOneDec().Equal(multiplier()))
+
+	params := ts.Keepers.Dualstaking.GetParams(ts.Ctx)
+	params.LoyaltyMaxMultiplier = math.LegacyMustNewDecFromStr("0.5")
+	require.Error(t, params.Validate())
+	params.LoyaltyMaxMultiplier = math.LegacyMustNewDecFromStr("1.5")
+	params.LoyaltyRampEpochs = 0
+	require.Error(t, params.Validate())
+	params.LoyaltyRampEpochs = 10
+	require.NoError(t, params.Validate())
+	ts.Keepers.Dualstaking.SetParams(ts.Ctx, params)
+
+	// 4 epochs since the delegation took effect
+	require.True(t, math.LegacyMustNewDecFromStr("1.2").Equal(multiplier()))
+
+	// long-standing delegations are capped at the max multiplier
+	ts.AdvanceEpochs(20)
+	require.True(t, math.LegacyMustNewDecFromStr("1.5").Equal(multiplier()))
+
+	// increasing the delegation restarts the count
+	_, err = ts.TxDualstakingDelegate(client1Addr, provider1Addr, ts.spec.Index, amount)
+	require.NoError(t, err)
+	ts.AdvanceEpoch()
+	require.True(t, math.LegacyOneDec().Equal(multiplier()))
+	ts.AdvanceEpochs(2)
+	require.True(t, math.LegacyMustNewDecFromStr("1.1").Equal(multiplier()))
+}
@@ -3,6 +3,7 @@ package keeper
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	_ "embed"
 
@@ -375,3 +376,94 @@ func (m Migrator) MigrateVersion3To4(ctx sdk.Context) error {
 	m.keeper.SetDisableDualstakingHook(ctx, false)
 	return nil
 }
+
+// MigrateVersion4To5 rewrites legacy delegation entries, whose index lacks the
+// chainID ("provider delegator"), into the DelegationKey(provider, delegator, chainID)
+// format. A missing chainID defaults to the chain the provider is staked on.
+func (m Migrator) MigrateVersion4To5(ctx sdk.Context) error {
+	nextEpoch := m.keeper.epochstorageKeeper.GetCurrentNextEpoch(ctx)
+
+	migrated := 0
+	for _, ind := range m.keeper.delegationFS.GetAllEntryIndices(ctx) {
+		split := strings.Split(ind, " ")
+		if len(split) != 2 {
+			continue
+		}
+		provider, delegator := split[0], split[1]
+
+		var delegation dualstakingtypes.Delegation
+		if !m.keeper.delegationFS.FindEntry(ctx, ind, nextEpoch, &delegation) {
+			continue
+		}
+
+		chainID := delegation.ChainID
+		if chainID == dualstakingtypes.EMPTY_PROVIDER_CHAINID {
+			chainID = m.legacyDelegationChainID(ctx, provider)
+		}
+		delegation.Provider = provider
+		delegation.Delegator = delegator
+		delegation.ChainID = chainID
+
+		err := m.keeper.delegationFS.DelEntry(ctx, ind, nextEpoch)
+		if err != nil {
+			return utils.LavaFormatError("failed to delete legacy delegation entry", err,
+				utils.Attribute{Key: "index", Value: ind},
+			)
+		}
+
+		// merge with an existing delegation under the new key, if any
+		index := dualstakingtypes.DelegationKey(provider, delegator, chainID)
+		var existing dualstakingtypes.Delegation
+		if m.keeper.delegationFS.FindEntry(ctx, index, nextEpoch, &existing) {
+			existing.AddAmount(delegation.Amount)
+			delegation = existing
+		}
+
+		err = m.keeper.delegationFS.AppendEntry(ctx, index, nextEpoch, &delegation)
+		if err != nil {
+			return utils.LavaFormatError("failed to migrate legacy delegation entry", err,
+				utils.Attribute{Key: "index", Value: ind},
+				utils.Attribute{Key: "chainID", Value: chainID},
+			)
+		}
+		migrated++
+	}
+
+	utils.LavaFormatInfo("Migrator for legacy delegation keys", utils.LogAttr("migrated", migrated))
+	return nil
+}
+
+// MigrateVersion5To6 computes the delegators' per chain totals
+func (m Migrator) MigrateVersion5To6(ctx sdk.Context) error {
+	m.keeper.RebuildDelegatorChainTotals(ctx)
+	return nil
+}
+
+// MigrateVersion6To7 sets the module's newly added params to their defaults. The
+// params that are already set keep their values.
+func (m Migrator) MigrateVersion6To7(ctx sdk.Context) error {
+	defaultParams := dualstakingtypes.DefaultParams()
+	for _, pair := range defaultParams.ParamSetPairs() {
+		if !m.keeper.paramstore.Has(ctx, pair.Key) {
+			m.keeper.paramstore.Set(ctx, pair.Key, pair.Value)
+		}
+	}
+	return nil
+}
+
+// legacyDelegationChainID returns the first chain the provider is staked on, or
+// the empty chain if it isn't staked anywhere (like the empty provider)
+func (m Migrator) legacyDelegationChainID(ctx sdk.Context, provider string) string {
+	providerAddr, err := sdk.AccAddressFromBech32(provider)
+	if err != nil {
+		return dualstakingtypes.EMPTY_PROVIDER_CHAINID
+	}
+
+	for _, chainID := range m.keeper.specKeeper.GetAllChainIDs(ctx) {
+		if _, found, _ := m.keeper.epochstorageKeeper.GetStakeEntryByAddressCurrent(ctx, chainID, providerAddr); found {
+			return chainID
+		}
+	}
+
+	return dualstakingtypes.EMPTY_PROVIDER_CHAINID
+}
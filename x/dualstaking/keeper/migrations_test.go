@@ -0,0 +1,79 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	commontypes "github.com/lavanet/lava/common/types"
+	"github.com/lavanet/lava/testutil/common"
+	"github.com/lavanet/lava/x/dualstaking/keeper"
+	"github.com/lavanet/lava/x/dualstaking/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrateLegacyDelegationKeys(t *testing.T) {
+	ts := newTester(t)
+
+	// 2 delegators, 1 provider staked, 0 provider unstaked, 0 provider unstaking
+	ts.setupForDelegation(2, 1, 0, 0)
+
+	_, client1Addr := ts.GetAccount(common.CONSUMER, 0)
+	_, client2Addr := ts.GetAccount(common.CONSUMER, 1)
+	_, provider1Addr := ts.GetAccount(common.PROVIDER, 0)
+
+	amount := sdk.NewCoin(commontypes.TokenDenom, sdk.NewInt(10000))
+
+	// client2 already has a delegation under the current key format
+	_, err := ts.TxDualstakingDelegate(client2Addr, provider1Addr, ts.spec.Index, amount)
+	require.NoError(t, err)
+	ts.AdvanceEpoch()
+
+	// legacy entries (index without chainID) for both delegators
+	for _, delegator := range []string{client1Addr, client2Addr} {
+		legacy := types.NewDelegation(delegator, provider1Addr, types.EMPTY_PROVIDER_CHAINID, ts.Ctx.BlockTime(), commontypes.TokenDenom)
+		legacy.AddAmount(amount)
+		err = ts.Keepers.Dualstaking.AppendLegacyDelegationForTesting(ts.Ctx, legacy, ts.GetNextEpoch())
+		require.NoError(t, err)
+	}
+	ts.AdvanceEpoch()
+
+	err = keeper.NewMigrator(ts.Keepers.Dualstaking).MigrateVersion4To5(ts.Ctx)
+	require.NoError(t, err)
+	ts.AdvanceEpoch()
+
+	// the legacy delegation is queryable under the provider's staked chain
+	delegation, found := ts.Keepers.Dualstaking.GetDelegation(ts.Ctx, client1Addr, provider1Addr, ts.spec.Index, ts.EpochStart())
+	require.True(t, found)
+	require.Equal(t, amount, delegation.Amount)
+	require.Equal(t, ts.spec.Index, delegation.ChainID)
+
+	// and merged into an existing delegation under the same key
+	delegation, found = ts.Keepers.Dualstaking.GetDelegation(ts.Ctx, client2Addr, provider1Addr, ts.spec.Index, ts.EpochStart())
+	require.True(t, found)
+	require.Equal(t, amount.Add(amount), delegation.Amount)
+
+	// no legacy indices remain, so migrating again is a no-op
+	err = keeper.NewMigrator(ts.Keepers.Dualstaking).MigrateVersion4To5(ts.Ctx)
+	require.NoError(t, err)
+	delegation, found = ts.Keepers.Dualstaking.GetDelegation(ts.Ctx, client2Addr, provider1Addr, ts.spec.Index, ts.GetNextEpoch())
+	require.True(t, found)
+	require.Equal(t, amount.Add(amount), delegation.Amount)
+}
+
+func TestMigrateVersion6To7KeepsParams(t *testing.T) {
+	ts := newTester(t)
+
+	params := types.DefaultParams()
+	params.MinDelegation = sdk.NewInt(5000)
+	params.LoyaltyRampEpochs = 20
+	ts.Keepers.Dualstaking.SetParams(ts.Ctx, params)
+
+	err := keeper.NewMigrator(ts.Keepers.Dualstaking).MigrateVersion6To7(ts.Ctx)
+	require.NoError(t, err)
+
+	// the already set params survive the migration
+	got := ts.Keepers.Dualstaking.GetParams(ts.Ctx)
+	require.True(t, sdk.NewInt(5000).Equal(got.MinDelegation))
+	require.Equal(t, uint64(20), got.LoyaltyRampEpochs)
+	require.True(t, types.DefaultLoyaltyMaxMultiplier.Equal(got.LoyaltyMaxMultiplier))
+}
@@ -0,0 +1,60 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/utils"
+	"github.com/lavanet/lava/x/dualstaking/types"
+)
+
+// The minimum delegation prevents dust delegations: a (re)delegation may not result
+// in a delegation below the minimum on the provider it moves to, nor leave a
+// remainder below the minimum on the provider it moves from (it must either move
+// the whole delegation or leave at least the minimum). The empty provider and the
+// providers' self delegations (bound by the spec's min stake) are exempt. Since
+// delegating goes through a redelegation from the empty provider, the minimum
+// applies to delegations too. The minimum is set by the MinDelegation param, and
+// a zero minimum (default) disables the check.
+
+// verifyMinDelegation checks that redelegating the amount leaves neither side with
+// a delegation below the minimum delegation
+func (k Keeper) verifyMinDelegation(ctx sdk.Context, delegator, from, to, fromChainID, toChainID string, amount sdk.Coin, nextEpoch uint64) error {
+	minDelegation := k.MinDelegation(ctx)
+	if minDelegation.IsZero() {
+		return nil
+	}
+
+	if to != types.EMPTY_PROVIDER && to != delegator {
+		toAmount := amount.Amount
+		if delegation, found := k.GetDelegation(ctx, delegator, to, toChainID, nextEpoch); found {
+			toAmount = toAmount.Add(delegation.Amount.Amount)
+		}
+		if toAmount.LT(minDelegation) {
+			return utils.LavaFormatWarning("redelegation results in a delegation below the minimum", types.ErrDelegationBelowMinimum,
+				utils.LogAttr("delegator", delegator),
+				utils.LogAttr("provider", to),
+				utils.LogAttr("chain_id", toChainID),
+				utils.LogAttr("delegation", toAmount),
+				utils.LogAttr("min_delegation", minDelegation),
+			)
+		}
+	}
+
+	if from != types.EMPTY_PROVIDER && from != delegator {
+		// insufficient delegations are rejected when decreasing the delegation
+		delegation, found := k.GetDelegation(ctx, delegator, from, fromChainID, nextEpoch)
+		if found && delegation.Amount.Amount.GT(amount.Amount) {
+			remainder := delegation.Amount.Amount.Sub(amount.Amount)
+			if remainder.LT(minDelegation) {
+				return utils.LavaFormatWarning("redelegation leaves a remainder below the minimum", types.ErrDelegationBelowMinimum,
+					utils.LogAttr("delegator", delegator),
+					utils.LogAttr("provider", from),
+					utils.LogAttr("chain_id", fromChainID),
+					utils.LogAttr("remainder", remainder),
+					utils.LogAttr("min_delegation", minDelegation),
+				)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,88 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	commontypes "github.com/lavanet/lava/common/types"
+	"github.com/lavanet/lava/testutil/common"
+	"github.com/lavanet/lava/x/dualstaking/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRedelegateMinDelegation(t *testing.T) {
+	ts := newTester(t)
+
+	// 1 delegator, 2 provider staked, 0 provider unstaked, 0 provider unstaking
+	ts.setupForDelegation(1, 2, 0, 0)
+
+	_, client1Addr := ts.GetAccount(common.CONSUMER, 0)
+	_, provider1Addr := ts.GetAccount(common.PROVIDER, 0)
+	_, provider2Addr := ts.GetAccount(common.PROVIDER, 1)
+
+	coin := func(amount int64) sdk.Coin {
+		return sdk.NewCoin(commontypes.TokenDenom, sdk.NewInt(amount))
+	}
+
+	_, err := ts.TxDualstakingDelegate(client1Addr, provider1Addr, ts.spec.Index, coin(10000))
+	require.NoError(t, err)
+	ts.AdvanceEpoch()
+
+	ts.setMinDelegation(sdk.NewInt(5000))
+	require.Equal(t, sdk.NewInt(5000), ts.Keepers.Dualstaking.MinDelegation(ts.Ctx))
+
+	playbook := []struct {
+		name   string
+		amount int64
+		valid  bool
+	}{
+		{name: "dust remainder on the from side", amount: 6000, valid: false},
+		{name: "below minimum on the to side", amount: 4000, valid: false},
+		{name: "minimum on both sides", amount: 5000, valid: true},
+		{name: "full move of the rest", amount: 5000, valid: true},
+	}
+
+	for _, play := range playbook {
+		_, err = ts.TxDualstakingRedelegate(client1Addr, provider1Addr, provider2Addr, ts.spec.Index, ts.spec.Index, coin(play.amount))
+		if play.valid {
+			require.NoError(t, err, play.name)
+			ts.AdvanceEpoch()
+		} else {
+			require.ErrorIs(t, err, types.ErrDelegationBelowMinimum, play.name)
+		}
+	}
+
+	_, found := ts.Keepers.Dualstaking.GetDelegation(ts.Ctx, client1Addr, provider1Addr, ts.spec.Index, ts.GetNextEpoch())
+	require.False(t, found)
+	delegation, found := ts.Keepers.Dualstaking.GetDelegation(ts.Ctx, client1Addr, provider2Addr, ts.spec.Index, ts.GetNextEpoch())
+	require.True(t, found)
+	require.Equal(t, coin(10000), delegation.Amount)
+}
+
+func TestMinDelegationReported(t *testing.T) {
+	ts := newTester(t)
+
+	// 1 delegator, 1 provider staked, 0 provider unstaked, 0 provider unstaking
+	ts.setupForDelegation(1, 1, 0, 0)
+
+	_, client1Addr := ts.GetAccount(common.CONSUMER, 0)
+	_, provider1Addr := ts.GetAccount(common.PROVIDER, 0)
+
+	require.True(t, ts.Keepers.Dualstaking.MinDelegation(ts.Ctx).IsZero())
+
+	ts.setMinDelegation(sdk.NewInt(5000))
+	require.Equal(t, sdk.NewInt(5000), ts.Keepers.Dualstaking.MinDelegation(ts.Ctx))
+
+	// the params query reports the minimum to clients
+	res, err := ts.QueryDualstakingParams()
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(5000), res.Params.MinDelegation)
+
+	// the rejection carries the minimum, so clients can correct the amount
+	_, err = ts.TxDualstakingDelegate(client1Addr, provider1Addr, ts.spec.Index, sdk.NewCoin(commontypes.TokenDenom, sdk.NewInt(4000)))
+	require.ErrorIs(t, err, types.ErrDelegationBelowMinimum)
+	require.ErrorContains(t, err, "min_delegation:5000")
+
+	_, err = ts.TxDualstakingDelegate(client1Addr, provider1Addr, ts.spec.Index, sdk.NewCoin(commontypes.TokenDenom, sdk.NewInt(5000)))
+	require.NoError(t, err)
+}
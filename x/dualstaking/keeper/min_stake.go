@@ -0,0 +1,22 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	spectypes "github.com/lavanet/lava/x/spec/types"
+)
+
+// The providers' minimum stake (checked when a provider's self delegation changes)
+// may be set per providers type (ProvidersTypeMinStakes param), so static and
+// dynamic specs can require different minimums. A providers type without a minimum
+// of its own (default) uses each spec's MinStakeProvider.
+
+// GetProvidersTypeMinStake returns the providers' minimum stake for the specs of
+// the providers type, if one is set
+func (k Keeper) GetProvidersTypeMinStake(ctx sdk.Context, providersType spectypes.Spec_ProvidersTypes) (sdk.Coin, bool) {
+	for _, entry := range k.ProvidersTypeMinStakes(ctx) {
+		if entry.ProvidersType == providersType {
+			return entry.MinStake, true
+		}
+	}
+	return sdk.Coin{}, false
+}
@@ -0,0 +1,102 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	commontypes "github.com/lavanet/lava/common/types"
+	"github.com/lavanet/lava/testutil/common"
+	"github.com/lavanet/lava/x/dualstaking/types"
+	spectypes "github.com/lavanet/lava/x/spec/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProvidersTypeMinStake(t *testing.T) {
+	ts := newTester(t)
+
+	// 0 delegator, 1 provider staked, 0 provider unstaked, 0 provider unstaking
+	ts.setupForDelegation(0, 1, 0, 0)
+
+	provider1Acct, provider1Addr := ts.GetAccount(common.PROVIDER, 0)
+	keeper := ts.Keepers.Dualstaking
+
+	coin := func(amount int64) sdk.Coin {
+		return sdk.NewCoin(commontypes.TokenDenom, sdk.NewInt(amount))
+	}
+
+	// stake the provider on a static spec and on a dynamic spec (with the same
+	// spec min stake)
+	specs := map[spectypes.Spec_ProvidersTypes]string{
+		spectypes.Spec_static:  "mockstatic",
+		spectypes.Spec_dynamic: "mockdynamic",
+	}
+	for providersType, index := range specs {
+		spec := common.CreateMockSpec()
+		spec.Index = index
+		spec.Name = index
+		spec.ProvidersTypes = providersType
+		ts.AddSpec(spec.Index, spec)
+		err := ts.StakeProvider(provider1Addr, spec, testStake)
+		require.NoError(t, err)
+	}
+
+	isFrozen := func(chainID string) bool {
+		stakeEntry, found, _ := ts.Keepers.Epochstorage.GetStakeEntryByAddressCurrent(ts.Ctx, chainID, provider1Acct.Addr)
+		require.True(t, found)
+		return stakeEntry.IsFrozen()
+	}
+
+	// by default both use the spec's min stake
+	for providersType := range specs {
+		_, found := keeper.GetProvidersTypeMinStake(ts.Ctx, providersType)
+		require.False(t, found)
+	}
+
+	setMinStakes := func(minStakes ...types.ProvidersTypeMinStake) {
+		params := keeper.GetParams(ts.Ctx)
+		params.ProvidersTypeMinStakes = minStakes
+		keeper.SetParams(ts.Ctx, params)
+	}
+
+	// the static min stake is above the provider's stake, the dynamic one below it
+	setMinStakes(
+		types.ProvidersTypeMinStake{ProvidersType: spectypes.Spec_static, MinStake: coin(2 * testStake)},
+		types.ProvidersTypeMinStake{ProvidersType: spectypes.Spec_dynamic, MinStake: coin(testStake / 2)},
+	)
+	minStake, found := keeper.GetProvidersTypeMinStake(ts.Ctx, spectypes.Spec_static)
+	require.True(t, found)
+	require.True(t, coin(2*testStake).IsEqual(minStake))
+
+	// a small self unbond freezes the static provider only
+	for _, index := range specs {
+		_, err := ts.TxDualstakingUnbond(provider1Addr, provider1Addr, index, coin(1000))
+		require.NoError(t, err)
+	}
+	require.True(t, isFrozen(specs[spectypes.Spec_static]))
+	require.False(t, isFrozen(specs[spectypes.Spec_dynamic]))
+
+	// going below the dynamic min stake freezes the dynamic provider too
+	_, err := ts.TxDualstakingUnbond(provider1Addr, provider1Addr, specs[spectypes.Spec_dynamic], coin(testStake/2))
+	require.NoError(t, err)
+	require.True(t, isFrozen(specs[spectypes.Spec_dynamic]))
+
+	// invalid or duplicate min stakes are rejected
+	params := keeper.GetParams(ts.Ctx)
+	params.ProvidersTypeMinStakes = []types.ProvidersTypeMinStake{
+		{ProvidersType: spectypes.Spec_static, MinStake: sdk.Coin{Denom: "", Amount: sdk.NewInt(1)}},
+	}
+	require.Error(t, params.Validate())
+	params.ProvidersTypeMinStakes = []types.ProvidersTypeMinStake{
+		{ProvidersType: spectypes.Spec_static, MinStake: coin(testStake)},
+		{ProvidersType: spectypes.Spec_static, MinStake: coin(testStake)},
+	}
+	require.Error(t, params.Validate())
+
+	// removing the providers type's min stake restores the spec's min stake
+	setMinStakes(types.ProvidersTypeMinStake{ProvidersType: spectypes.Spec_dynamic, MinStake: coin(testStake / 2)})
+	_, found = keeper.GetProvidersTypeMinStake(ts.Ctx, spectypes.Spec_static)
+	require.False(t, found)
+	_, err = ts.TxDualstakingDelegate(provider1Addr, provider1Addr, specs[spectypes.Spec_static], coin(1000))
+	require.NoError(t, err)
+	require.False(t, isFrozen(specs[spectypes.Spec_static]))
+}
@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/utils"
+	"github.com/lavanet/lava/x/dualstaking/types"
+)
+
+// ResolveProviderByMoniker returns the address of the provider staked on the chain
+// with the given moniker (as set in its stake entry), so delegators can identify a
+// provider by its human-readable name. Monikers are not unique, so a moniker shared
+// by several of the chain's providers is rejected rather than guessed.
+func (k Keeper) ResolveProviderByMoniker(ctx sdk.Context, chainID, moniker string) (string, error) {
+	if moniker == "" {
+		return "", utils.LavaFormatWarning("cannot resolve provider", types.ErrProviderMonikerNotFound,
+			utils.Attribute{Key: "chainID", Value: chainID},
+		)
+	}
+
+	stakeStorage, _ := k.epochstorageKeeper.GetStakeStorageCurrent(ctx, chainID)
+
+	var providers []string
+	for _, stakeEntry := range stakeStorage.StakeEntries {
+		if stakeEntry.Moniker == moniker {
+			providers = append(providers, stakeEntry.Address)
+		}
+	}
+
+	switch len(providers) {
+	case 0:
+		return "", utils.LavaFormatWarning("cannot resolve provider", types.ErrProviderMonikerNotFound,
+			utils.Attribute{Key: "chainID", Value: chainID},
+			utils.Attribute{Key: "moniker", Value: moniker},
+		)
+	case 1:
+		return providers[0], nil
+	default:
+		return "", utils.LavaFormatWarning("cannot resolve provider", types.ErrAmbiguousProviderMoniker,
+			utils.Attribute{Key: "chainID", Value: chainID},
+			utils.Attribute{Key: "moniker", Value: moniker},
+			utils.Attribute{Key: "providers", Value: providers},
+		)
+	}
+}
@@ -0,0 +1,49 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	commontypes "github.com/lavanet/lava/common/types"
+	"github.com/lavanet/lava/testutil/common"
+	"github.com/lavanet/lava/x/dualstaking/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveProviderByMoniker(t *testing.T) {
+	ts := newTester(t)
+
+	// 1 delegator, 2 providers staked (sharing the default moniker), 0 provider unstaked, 0 provider unstaking
+	ts.setupForDelegation(1, 2, 0, 0)
+
+	_, client1Addr := ts.GetAccount(common.CONSUMER, 0)
+
+	// a third provider with its own moniker
+	err := ts.addProviders(1)
+	require.NoError(t, err)
+	_, provider3Addr := ts.GetAccount(common.PROVIDER, 2)
+	err = ts.StakeProviderExtra(provider3Addr, ts.spec, testStake, nil, 0, "alpha")
+	require.NoError(t, err)
+
+	// unique moniker: resolved, and the delegation goes to the resolved provider
+	provider, err := ts.Keepers.Dualstaking.ResolveProviderByMoniker(ts.Ctx, ts.spec.Index, "alpha")
+	require.NoError(t, err)
+	require.Equal(t, provider3Addr, provider)
+
+	amount := sdk.NewCoin(commontypes.TokenDenom, sdk.NewInt(10000))
+	_, err = ts.TxDualstakingDelegate(client1Addr, provider, ts.spec.Index, amount)
+	require.NoError(t, err)
+	ts.AdvanceEpoch()
+	_, found := ts.Keepers.Dualstaking.GetDelegation(ts.Ctx, client1Addr, provider3Addr, ts.spec.Index, ts.EpochStart())
+	require.True(t, found)
+
+	// ambiguous moniker
+	_, err = ts.Keepers.Dualstaking.ResolveProviderByMoniker(ts.Ctx, ts.spec.Index, "prov")
+	require.ErrorIs(t, err, types.ErrAmbiguousProviderMoniker)
+
+	// unknown moniker, and a known moniker on a chain its provider isn't staked on
+	_, err = ts.Keepers.Dualstaking.ResolveProviderByMoniker(ts.Ctx, ts.spec.Index, "beta")
+	require.ErrorIs(t, err, types.ErrProviderMonikerNotFound)
+	_, err = ts.Keepers.Dualstaking.ResolveProviderByMoniker(ts.Ctx, "mock1", "alpha")
+	require.ErrorIs(t, err, types.ErrProviderMonikerNotFound)
+}
@@ -4,15 +4,59 @@ import (
 	"context"
 	"fmt"
 
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/lavanet/lava/utils"
 	"github.com/lavanet/lava/x/dualstaking/types"
+	"golang.org/x/exp/slices"
 )
 
 func (k msgServer) Delegate(goCtx context.Context, msg *types.MsgDelegate) (*types.MsgDelegateResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
-	return &types.MsgDelegateResponse{}, k.Keeper.DelegateFull(ctx, msg.Creator, msg.Validator, msg.Provider, msg.ChainID, msg.Amount)
+	receipt, err := k.Keeper.DelegateFullWithReceipt(ctx, msg.Creator, msg.Validator, msg.Provider, msg.ChainID, msg.Amount)
+	if err != nil {
+		return &types.MsgDelegateResponse{}, err
+	}
+	return &types.MsgDelegateResponse{
+		Delegation:            receipt.Delegation,
+		EffectiveEpoch:        receipt.EffectiveEpoch,
+		ProviderDelegateTotal: receipt.ProviderDelegateTotal,
+	}, nil
+}
+
+// DelegateFullWithReceipt delegates (like DelegateFull) and returns a receipt with
+// the resulting state of the delegation
+func (k Keeper) DelegateFullWithReceipt(ctx sdk.Context, delegator string, validator string, provider string, chainID string, amount sdk.Coin) (types.DelegateReceipt, error) {
+	if err := k.DelegateFull(ctx, delegator, validator, provider, chainID, amount); err != nil {
+		return types.DelegateReceipt{}, err
+	}
+	return k.delegateReceipt(ctx, delegator, provider, chainID, amount), nil
+}
+
+// delegateReceipt describes the state of the delegation after delegating the amount
+func (k Keeper) delegateReceipt(ctx sdk.Context, delegator, provider, chainID string, amount sdk.Coin) types.DelegateReceipt {
+	nextEpoch := k.epochstorageKeeper.GetCurrentNextEpoch(ctx)
+	receipt := types.DelegateReceipt{
+		Delegator:             delegator,
+		Provider:              provider,
+		ChainID:               chainID,
+		Amount:                amount,
+		Delegation:            sdk.NewCoin(amount.Denom, math.ZeroInt()),
+		EffectiveEpoch:        nextEpoch,
+		ProviderDelegateTotal: sdk.NewCoin(amount.Denom, math.ZeroInt()),
+	}
+	if delegation, found := k.GetDelegation(ctx, delegator, provider, chainID, nextEpoch); found {
+		receipt.Delegation = delegation.Amount
+	}
+	if providerAddr, err := sdk.AccAddressFromBech32(provider); err == nil {
+		stakeEntry, found, _ := k.epochstorageKeeper.GetStakeEntryByAddressCurrent(ctx, chainID, providerAddr)
+		if found && !stakeEntry.DelegateTotal.IsNil() {
+			receipt.ProviderDelegateTotal = stakeEntry.DelegateTotal
+		}
+	}
+	return receipt
 }
 
 // DelegateFull uses staking module for to delegate with hooks
@@ -42,10 +86,37 @@ func (k Keeper) DelegateFull(ctx sdk.Context, delegator string, validator string
 		return err
 	}
 
+	// check before delegating in the staking module, so that delegating to an
+	// unstaked provider doesn't change any state
+	if err := k.verifyProviderStaked(ctx, provider, chainID); err != nil {
+		return err
+	}
+	if !k.IsDelegatorAllowed(ctx, provider, delegator) {
+		return utils.LavaFormatWarning("delegator is not allowed by the provider", types.ErrDelegatorNotAllowed,
+			utils.LogAttr("delegator", delegator),
+			utils.LogAttr("provider", provider),
+		)
+	}
+	// (if not rejected, the warning is logged when the delegation is increased)
+	if k.RejectInactiveProviders(ctx) {
+		if err := k.verifyProviderActive(ctx, delegator, provider, chainID); err != nil {
+			return err
+		}
+	}
+
 	if err := utils.ValidateCoins(ctx, k.stakingKeeper.BondDenom(ctx), amount, false); err != nil {
 		return err
 	}
 
+	// fail on insufficient funds before any state change
+	if balance := k.bankKeeper.GetBalance(ctx, delegatorAddress, amount.Denom); balance.IsLT(amount) {
+		return utils.LavaFormatWarning("insufficient funds to delegate", sdkerrors.ErrInsufficientFunds,
+			utils.LogAttr("delegator", delegator),
+			utils.LogAttr("balance", balance),
+			utils.LogAttr("amount", amount),
+		)
+	}
+
 	_, err = k.stakingKeeper.Delegate(ctx, delegatorAddress, amount.Amount, stakingtypes.Unbonded, validatorType, true)
 	if err != nil {
 		return err
@@ -74,3 +145,42 @@ func (k Keeper) DelegateFull(ctx sdk.Context, delegator string, validator string
 
 	return err
 }
+
+// DelegateToProviderChains delegates to the provider on several chains at once
+// (using the same validator for all). The chains are delegated in sorted order, and
+// either all the delegations succeed or none of them is applied.
+func (k Keeper) DelegateToProviderChains(ctx sdk.Context, delegator string, validator string, provider string, amounts map[string]sdk.Coin) error {
+	if len(amounts) == 0 {
+		return utils.LavaFormatWarning("no chains to delegate to", types.ErrBadDelegationAmount,
+			utils.LogAttr("delegator", delegator),
+			utils.LogAttr("provider", provider),
+		)
+	}
+
+	chainIDs := make([]string, 0, len(amounts))
+	for chainID := range amounts {
+		if chainID == types.EMPTY_PROVIDER_CHAINID {
+			return utils.LavaFormatWarning("invalid chain ID", fmt.Errorf("empty chain ID"),
+				utils.LogAttr("delegator", delegator),
+				utils.LogAttr("provider", provider),
+			)
+		}
+		chainIDs = append(chainIDs, chainID)
+	}
+	slices.Sort(chainIDs)
+
+	// delegate on a cached context, and only write it if all the delegations succeed
+	cacheCtx, writeCache := ctx.CacheContext()
+	for _, chainID := range chainIDs {
+		if err := k.DelegateFull(cacheCtx, delegator, validator, provider, chainID, amounts[chainID]); err != nil {
+			return utils.LavaFormatWarning("failed to delegate to provider chains", err,
+				utils.LogAttr("delegator", delegator),
+				utils.LogAttr("provider", provider),
+				utils.LogAttr("chain_id", chainID),
+			)
+		}
+	}
+	writeCache()
+
+	return nil
+}
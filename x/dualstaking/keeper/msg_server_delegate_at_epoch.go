@@ -0,0 +1,29 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/utils"
+	"github.com/lavanet/lava/x/dualstaking/types"
+)
+
+func (k msgServer) DelegateAtEpoch(goCtx context.Context, msg *types.MsgDelegateAtEpoch) (*types.MsgDelegateAtEpochResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	err := k.Keeper.DelegateAtEpoch(ctx, msg.Creator, msg.Provider, msg.ChainID, msg.Amount, msg.TargetEpoch)
+	if err == nil {
+		logger := k.Keeper.Logger(ctx)
+		details := map[string]string{
+			"delegator":    msg.Creator,
+			"provider":     msg.Provider,
+			"chainID":      msg.ChainID,
+			"amount":       msg.Amount.String(),
+			"target_epoch": strconv.FormatUint(msg.TargetEpoch, 10),
+		}
+		utils.LogLavaEvent(ctx, logger, types.ScheduleDelegationEventName, details, "Schedule Delegation")
+	}
+
+	return &types.MsgDelegateAtEpochResponse{}, err
+}
@@ -0,0 +1,22 @@
+package keeper
+
+import (
+	"context"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/lavanet/lava/x/dualstaking/types"
+)
+
+func (k msgServer) ForceUnbondDelegator(goCtx context.Context, msg *types.MsgForceUnbondDelegator) (*types.MsgForceUnbondDelegatorResponse, error) {
+	if msg.Authority != k.authority {
+		return nil, sdkerrors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	err := k.Keeper.ForceUnbondDelegator(ctx, msg.Delegator, msg.SkipHoldPeriod)
+
+	return &types.MsgForceUnbondDelegatorResponse{}, err
+}
@@ -0,0 +1,28 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/utils"
+	"github.com/lavanet/lava/x/dualstaking/types"
+)
+
+func (k msgServer) SetAutoCompound(goCtx context.Context, msg *types.MsgSetAutoCompound) (*types.MsgSetAutoCompoundResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	err := k.Keeper.SetDelegationAutoCompound(ctx, msg.Creator, msg.Provider, msg.ChainID, msg.Enabled)
+	if err == nil {
+		logger := k.Keeper.Logger(ctx)
+		details := map[string]string{
+			"delegator": msg.Creator,
+			"provider":  msg.Provider,
+			"chainID":   msg.ChainID,
+			"enabled":   strconv.FormatBool(msg.Enabled),
+		}
+		utils.LogLavaEvent(ctx, logger, types.SetAutoCompoundEventName, details, "Set Delegation Auto-Compound")
+	}
+
+	return &types.MsgSetAutoCompoundResponse{}, err
+}
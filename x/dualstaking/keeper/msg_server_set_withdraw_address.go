@@ -0,0 +1,25 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/utils"
+	"github.com/lavanet/lava/x/dualstaking/types"
+)
+
+func (k msgServer) SetWithdrawAddress(goCtx context.Context, msg *types.MsgSetWithdrawAddress) (*types.MsgSetWithdrawAddressResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	err := k.Keeper.SetWithdrawAddress(ctx, msg.Creator, msg.WithdrawAddress)
+	if err == nil {
+		logger := k.Keeper.Logger(ctx)
+		details := map[string]string{
+			"delegator":        msg.Creator,
+			"withdraw_address": msg.WithdrawAddress,
+		}
+		utils.LogLavaEvent(ctx, logger, types.SetWithdrawAddressEventName, details, "Set Delegator Withdraw Address")
+	}
+
+	return &types.MsgSetWithdrawAddressResponse{}, err
+}
@@ -2,7 +2,9 @@ package keeper
 
 import (
 	"context"
+	"fmt"
 
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/lavanet/lava/utils"
 	"github.com/lavanet/lava/x/dualstaking/types"
@@ -65,7 +67,40 @@ func (k Keeper) UnbondFull(ctx sdk.Context, delegator string, validator string,
 			"amount":    amount.String(),
 		}
 		utils.LogLavaEvent(ctx, logger, types.UnbondingEventName, details, "Unbond")
+		if unstake {
+			k.emitEmptyProviderRebalance(ctx, delegator, amount, types.EmptyProviderRebalanceProviderUnstaked)
+		}
 	}
 
 	return err
 }
+
+// UnbondFraction unbonds a fraction (in the range (0,1]) of the delegator's current
+// delegation to the provider on the chain. The unbonded amount is truncated.
+func (k Keeper) UnbondFraction(ctx sdk.Context, delegator string, validator string, provider string, chainID string, fraction math.LegacyDec, unstake bool) error {
+	if !fraction.IsPositive() || fraction.GT(math.LegacyOneDec()) {
+		return utils.LavaFormatWarning("invalid unbond fraction", types.ErrBadDelegationAmount,
+			utils.LogAttr("fraction", fraction),
+		)
+	}
+
+	nextEpoch := k.epochstorageKeeper.GetCurrentNextEpoch(ctx)
+	delegation, found := k.GetDelegation(ctx, delegator, provider, chainID, nextEpoch)
+	if !found {
+		return utils.LavaFormatWarning("cannot unbond fraction", types.ErrDelegationNotFound,
+			utils.LogAttr("delegator", delegator),
+			utils.LogAttr("provider", provider),
+			utils.LogAttr("chain_id", chainID),
+		)
+	}
+
+	amount := sdk.NewCoin(delegation.Amount.Denom, fraction.MulInt(delegation.Amount.Amount).TruncateInt())
+	if amount.IsZero() {
+		return utils.LavaFormatWarning("cannot unbond fraction", fmt.Errorf("fraction of the delegation is zero"),
+			utils.LogAttr("delegation", delegation.Amount),
+			utils.LogAttr("fraction", fraction),
+		)
+	}
+
+	return k.UnbondFull(ctx, delegator, validator, provider, chainID, amount, unstake)
+}
@@ -0,0 +1,35 @@
+package keeper
+
+import (
+	"context"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/utils"
+	"github.com/lavanet/lava/x/dualstaking/types"
+)
+
+func (k msgServer) UpdateDelegatorAllowlist(goCtx context.Context, msg *types.MsgUpdateDelegatorAllowlist) (*types.MsgUpdateDelegatorAllowlistResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return &types.MsgUpdateDelegatorAllowlistResponse{}, err
+	}
+
+	for _, delegator := range msg.Add {
+		k.Keeper.AddDelegatorToAllowlist(ctx, msg.Creator, delegator)
+	}
+	for _, delegator := range msg.Remove {
+		k.Keeper.RemoveDelegatorFromAllowlist(ctx, msg.Creator, delegator)
+	}
+
+	logger := k.Keeper.Logger(ctx)
+	details := map[string]string{
+		"provider": msg.Creator,
+		"added":    strings.Join(msg.Add, ","),
+		"removed":  strings.Join(msg.Remove, ","),
+	}
+	utils.LogLavaEvent(ctx, logger, types.UpdateDelegatorAllowlistEventName, details, "Update Delegator Allowlist")
+
+	return &types.MsgUpdateDelegatorAllowlistResponse{}, nil
+}
@@ -7,10 +7,53 @@ import (
 
 // GetParams get all parameters as types.Params
 func (k Keeper) GetParams(ctx sdk.Context) types.Params {
-	return types.NewParams()
+	return types.NewParams(
+		k.RejectInactiveProviders(ctx),
+		k.MinDelegation(ctx),
+		k.LoyaltyMaxMultiplier(ctx),
+		k.LoyaltyRampEpochs(ctx),
+		k.ProvidersTypeMinStakes(ctx),
+		k.ExtensionStakeRequirements(ctx),
+	)
 }
 
 // SetParams set the params
 func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
 	k.paramstore.SetParamSet(ctx, &params)
 }
+
+// RejectInactiveProviders returns the RejectInactiveProviders param
+func (k Keeper) RejectInactiveProviders(ctx sdk.Context) (res bool) {
+	k.paramstore.Get(ctx, types.KeyRejectInactiveProviders, &res)
+	return
+}
+
+// MinDelegation returns the MinDelegation param
+func (k Keeper) MinDelegation(ctx sdk.Context) (res sdk.Int) {
+	k.paramstore.Get(ctx, types.KeyMinDelegation, &res)
+	return
+}
+
+// LoyaltyMaxMultiplier returns the LoyaltyMaxMultiplier param
+func (k Keeper) LoyaltyMaxMultiplier(ctx sdk.Context) (res sdk.Dec) {
+	k.paramstore.Get(ctx, types.KeyLoyaltyMaxMultiplier, &res)
+	return
+}
+
+// LoyaltyRampEpochs returns the LoyaltyRampEpochs param
+func (k Keeper) LoyaltyRampEpochs(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyLoyaltyRampEpochs, &res)
+	return
+}
+
+// ProvidersTypeMinStakes returns the ProvidersTypeMinStakes param
+func (k Keeper) ProvidersTypeMinStakes(ctx sdk.Context) (res []types.ProvidersTypeMinStake) {
+	k.paramstore.Get(ctx, types.KeyProvidersTypeMinStakes, &res)
+	return
+}
+
+// ExtensionStakeRequirements returns the ExtensionStakeRequirements param
+func (k Keeper) ExtensionStakeRequirements(ctx sdk.Context) (res []types.ExtensionStakeRequirement) {
+	k.paramstore.Get(ctx, types.KeyExtensionStakeRequirements, &res)
+	return
+}
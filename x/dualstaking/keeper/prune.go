@@ -0,0 +1,65 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/utils"
+	"github.com/lavanet/lava/x/dualstaking/types"
+)
+
+// PruneDelegations removes leftovers that the regular delegation flows should not
+// leave behind, but could following past bugs or migrations: zero-amount delegation
+// entries, providers listed in a delegator entry without a matching delegation,
+// and delegator entries with no providers. The removals take effect on the next
+// epoch, and the stale versions are reclaimed by the fixation store as usual.
+func (k Keeper) PruneDelegations(ctx sdk.Context) (prunedDelegations, prunedDelegators int) {
+	nextEpoch := k.epochstorageKeeper.GetCurrentNextEpoch(ctx)
+
+	for _, ind := range k.delegationFS.GetAllEntryIndices(ctx) {
+		var delegation types.Delegation
+		if !k.delegationFS.FindEntry(ctx, ind, nextEpoch, &delegation) || !delegation.IsZero() {
+			continue
+		}
+		if err := k.delegationFS.DelEntry(ctx, ind, nextEpoch); err != nil {
+			utils.LavaFormatError("failed pruning zero delegation entry", err,
+				utils.Attribute{Key: "index", Value: ind},
+			)
+			continue
+		}
+		k.RemoveDelegationLock(ctx, delegation.Delegator, delegation.Provider, delegation.ChainID)
+		prunedDelegations++
+	}
+
+	for _, ind := range k.delegatorFS.GetAllEntryIndices(ctx) {
+		var delegatorEntry types.Delegator
+		if !k.delegatorFS.FindEntry(ctx, ind, nextEpoch, &delegatorEntry) {
+			continue
+		}
+
+		delegator := types.DelegatorKeyDecode(ind)
+		modified := false
+		for _, provider := range append([]string{}, delegatorEntry.Providers...) {
+			if len(k.GetAllProviderDelegatorDelegations(ctx, delegator, provider, nextEpoch)) == 0 {
+				delegatorEntry.DelProvider(provider)
+				modified = true
+			}
+		}
+
+		var err error
+		switch {
+		case delegatorEntry.IsEmpty():
+			err = k.delegatorFS.DelEntry(ctx, ind, nextEpoch)
+			if err == nil {
+				prunedDelegators++
+			}
+		case modified:
+			err = k.delegatorFS.AppendEntry(ctx, ind, nextEpoch, &delegatorEntry)
+		}
+		if err != nil {
+			utils.LavaFormatError("failed pruning delegator entry", err,
+				utils.Attribute{Key: "delegator", Value: delegator},
+			)
+		}
+	}
+
+	return prunedDelegations, prunedDelegators
+}
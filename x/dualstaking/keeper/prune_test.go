@@ -0,0 +1,47 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	commontypes "github.com/lavanet/lava/common/types"
+	"github.com/lavanet/lava/testutil/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPruneDelegations(t *testing.T) {
+	ts := newTester(t)
+
+	// 1 delegator, 1 provider staked, 0 provider unstaked, 0 provider unstaking
+	ts.setupForDelegation(1, 1, 0, 0)
+
+	_, client1Addr := ts.GetAccount(common.CONSUMER, 0)
+	_, provider1Addr := ts.GetAccount(common.PROVIDER, 0)
+
+	amount := sdk.NewCoin(commontypes.TokenDenom, sdk.NewInt(10000))
+	_, err := ts.TxDualstakingDelegate(client1Addr, provider1Addr, ts.spec.Index, amount)
+	require.NoError(t, err)
+	ts.AdvanceEpoch()
+
+	providers, err := ts.Keepers.Dualstaking.GetDelegatorProviders(ts.Ctx, client1Addr, ts.EpochStart())
+	require.NoError(t, err)
+	require.Equal(t, []string{provider1Addr}, providers)
+
+	// fully unbond the delegator
+	_, err = ts.TxDualstakingUnbond(client1Addr, provider1Addr, ts.spec.Index, amount)
+	require.NoError(t, err)
+	ts.AdvanceEpoch()
+
+	ts.Keepers.Dualstaking.PruneDelegations(ts.Ctx)
+
+	providers, err = ts.Keepers.Dualstaking.GetDelegatorProviders(ts.Ctx, client1Addr, ts.GetNextEpoch())
+	require.NoError(t, err)
+	require.Empty(t, providers)
+
+	// the remaining delegations are untouched, and pruning again finds nothing
+	_, found := ts.Keepers.Dualstaking.GetDelegation(ts.Ctx, provider1Addr, provider1Addr, ts.spec.Index, ts.GetNextEpoch())
+	require.True(t, found)
+	prunedDelegations, prunedDelegators := ts.Keepers.Dualstaking.PruneDelegations(ts.Ctx)
+	require.Zero(t, prunedDelegations)
+	require.Zero(t, prunedDelegators)
+}
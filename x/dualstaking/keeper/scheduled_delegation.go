@@ -0,0 +1,286 @@
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/lavanet/lava/utils"
+	"github.com/lavanet/lava/x/dualstaking/types"
+)
+
+// A delegation may be scheduled to take effect at a future epoch (rather than the
+// next one). The funds are delegated in the staking module right away, so they are
+// locked from the moment of scheduling, and wait with the empty provider. Once the
+// target epoch becomes the next epoch, BeginBlock (at the epoch start) redelegates
+// them from the empty provider to the provider, so the delegation's fixation entry
+// is appended at the target epoch. At most MaxScheduledDelegationsPerBlock are
+// applied per epoch start, and the rest wait for the following epoch start (and
+// take effect an epoch later). If that fails (e.g. the provider unstaked meanwhile, or the funds
+// were unbonded from the empty provider), the funds stay with the empty provider.
+// The scheduled funds are not unbondable from the empty provider by validator
+// unbonds, unless the delegator's other delegations cannot cover the unbond: then
+// the scheduled delegations are reduced (latest first) by the shortfall.
+// The scheduled delegations are indexed by <epoch,provider,delegator,chainID>, and
+// also by the delegator.
+
+// DelegateAtEpoch delegates (like DelegateFull) but with the delegation taking
+// effect at the target epoch, which must not be earlier than the next epoch. The
+// funds are delegated through the validator the delegator delegates the most to (or
+// else the most powerful bonded validator).
+func (k Keeper) DelegateAtEpoch(ctx sdk.Context, delegator, provider, chainID string, amount sdk.Coin, targetEpoch uint64) error {
+	nextEpoch := k.epochstorageKeeper.GetCurrentNextEpoch(ctx)
+	if targetEpoch < nextEpoch {
+		return utils.LavaFormatWarning("cannot schedule delegation to a past or current epoch", types.ErrInvalidDelegationEpoch,
+			utils.LogAttr("target_epoch", targetEpoch),
+			utils.LogAttr("next_epoch", nextEpoch),
+		)
+	}
+	if _, blockInEpoch, err := k.epochstorageKeeper.GetEpochStartForBlock(ctx, targetEpoch); err != nil || blockInEpoch != 0 {
+		return utils.LavaFormatWarning("cannot schedule delegation: target is not an epoch start", types.ErrInvalidDelegationEpoch,
+			utils.LogAttr("target_epoch", targetEpoch),
+			utils.LogAttr("error", err),
+		)
+	}
+
+	delegatorAddr, err := sdk.AccAddressFromBech32(delegator)
+	if err != nil {
+		return utils.LavaFormatWarning("invalid delegator address", err,
+			utils.LogAttr("delegator", delegator),
+		)
+	}
+	validator, err := k.delegationValidator(ctx, delegatorAddr)
+	if err != nil {
+		return err
+	}
+
+	if targetEpoch == nextEpoch {
+		return k.DelegateFull(ctx, delegator, validator, provider, chainID, amount)
+	}
+
+	if _, found := k.specKeeper.GetSpec(ctx, chainID); !found {
+		return utils.LavaFormatWarning("invalid chain ID", fmt.Errorf("chain ID not found"),
+			utils.LogAttr("chain_id", chainID),
+		)
+	}
+	if err := k.verifyProviderStaked(ctx, provider, chainID); err != nil {
+		return err
+	}
+	if !k.IsDelegatorAllowed(ctx, provider, delegator) {
+		return utils.LavaFormatWarning("delegator is not allowed by the provider", types.ErrDelegatorNotAllowed,
+			utils.LogAttr("delegator", delegator),
+			utils.LogAttr("provider", provider),
+		)
+	}
+	if err := utils.ValidateCoins(ctx, k.stakingKeeper.BondDenom(ctx), amount, false); err != nil {
+		return err
+	}
+	if balance := k.bankKeeper.GetBalance(ctx, delegatorAddr, amount.Denom); balance.IsLT(amount) {
+		return utils.LavaFormatWarning("insufficient funds to delegate", sdkerrors.ErrInsufficientFunds,
+			utils.LogAttr("delegator", delegator),
+			utils.LogAttr("balance", balance),
+			utils.LogAttr("amount", amount),
+		)
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(validator)
+	if err != nil {
+		return err
+	}
+	validatorType, found := k.stakingKeeper.GetValidator(ctx, valAddr)
+	if !found {
+		return stakingtypes.ErrNoValidatorFound
+	}
+
+	// the staking hooks delegate the funds to the empty provider
+	_, err = k.stakingKeeper.Delegate(ctx, delegatorAddr, amount.Amount, stakingtypes.Unbonded, validatorType, true)
+	if err != nil {
+		return err
+	}
+
+	scheduled, found := k.GetScheduledDelegation(ctx, delegator, provider, chainID, targetEpoch)
+	if found {
+		amount = amount.Add(scheduled)
+	}
+	k.SetScheduledDelegation(ctx, delegator, provider, chainID, targetEpoch, amount)
+
+	return nil
+}
+
+// GetScheduledDelegation returns the amount scheduled to be delegated at the epoch
+func (k Keeper) GetScheduledDelegation(ctx sdk.Context, delegator, provider, chainID string, epoch uint64) (sdk.Coin, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ScheduledDelegationPrefix))
+	b := store.Get(types.ScheduledDelegationKey(epoch, provider, delegator, chainID))
+	if b == nil {
+		return sdk.Coin{}, false
+	}
+	var amount sdk.Coin
+	k.cdc.MustUnmarshal(b, &amount)
+	return amount, true
+}
+
+// SetScheduledDelegation sets the amount scheduled to be delegated at the epoch
+func (k Keeper) SetScheduledDelegation(ctx sdk.Context, delegator, provider, chainID string, epoch uint64, amount sdk.Coin) {
+	key := types.ScheduledDelegationKey(epoch, provider, delegator, chainID)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ScheduledDelegationPrefix))
+	store.Set(key, k.cdc.MustMarshal(&amount))
+
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ScheduledDelegationByDelegatorPrefix))
+	indexStore.Set(types.ScheduledDelegationByDelegatorKey(delegator, key), []byte{1})
+}
+
+// removeScheduledDelegation removes the scheduled delegation (by its key)
+func (k Keeper) removeScheduledDelegation(ctx sdk.Context, key []byte) {
+	_, _, delegator, _ := types.ScheduledDelegationKeyDecode(key)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ScheduledDelegationPrefix))
+	store.Delete(key)
+
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ScheduledDelegationByDelegatorPrefix))
+	indexStore.Delete(types.ScheduledDelegationByDelegatorKey(delegator, key))
+}
+
+// GetDelegatorScheduledDelegations returns the delegator's scheduled delegations
+// (ordered by epoch)
+func (k Keeper) GetDelegatorScheduledDelegations(ctx sdk.Context, delegator string) []types.ScheduledDelegation {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ScheduledDelegationPrefix))
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ScheduledDelegationByDelegatorPrefix))
+	indexPrefix := types.ScheduledDelegationByDelegatorKey(delegator, nil)
+	iterator := sdk.KVStorePrefixIterator(indexStore, indexPrefix)
+	defer iterator.Close()
+
+	scheduled := []types.ScheduledDelegation{}
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()[len(indexPrefix):]
+		b := store.Get(key)
+		if b == nil {
+			continue
+		}
+		var amount sdk.Coin
+		k.cdc.MustUnmarshal(b, &amount)
+		epoch, provider, _, chainID := types.ScheduledDelegationKeyDecode(key)
+		scheduled = append(scheduled, types.ScheduledDelegation{
+			Delegator: delegator,
+			Provider:  provider,
+			ChainId:   chainID,
+			Epoch:     epoch,
+			Amount:    amount,
+		})
+	}
+	return scheduled
+}
+
+// getDelegatorScheduledTotal returns the total amount of the delegator's scheduled
+// delegations (which waits with the empty provider)
+func (k Keeper) getDelegatorScheduledTotal(ctx sdk.Context, delegator string) math.Int {
+	total := math.ZeroInt()
+	for _, scheduled := range k.GetDelegatorScheduledDelegations(ctx, delegator) {
+		total = total.Add(scheduled.Amount.Amount)
+	}
+	return total
+}
+
+// reduceScheduledDelegations reduces the delegator's scheduled delegations by the
+// amount (that was unbonded from the empty provider), starting with the latest
+// ones. A scheduled delegation reduced to zero is cancelled.
+func (k Keeper) reduceScheduledDelegations(ctx sdk.Context, delegator string, amount math.Int) {
+	scheduled := k.GetDelegatorScheduledDelegations(ctx, delegator)
+	for i := len(scheduled) - 1; i >= 0 && amount.IsPositive(); i-- {
+		s := scheduled[i]
+		reduction := math.MinInt(amount, s.Amount.Amount)
+		amount = amount.Sub(reduction)
+
+		if reduction.Equal(s.Amount.Amount) {
+			k.removeScheduledDelegation(ctx, types.ScheduledDelegationKey(s.Epoch, s.Provider, s.Delegator, s.ChainId))
+		} else {
+			k.SetScheduledDelegation(ctx, s.Delegator, s.Provider, s.ChainId, s.Epoch, s.Amount.SubAmount(reduction))
+		}
+
+		details := map[string]string{
+			"delegator": s.Delegator,
+			"provider":  s.Provider,
+			"chainID":   s.ChainId,
+			"epoch":     strconv.FormatUint(s.Epoch, 10),
+			"amount":    sdk.NewCoin(s.Amount.Denom, reduction).String(),
+			"remaining": s.Amount.SubAmount(reduction).String(),
+		}
+		utils.LogLavaEvent(ctx, k.Logger(ctx), types.CancelScheduledDelegationEventName, details, "Scheduled delegation cancelled by unbond")
+	}
+}
+
+// GetAllScheduledDelegations returns all the scheduled delegations (for genesis)
+func (k Keeper) GetAllScheduledDelegations(ctx sdk.Context) []types.ScheduledDelegation {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ScheduledDelegationPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	scheduled := []types.ScheduledDelegation{}
+	for ; iterator.Valid(); iterator.Next() {
+		var amount sdk.Coin
+		k.cdc.MustUnmarshal(iterator.Value(), &amount)
+		epoch, provider, delegator, chainID := types.ScheduledDelegationKeyDecode(iterator.Key())
+		scheduled = append(scheduled, types.ScheduledDelegation{
+			Delegator: delegator,
+			Provider:  provider,
+			ChainId:   chainID,
+			Epoch:     epoch,
+			Amount:    amount,
+		})
+	}
+	return scheduled
+}
+
+// BeginBlock imports the delegations pending since genesis (see
+// importPendingDelegations), and applies (at epoch start) the scheduled delegations
+// whose target epoch is (no later than) the next epoch, up to
+// MaxScheduledDelegationsPerBlock of them
+func (k Keeper) BeginBlock(ctx sdk.Context) {
+	k.importPendingDelegations(ctx)
+
+	if !k.epochstorageKeeper.IsEpochStart(ctx) {
+		return
+	}
+
+	nextEpoch := k.epochstorageKeeper.GetCurrentNextEpoch(ctx)
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ScheduledDelegationPrefix))
+	iterator := store.Iterator(nil, sdk.Uint64ToBigEndian(nextEpoch+1))
+
+	var keys [][]byte
+	for ; iterator.Valid() && len(keys) < types.MaxScheduledDelegationsPerBlock; iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		var amount sdk.Coin
+		k.cdc.MustUnmarshal(store.Get(key), &amount)
+		k.removeScheduledDelegation(ctx, key)
+
+		epoch, provider, delegator, chainID := types.ScheduledDelegationKeyDecode(key)
+		cacheCtx, writeCache := ctx.CacheContext()
+		err := k.Redelegate(cacheCtx, delegator, types.EMPTY_PROVIDER, provider, types.EMPTY_PROVIDER_CHAINID, chainID, amount)
+		if err != nil {
+			utils.LavaFormatWarning("failed to apply scheduled delegation, leaving the funds with the empty provider", err,
+				utils.LogAttr("delegator", delegator),
+				utils.LogAttr("provider", provider),
+				utils.LogAttr("chain_id", chainID),
+				utils.LogAttr("amount", amount),
+				utils.LogAttr("epoch", epoch),
+			)
+			continue
+		}
+		writeCache()
+
+		details := map[string]string{
+			"delegator": delegator,
+			"provider":  provider,
+			"chainID":   chainID,
+			"amount":    amount.String(),
+		}
+		utils.LogLavaEvent(ctx, k.Logger(ctx), types.DelegateEventName, details, "Delegate")
+	}
+}
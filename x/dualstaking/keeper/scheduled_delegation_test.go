@@ -0,0 +1,185 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	commontypes "github.com/lavanet/lava/common/types"
+	"github.com/lavanet/lava/testutil/common"
+	"github.com/lavanet/lava/x/dualstaking/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDelegateAtEpoch(t *testing.T) {
+	ts := newTester(t)
+
+	// 1 delegator, 1 provider staked, 0 provider unstaked, 0 provider unstaking
+	ts.setupForDelegation(1, 1, 0, 0)
+
+	client1Acct, client1Addr := ts.GetAccount(common.CONSUMER, 0)
+	_, provider1Addr := ts.GetAccount(common.PROVIDER, 0)
+
+	validator, _ := ts.GetAccount(common.VALIDATOR, 0)
+
+	keeper := ts.Keepers.Dualstaking
+	amount := sdk.NewCoin(commontypes.TokenDenom, sdk.NewInt(10000))
+
+	// the scheduled funds go through the validator the delegator delegates to
+	// (this delegation waits with the empty provider)
+	validatorAmount := sdk.NewCoin(commontypes.TokenDenom, sdk.NewInt(1000))
+	_, err := ts.TxDelegateValidator(client1Acct, validator, validatorAmount.Amount)
+	require.NoError(t, err)
+
+	// past, current and non epoch start blocks are rejected
+	nextEpoch := ts.GetNextEpoch()
+	_, err = ts.TxDualstakingDelegateAtEpoch(client1Addr, provider1Addr, ts.spec.Index, amount, ts.EpochStart())
+	require.ErrorIs(t, err, types.ErrInvalidDelegationEpoch)
+	_, err = ts.TxDualstakingDelegateAtEpoch(client1Addr, provider1Addr, ts.spec.Index, amount, nextEpoch+1)
+	require.ErrorIs(t, err, types.ErrInvalidDelegationEpoch)
+
+	// schedule the delegation two epochs out: the funds are locked right away
+	balance := ts.GetBalance(client1Acct.Addr)
+	targetEpoch := nextEpoch + ts.EpochBlocks()
+	_, err = ts.TxDualstakingDelegateAtEpoch(client1Addr, provider1Addr, ts.spec.Index, amount, targetEpoch)
+	require.NoError(t, err)
+	require.Equal(t, balance-amount.Amount.Int64(), ts.GetBalance(client1Acct.Addr))
+
+	scheduled, found := keeper.GetScheduledDelegation(ts.Ctx, client1Addr, provider1Addr, ts.spec.Index, targetEpoch)
+	require.True(t, found)
+	require.True(t, amount.IsEqual(scheduled))
+
+	// inactive until the target epoch
+	_, found = keeper.GetDelegation(ts.Ctx, client1Addr, provider1Addr, ts.spec.Index, nextEpoch)
+	require.False(t, found)
+
+	ts.AdvanceEpoch()
+	_, found = keeper.GetDelegation(ts.Ctx, client1Addr, provider1Addr, ts.spec.Index, ts.EpochStart())
+	require.False(t, found)
+	_, found = keeper.GetScheduledDelegation(ts.Ctx, client1Addr, provider1Addr, ts.spec.Index, targetEpoch)
+	require.False(t, found)
+
+	ts.AdvanceEpoch()
+	require.Equal(t, targetEpoch, ts.EpochStart())
+	delegation, found := keeper.GetDelegation(ts.Ctx, client1Addr, provider1Addr, ts.spec.Index, ts.EpochStart())
+	require.True(t, found)
+	require.True(t, amount.IsEqual(delegation.Amount))
+
+	// the funds were moved from the empty provider
+	delegation, found = keeper.GetDelegation(ts.Ctx, client1Addr, types.EMPTY_PROVIDER, types.EMPTY_PROVIDER_CHAINID, ts.EpochStart())
+	require.True(t, found)
+	require.True(t, validatorAmount.IsEqual(delegation.Amount))
+}
+
+func TestScheduledDelegationsPerBlockCap(t *testing.T) {
+	ts := newTester(t)
+
+	// more delegators than the per block cap, 1 provider staked
+	delegators := types.MaxScheduledDelegationsPerBlock + 1
+	ts.setupForDelegation(delegators, 1, 0, 0)
+
+	_, provider1Addr := ts.GetAccount(common.PROVIDER, 0)
+
+	keeper := ts.Keepers.Dualstaking
+	amount := sdk.NewCoin(commontypes.TokenDenom, sdk.NewInt(10000))
+
+	targetEpoch := ts.GetNextEpoch() + ts.EpochBlocks()
+	for i := 0; i < delegators; i++ {
+		_, clientAddr := ts.GetAccount(common.CONSUMER, i)
+		_, err := ts.TxDualstakingDelegateAtEpoch(clientAddr, provider1Addr, ts.spec.Index, amount, targetEpoch)
+		require.NoError(t, err)
+	}
+
+	pending := func() (count int) {
+		for i := 0; i < delegators; i++ {
+			_, clientAddr := ts.GetAccount(common.CONSUMER, i)
+			if _, found := keeper.GetScheduledDelegation(ts.Ctx, clientAddr, provider1Addr, ts.spec.Index, targetEpoch); found {
+				count++
+			}
+		}
+		return count
+	}
+
+	// nothing is applied before the epoch start
+	ts.AdvanceBlock()
+	require.Equal(t, delegators, pending())
+
+	// the first epoch start applies up to the cap, and the next one the rest
+	ts.AdvanceEpoch()
+	require.Equal(t, delegators-types.MaxScheduledDelegationsPerBlock, pending())
+	ts.AdvanceEpoch()
+	require.Equal(t, 0, pending())
+
+	ts.AdvanceEpoch()
+	for i := 0; i < delegators; i++ {
+		_, clientAddr := ts.GetAccount(common.CONSUMER, i)
+		delegation, found := keeper.GetDelegation(ts.Ctx, clientAddr, provider1Addr, ts.spec.Index, ts.EpochStart())
+		require.True(t, found)
+		require.True(t, amount.IsEqual(delegation.Amount))
+	}
+}
+
+func TestScheduledDelegationValidatorUnbond(t *testing.T) {
+	ts := newTester(t)
+
+	// 1 delegator, 1 provider staked, 0 provider unstaked, 0 provider unstaking
+	ts.setupForDelegation(1, 1, 0, 0)
+
+	client1Acct, client1Addr := ts.GetAccount(common.CONSUMER, 0)
+	_, provider1Addr := ts.GetAccount(common.PROVIDER, 0)
+	validator, _ := ts.GetAccount(common.VALIDATOR, 0)
+
+	keeper := ts.Keepers.Dualstaking
+	coin := func(amount int64) sdk.Coin {
+		return sdk.NewCoin(commontypes.TokenDenom, sdk.NewInt(amount))
+	}
+
+	// 1000 free in the empty provider, 2000 delegated to provider1, and 10000
+	// scheduled two epochs out (waiting in the empty provider)
+	_, err := ts.TxDelegateValidator(client1Acct, validator, sdk.NewInt(1000))
+	require.NoError(t, err)
+	_, err = ts.TxDualstakingDelegate(client1Addr, provider1Addr, ts.spec.Index, coin(2000))
+	require.NoError(t, err)
+	targetEpoch := ts.GetNextEpoch() + ts.EpochBlocks()
+	_, err = ts.TxDualstakingDelegateAtEpoch(client1Addr, provider1Addr, ts.spec.Index, coin(10000), targetEpoch)
+	require.NoError(t, err)
+
+	emptyDelegation := func() sdk.Coin {
+		delegation, found := keeper.GetDelegation(ts.Ctx, client1Addr, types.EMPTY_PROVIDER, types.EMPTY_PROVIDER_CHAINID, ts.GetNextEpoch())
+		require.True(t, found)
+		return delegation.Amount
+	}
+	require.True(t, coin(11000).IsEqual(emptyDelegation()))
+
+	// a validator unbond takes the free funds and then the provider delegations,
+	// leaving the scheduled funds in place
+	_, err = ts.TxUnbondValidator(client1Acct, validator, sdk.NewInt(2500))
+	require.NoError(t, err)
+	require.True(t, coin(10000).IsEqual(emptyDelegation()))
+	delegation, found := keeper.GetDelegation(ts.Ctx, client1Addr, provider1Addr, ts.spec.Index, ts.GetNextEpoch())
+	require.True(t, found)
+	require.True(t, coin(500).IsEqual(delegation.Amount))
+	scheduled, found := keeper.GetScheduledDelegation(ts.Ctx, client1Addr, provider1Addr, ts.spec.Index, targetEpoch)
+	require.True(t, found)
+	require.True(t, coin(10000).IsEqual(scheduled))
+
+	// when the other delegations cannot cover the unbond, the shortfall is removed
+	// from the scheduled funds, reducing the scheduled delegation
+	_, err = ts.TxUnbondValidator(client1Acct, validator, sdk.NewInt(1000))
+	require.NoError(t, err)
+	require.True(t, coin(9500).IsEqual(emptyDelegation()))
+	scheduled, found = keeper.GetScheduledDelegation(ts.Ctx, client1Addr, provider1Addr, ts.spec.Index, targetEpoch)
+	require.True(t, found)
+	require.True(t, coin(9500).IsEqual(scheduled))
+
+	diff, err := keeper.VerifyDelegatorBalance(ts.Ctx, client1Acct.Addr)
+	require.NoError(t, err)
+	require.True(t, diff.IsZero())
+
+	// the reduced scheduled delegation is applied at its target epoch
+	ts.AdvanceEpochs(2)
+	require.Equal(t, targetEpoch, ts.EpochStart())
+	delegation, found = keeper.GetDelegation(ts.Ctx, client1Addr, provider1Addr, ts.spec.Index, ts.EpochStart())
+	require.True(t, found)
+	require.True(t, coin(9500).IsEqual(delegation.Amount))
+	require.Empty(t, keeper.GetDelegatorScheduledDelegations(ts.Ctx, client1Addr))
+}
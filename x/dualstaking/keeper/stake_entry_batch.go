@@ -0,0 +1,130 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/lavanet/lava/utils"
+	"github.com/lavanet/lava/x/dualstaking/types"
+	epochstoragetypes "github.com/lavanet/lava/x/epochstorage/types"
+)
+
+// A stake entry batch defers the (epochstorage) stake entry updates of several
+// delegation changes, to write each affected stake entry once instead of once per
+// change. The changes are summed per <provider,chainID> (the self delegation's
+// into Stake, the others' into DelegateTotal), and applied like a single increase
+// or decrease of the net amount.
+
+type stakeEntryDelta struct {
+	provider      string
+	chainID       string
+	stake         math.Int
+	delegateTotal math.Int
+	increased     bool
+}
+
+type stakeEntryBatch struct {
+	deltas map[string]*stakeEntryDelta
+	keys   []string // in order of first change
+}
+
+func newStakeEntryBatch() *stakeEntryBatch {
+	return &stakeEntryBatch{deltas: map[string]*stakeEntryDelta{}}
+}
+
+// add records a change of the delegation's amount (negative for a decrease)
+func (b *stakeEntryBatch) add(delegator, provider, chainID string, amount math.Int) {
+	key := provider + " " + chainID
+	delta, ok := b.deltas[key]
+	if !ok {
+		delta = &stakeEntryDelta{provider: provider, chainID: chainID, stake: math.ZeroInt(), delegateTotal: math.ZeroInt()}
+		b.deltas[key] = delta
+		b.keys = append(b.keys, key)
+	}
+	if delegator == provider {
+		delta.stake = delta.stake.Add(amount)
+	} else {
+		delta.delegateTotal = delta.delegateTotal.Add(amount)
+	}
+	if amount.IsPositive() {
+		delta.increased = true
+	}
+}
+
+// applyStakeEntryBatch writes the batch's changes to the (current) stake entries,
+// with one read and one write per stake entry
+func (k Keeper) applyStakeEntryBatch(ctx sdk.Context, b *stakeEntryBatch) error {
+	for _, key := range b.keys {
+		delta := b.deltas[key]
+		if delta.stake.IsZero() && delta.delegateTotal.IsZero() {
+			continue
+		}
+
+		providerAddr, err := sdk.AccAddressFromBech32(delta.provider)
+		if err != nil {
+			// panic:ok: this call was already successful by the caller
+			utils.LavaFormatPanic("applyStakeEntryBatch: invalid provider address", err,
+				utils.Attribute{Key: "provider", Value: delta.provider},
+			)
+		}
+
+		stakeEntry, exists, index := k.epochstorageKeeper.GetStakeEntryByAddressCurrent(ctx, delta.chainID, providerAddr)
+		if !exists {
+			// like decreaseStakeEntryDelegation, decreases of an unstaked provider
+			// are ignored
+			if delta.increased {
+				return epochstoragetypes.ErrProviderNotStaked
+			}
+			continue
+		}
+
+		// sanity check
+		if stakeEntry.Address != delta.provider {
+			return utils.LavaFormatError("critical: batch stake entry update with address mismatch", sdkerrors.ErrInvalidAddress,
+				utils.Attribute{Key: "provider", Value: delta.provider},
+				utils.Attribute{Key: "address", Value: stakeEntry.Address},
+			)
+		}
+
+		if !delta.stake.IsZero() {
+			minStake, err := k.getMinStake(ctx, delta.chainID)
+			if err != nil {
+				return err
+			}
+			belowMinStake := stakeEntry.Stake.IsLT(minStake)
+			stakeEntry.Stake.Amount = stakeEntry.Stake.Amount.Add(delta.stake)
+			if stakeEntry.Stake.IsNegative() {
+				return fmt.Errorf("invalid or insufficient funds: negative stake %s", stakeEntry.Stake)
+			}
+			if delta.stake.IsNegative() {
+				if stakeEntry.Stake.IsLT(minStake) {
+					stakeEntry.Freeze()
+				}
+			} else {
+				if stakeEntry.Stake.IsGTE(minStake) && stakeEntry.IsFrozen() {
+					stakeEntry.UnFreeze(uint64(ctx.BlockHeight()))
+				}
+				if belowMinStake && stakeEntry.Stake.IsGTE(minStake) {
+					details := map[string]string{
+						"provider":  delta.provider,
+						"chain_id":  delta.chainID,
+						"stake":     stakeEntry.Stake.String(),
+						"min_stake": minStake.String(),
+					}
+					utils.LogLavaEvent(ctx, k.Logger(ctx), types.ProviderMinStakeReachedEventName, details, "Provider reached the min stake")
+				}
+			}
+		}
+
+		stakeEntry.DelegateTotal.Amount = stakeEntry.DelegateTotal.Amount.Add(delta.delegateTotal)
+		if stakeEntry.DelegateTotal.IsNegative() {
+			return fmt.Errorf("invalid or insufficient funds: negative delegate total %s", stakeEntry.DelegateTotal)
+		}
+
+		k.epochstorageKeeper.ModifyStakeEntryCurrent(ctx, delta.chainID, stakeEntry, index)
+	}
+
+	return nil
+}
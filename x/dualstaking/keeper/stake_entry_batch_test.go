@@ -0,0 +1,116 @@
+package keeper_test
+
+import (
+	"testing"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	commontypes "github.com/lavanet/lava/common/types"
+	"github.com/lavanet/lava/testutil/common"
+	"github.com/lavanet/lava/x/dualstaking/types"
+	"github.com/stretchr/testify/require"
+)
+
+// writeCountingGasMeter counts the store writes (through the gas they consume)
+type writeCountingGasMeter struct {
+	sdk.GasMeter
+	writes int
+}
+
+func (m *writeCountingGasMeter) ConsumeGas(amount storetypes.Gas, descriptor string) {
+	if descriptor == storetypes.GasWriteCostFlatDesc {
+		m.writes++
+	}
+	m.GasMeter.ConsumeGas(amount, descriptor)
+}
+
+const splitTargets = 20
+
+// setupSplitRedelegation delegates to provider1, to be moved to provider2 in parts
+func setupSplitRedelegation(t *testing.T) (ts *tester, delegator, from string, targets []types.RedelegateTarget) {
+	ts = newTester(t)
+
+	// 1 delegator, 2 provider staked, 0 provider unstaked, 0 provider unstaking
+	ts.setupForDelegation(1, 2, 0, 0)
+
+	_, client1Addr := ts.GetAccount(common.CONSUMER, 0)
+	_, provider1Addr := ts.GetAccount(common.PROVIDER, 0)
+	_, provider2Addr := ts.GetAccount(common.PROVIDER, 1)
+
+	part := sdk.NewCoin(commontypes.TokenDenom, sdk.NewInt(1000))
+	_, err := ts.TxDualstakingDelegate(client1Addr, provider1Addr, ts.spec.Index, part.AddAmount(part.Amount.MulRaw(splitTargets-1)))
+	require.NoError(t, err)
+	ts.AdvanceEpoch()
+
+	for i := 0; i < splitTargets; i++ {
+		targets = append(targets, types.RedelegateTarget{Provider: provider2Addr, ChainID: ts.spec.Index, Amount: part})
+	}
+	return ts, client1Addr, provider1Addr, targets
+}
+
+// redelegateSplitWrites moves the delegation's parts (on a cached context), either
+// one redelegation at a time or with a single split redelegation, and returns the
+// number of store writes and the cached context
+func redelegateSplitWrites(t *testing.T, ts *tester, delegator, from string, targets []types.RedelegateTarget, split bool) (int, sdk.Context) {
+	meter := &writeCountingGasMeter{GasMeter: sdk.NewInfiniteGasMeter()}
+	ctx, _ := ts.Ctx.WithGasMeter(meter).CacheContext()
+
+	if split {
+		err := ts.Keepers.Dualstaking.RedelegateSplit(ctx, delegator, from, ts.spec.Index, targets)
+		require.NoError(t, err)
+	} else {
+		for _, target := range targets {
+			err := ts.Keepers.Dualstaking.Redelegate(ctx, delegator, from, target.Provider, ts.spec.Index, target.ChainID, target.Amount)
+			require.NoError(t, err)
+		}
+	}
+	return meter.writes, ctx
+}
+
+func TestRedelegateSplitStakeEntryWrites(t *testing.T) {
+	ts, delegator, from, targets := setupSplitRedelegation(t)
+
+	sequentialWrites, sequentialCtx := redelegateSplitWrites(t, ts, delegator, from, targets, false)
+	splitWrites, splitCtx := redelegateSplitWrites(t, ts, delegator, from, targets, true)
+
+	// the split writes each of the two stake entries once, rather than once per part
+	require.Less(t, splitWrites, sequentialWrites)
+
+	// with the same outcome
+	for _, provider := range []string{from, targets[0].Provider} {
+		providerAcct := sdk.MustAccAddressFromBech32(provider)
+		sequential, found, _ := ts.Keepers.Epochstorage.GetStakeEntryByAddressCurrent(sequentialCtx, ts.spec.Index, providerAcct)
+		require.True(t, found)
+		split, found, _ := ts.Keepers.Epochstorage.GetStakeEntryByAddressCurrent(splitCtx, ts.spec.Index, providerAcct)
+		require.True(t, found)
+		require.True(t, sequential.Stake.IsEqual(split.Stake))
+		require.True(t, sequential.DelegateTotal.IsEqual(split.DelegateTotal))
+	}
+	stakeEntry, found, _ := ts.Keepers.Epochstorage.GetStakeEntryByAddressCurrent(splitCtx, ts.spec.Index, sdk.MustAccAddressFromBech32(targets[0].Provider))
+	require.True(t, found)
+	require.Equal(t, int64(splitTargets*1000), stakeEntry.DelegateTotal.Amount.Int64())
+}
+
+func BenchmarkRedelegateSplit(b *testing.B) {
+	for _, bench := range []struct {
+		name  string
+		split bool
+	}{
+		{"sequential", false},
+		{"split", true},
+	} {
+		b.Run(bench.name, func(b *testing.B) {
+			// the tester requires a *testing.T
+			t := &testing.T{}
+			ts, delegator, from, targets := setupSplitRedelegation(t)
+
+			b.ResetTimer()
+			writes := 0
+			for i := 0; i < b.N; i++ {
+				n, _ := redelegateSplitWrites(t, ts, delegator, from, targets, bench.split)
+				writes += n
+			}
+			b.ReportMetric(float64(writes)/float64(b.N), "writes/op")
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/x/dualstaking/types"
+	fixationtypes "github.com/lavanet/lava/x/fixationstore/types"
+)
+
+// GetStoreStats returns the entry counts of the delegation and delegator fixation
+// stores, to monitor their growth (e.g. from dust delegations)
+func (k Keeper) GetStoreStats(ctx sdk.Context) types.StoreStats {
+	nextEpoch := k.epochstorageKeeper.GetCurrentNextEpoch(ctx)
+
+	var stats types.StoreStats
+	stats.DelegationEntries, stats.DelegationIndices = countFixationEntries(ctx, &k.delegationFS, nextEpoch, &types.Delegation{})
+	stats.DelegatorEntries, stats.DelegatorIndices = countFixationEntries(ctx, &k.delegatorFS, nextEpoch, &types.Delegator{})
+	return stats
+}
+
+// countFixationEntries counts the fixation store's indices, and those of them with
+// an entry in effect at the block
+func countFixationEntries(ctx sdk.Context, fs *fixationtypes.FixationStore, block uint64, entryData codec.ProtoMarshaler) (entries, indices uint64) {
+	for _, ind := range fs.GetAllEntryIndices(ctx) {
+		indices++
+		if fs.FindEntry(ctx, ind, block, entryData) {
+			entries++
+		}
+	}
+	return entries, indices
+}
+
+// EndBlock updates the store stats telemetry gauges (once per epoch, as counting
+// iterates over the whole stores)
+func (k Keeper) EndBlock(ctx sdk.Context) {
+	if uint64(ctx.BlockHeight()) != k.epochstorageKeeper.GetEpochStart(ctx) {
+		return
+	}
+
+	stats := k.GetStoreStats(ctx)
+	telemetry.ModuleSetGauge(types.ModuleName, float32(stats.DelegationEntries), "delegation_entries")
+	telemetry.ModuleSetGauge(types.ModuleName, float32(stats.DelegationIndices), "delegation_indices")
+	telemetry.ModuleSetGauge(types.ModuleName, float32(stats.DelegatorEntries), "delegator_entries")
+	telemetry.ModuleSetGauge(types.ModuleName, float32(stats.DelegatorIndices), "delegator_indices")
+}
@@ -0,0 +1,56 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	commontypes "github.com/lavanet/lava/common/types"
+	"github.com/lavanet/lava/testutil/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetStoreStats(t *testing.T) {
+	ts := newTester(t)
+
+	// 2 delegators, 1 provider staked, 0 provider unstaked, 0 provider unstaking
+	ts.setupForDelegation(2, 1, 0, 0)
+
+	_, client1Addr := ts.GetAccount(common.CONSUMER, 0)
+	_, client2Addr := ts.GetAccount(common.CONSUMER, 1)
+	_, provider1Addr := ts.GetAccount(common.PROVIDER, 0)
+
+	keeper := ts.Keepers.Dualstaking
+	amount := sdk.NewCoin(commontypes.TokenDenom, sdk.NewInt(10000))
+	before := keeper.GetStoreStats(ts.Ctx)
+
+	for _, client := range []string{client1Addr, client2Addr} {
+		_, err := ts.TxDualstakingDelegate(client, provider1Addr, ts.spec.Index, amount)
+		require.NoError(t, err)
+	}
+	ts.AdvanceEpoch()
+
+	stats := keeper.GetStoreStats(ts.Ctx)
+	require.Equal(t, before.DelegationEntries+2, stats.DelegationEntries)
+	require.Equal(t, before.DelegatorEntries+2, stats.DelegatorEntries)
+	require.Less(t, before.DelegationIndices, stats.DelegationIndices)
+	require.Less(t, before.DelegatorIndices, stats.DelegatorIndices)
+
+	// a full unbond removes the entries (and their indices)
+	_, err := ts.TxDualstakingUnbond(client1Addr, provider1Addr, ts.spec.Index, amount)
+	require.NoError(t, err)
+	ts.AdvanceEpoch()
+
+	unbonded := keeper.GetStoreStats(ts.Ctx)
+	require.Equal(t, stats.DelegationEntries-1, unbonded.DelegationEntries)
+	require.Equal(t, stats.DelegatorEntries-1, unbonded.DelegatorEntries)
+	require.Less(t, unbonded.DelegationIndices, stats.DelegationIndices)
+	require.LessOrEqual(t, unbonded.DelegationEntries, unbonded.DelegationIndices)
+
+	// the query reports the same stats
+	res, err := ts.QueryDualstakingStoreStats()
+	require.NoError(t, err)
+	require.Equal(t, unbonded.DelegationEntries, res.DelegationEntries)
+	require.Equal(t, unbonded.DelegationIndices, res.DelegationIndices)
+	require.Equal(t, unbonded.DelegatorEntries, res.DelegatorEntries)
+	require.Equal(t, unbonded.DelegatorIndices, res.DelegatorIndices)
+}
@@ -0,0 +1,63 @@
+package keeper
+
+import (
+	"math"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/lavanet/lava/utils"
+)
+
+// GetMaturingUnbondings returns the delegator's unbondings that are released within
+// the given window from the current block time. The unbondings are held by the
+// staking module, which releases them by time (rather than by block height), so
+// the window is a duration. Each returned unbonding delegation keeps only its
+// entries that mature within the window.
+func (k Keeper) GetMaturingUnbondings(ctx sdk.Context, delegator string, within time.Duration) ([]stakingtypes.UnbondingDelegation, error) {
+	delegatorAddr, err := sdk.AccAddressFromBech32(delegator)
+	if err != nil {
+		return nil, utils.LavaFormatWarning("invalid delegator address", err,
+			utils.Attribute{Key: "delegator", Value: delegator},
+		)
+	}
+
+	deadline := ctx.BlockTime().Add(within)
+
+	var maturing []stakingtypes.UnbondingDelegation
+	for _, unbonding := range k.stakingKeeper.GetUnbondingDelegations(ctx, delegatorAddr, math.MaxUint16) {
+		var entries []stakingtypes.UnbondingDelegationEntry
+		for _, entry := range unbonding.Entries {
+			if !entry.CompletionTime.After(deadline) {
+				entries = append(entries, entry)
+			}
+		}
+		if len(entries) > 0 {
+			unbonding.Entries = entries
+			maturing = append(maturing, unbonding)
+		}
+	}
+
+	return maturing, nil
+}
+
+// GetDelegatorUnbondingAmount returns the delegator's total amount in the unbonding
+// hold (unbonded but not released yet). The staking module holds the unbondings
+// per validator, so the amount is not attributed to providers or chains.
+func (k Keeper) GetDelegatorUnbondingAmount(ctx sdk.Context, delegator string) (sdk.Coin, error) {
+	delegatorAddr, err := sdk.AccAddressFromBech32(delegator)
+	if err != nil {
+		return sdk.Coin{}, utils.LavaFormatWarning("invalid delegator address", err,
+			utils.Attribute{Key: "delegator", Value: delegator},
+		)
+	}
+
+	amount := sdk.NewCoin(k.stakingKeeper.BondDenom(ctx), sdk.ZeroInt())
+	for _, unbonding := range k.stakingKeeper.GetUnbondingDelegations(ctx, delegatorAddr, math.MaxUint16) {
+		for _, entry := range unbonding.Entries {
+			amount.Amount = amount.Amount.Add(entry.Balance)
+		}
+	}
+
+	return amount, nil
+}
@@ -0,0 +1,72 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/utils"
+	"github.com/lavanet/lava/x/dualstaking/types"
+)
+
+// A delegator may have its delegation rewards sent to a different address than
+// its own (like the distribution module's withdraw address). By default, the
+// rewards are sent to the delegator.
+
+// SetWithdrawAddress sets the address to which the delegator's rewards are sent.
+// Setting it to the delegator itself restores the default.
+func (k Keeper) SetWithdrawAddress(ctx sdk.Context, delegator, withdrawAddr string) error {
+	if _, err := sdk.AccAddressFromBech32(delegator); err != nil {
+		return utils.LavaFormatWarning("invalid delegator address", err,
+			utils.Attribute{Key: "delegator", Value: delegator},
+		)
+	}
+
+	withdrawAcc, err := sdk.AccAddressFromBech32(withdrawAddr)
+	if err != nil {
+		return utils.LavaFormatWarning("invalid withdraw address", types.ErrInvalidWithdrawAddress,
+			utils.Attribute{Key: "delegator", Value: delegator},
+			utils.Attribute{Key: "withdraw_address", Value: withdrawAddr},
+			utils.Attribute{Key: "error", Value: err},
+		)
+	}
+
+	if k.bankKeeper.BlockedAddr(withdrawAcc) {
+		return utils.LavaFormatWarning("withdraw address is not allowed to receive funds", types.ErrInvalidWithdrawAddress,
+			utils.Attribute{Key: "delegator", Value: delegator},
+			utils.Attribute{Key: "withdraw_address", Value: withdrawAddr},
+		)
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.WithdrawAddressPrefix))
+	if withdrawAddr == delegator {
+		store.Delete([]byte(delegator))
+		return nil
+	}
+	store.Set([]byte(delegator), withdrawAcc)
+
+	return nil
+}
+
+// GetWithdrawAddress gets the address to which the delegator's rewards are sent
+func (k Keeper) GetWithdrawAddress(ctx sdk.Context, delegator string) (sdk.AccAddress, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.WithdrawAddressPrefix))
+	if b := store.Get([]byte(delegator)); b != nil {
+		return sdk.AccAddress(b), nil
+	}
+	return sdk.AccAddressFromBech32(delegator)
+}
+
+// GetAllWithdrawAddresses returns all the (non-default) withdraw addresses (for genesis)
+func (k Keeper) GetAllWithdrawAddresses(ctx sdk.Context) []types.WithdrawAddress {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.WithdrawAddressPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	withdrawAddrs := []types.WithdrawAddress{}
+	for ; iterator.Valid(); iterator.Next() {
+		withdrawAddrs = append(withdrawAddrs, types.WithdrawAddress{
+			Delegator:       string(iterator.Key()),
+			WithdrawAddress: sdk.AccAddress(iterator.Value()).String(),
+		})
+	}
+	return withdrawAddrs
+}
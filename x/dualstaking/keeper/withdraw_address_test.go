@@ -0,0 +1,66 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	commontypes "github.com/lavanet/lava/common/types"
+	"github.com/lavanet/lava/testutil/common"
+	"github.com/lavanet/lava/x/dualstaking/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClaimRewardsToWithdrawAddress(t *testing.T) {
+	ts := newTester(t)
+
+	// 2 delegators, 1 provider staked, 0 provider unstaked, 0 provider unstaking
+	ts.setupForDelegation(2, 1, 0, 0)
+
+	client1Acct, client1Addr := ts.GetAccount(common.CONSUMER, 0)
+	client2Acct, client2Addr := ts.GetAccount(common.CONSUMER, 1)
+	_, provider1Addr := ts.GetAccount(common.PROVIDER, 0)
+
+	amount := sdk.NewCoin(commontypes.TokenDenom, sdk.NewInt(10000))
+	_, err := ts.TxDualstakingDelegate(client1Addr, provider1Addr, ts.spec.Index, amount)
+	require.NoError(t, err)
+	ts.AdvanceEpoch()
+
+	// default withdraw address is the delegator
+	withdrawAcc, err := ts.Keepers.Dualstaking.GetWithdrawAddress(ts.Ctx, client1Addr)
+	require.NoError(t, err)
+	require.Equal(t, client1Acct.Addr, withdrawAcc)
+
+	require.Error(t, ts.Keepers.Dualstaking.SetWithdrawAddress(ts.Ctx, client1Addr, "invalid"))
+	_, err = ts.TxDualstakingSetWithdrawAddress(client1Addr, client2Addr)
+	require.NoError(t, err)
+	withdrawAcc, err = ts.Keepers.Dualstaking.GetWithdrawAddress(ts.Ctx, client1Addr)
+	require.NoError(t, err)
+	require.Equal(t, client2Acct.Addr, withdrawAcc)
+
+	// give the delegator a reward to claim
+	reward := sdk.NewCoin(commontypes.TokenDenom, sdk.NewInt(100))
+	ts.Keepers.Dualstaking.SetDelegatorReward(ts.Ctx, types.DelegatorReward{
+		Delegator: client1Addr,
+		Provider:  provider1Addr,
+		ChainId:   ts.spec.Index,
+		Amount:    reward,
+	})
+	err = ts.Keepers.BankKeeper.MintCoins(ts.Ctx, types.ModuleName, sdk.NewCoins(reward))
+	require.NoError(t, err)
+
+	client1Balance := ts.GetBalance(client1Acct.Addr)
+	client2Balance := ts.GetBalance(client2Acct.Addr)
+
+	_, err = ts.TxDualstakingClaimRewards(client1Addr, provider1Addr)
+	require.NoError(t, err)
+
+	require.Equal(t, client1Balance, ts.GetBalance(client1Acct.Addr))
+	require.Equal(t, client2Balance+reward.Amount.Int64(), ts.GetBalance(client2Acct.Addr))
+
+	// setting the delegator itself restores the default
+	_, err = ts.TxDualstakingSetWithdrawAddress(client1Addr, client1Addr)
+	require.NoError(t, err)
+	withdrawAcc, err = ts.Keepers.Dualstaking.GetWithdrawAddress(ts.Ctx, client1Addr)
+	require.NoError(t, err)
+	require.Equal(t, client1Acct.Addr, withdrawAcc)
+}
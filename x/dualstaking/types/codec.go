@@ -14,6 +14,11 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgRedelegate{}, "dualstaking/Redelegate", nil)
 	cdc.RegisterConcrete(&MsgUnbond{}, "dualstaking/Unbond", nil)
 	cdc.RegisterConcrete(&MsgClaimRewards{}, "dualstaking/MsgClaimRewards", nil)
+	cdc.RegisterConcrete(&MsgUpdateDelegatorAllowlist{}, "dualstaking/MsgUpdateDelegatorAllowlist", nil)
+	cdc.RegisterConcrete(&MsgSetWithdrawAddress{}, "dualstaking/MsgSetWithdrawAddress", nil)
+	cdc.RegisterConcrete(&MsgSetAutoCompound{}, "dualstaking/MsgSetAutoCompound", nil)
+	cdc.RegisterConcrete(&MsgDelegateAtEpoch{}, "dualstaking/MsgDelegateAtEpoch", nil)
+	cdc.RegisterConcrete(&MsgForceUnbondDelegator{}, "dualstaking/MsgForceUnbondDelegator", nil)
 	// this line is used by starport scaffolding # 2
 }
 
@@ -30,6 +35,21 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgClaimRewards{},
 	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgUpdateDelegatorAllowlist{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSetWithdrawAddress{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSetAutoCompound{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgDelegateAtEpoch{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgForceUnbondDelegator{},
+	)
 	// this line is used by starport scaffolding # 3
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -3,6 +3,7 @@ package types
 import (
 	"time"
 
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/lavanet/lava/utils"
 	"github.com/lavanet/lava/utils/slices"
@@ -65,3 +66,108 @@ func (delegator *Delegator) DelProvider(provider string) {
 func (delegator *Delegator) IsEmpty() bool {
 	return len(delegator.Providers) == 0
 }
+
+// RankedProvider is a provider's stake on a chain, ranked by its effective stake
+// (self stake plus delegations up to the provider's delegation limit)
+type RankedProvider struct {
+	Address        string
+	Stake          sdk.Coin
+	DelegateTotal  sdk.Coin
+	EffectiveStake math.Int
+}
+
+// DelegateReceipt confirms a delegation: the delegated amount, the resulting
+// delegation of the delegator to the provider on the chain, the epoch it takes
+// effect at, and the provider's resulting delegations total on the chain
+type DelegateReceipt struct {
+	Delegator             string
+	Provider              string
+	ChainID               string
+	Amount                sdk.Coin
+	Delegation            sdk.Coin
+	EffectiveEpoch        uint64
+	ProviderDelegateTotal sdk.Coin
+}
+
+// RatioPoint is a provider's self stake and delegations on a chain at an epoch.
+// Ratio is Stake/DelegateTotal, and is zero when the provider wasn't staked or
+// had no delegations.
+type RatioPoint struct {
+	Epoch         uint64
+	Staked        bool
+	Stake         sdk.Coin
+	DelegateTotal sdk.Coin
+	Ratio         math.LegacyDec
+}
+
+// DelegatorContribution is the total amount a delegator delegates to a provider,
+// summed over all the provider's chains
+type DelegatorContribution struct {
+	Delegator string
+	Amount    sdk.Coin
+}
+
+// ProviderGroup is a delegator's delegations to a single provider (one for each of
+// the provider's chains, sorted by chain) and their subtotal
+type ProviderGroup struct {
+	Provider    string
+	Delegations []Delegation
+	Subtotal    sdk.Coin
+}
+
+// StoreStats are the entry counts of the delegation and delegator fixation stores:
+// the entries in effect at the next epoch, and the indices the stores hold (which
+// also include deleted entries that are not pruned yet)
+type StoreStats struct {
+	DelegationEntries uint64
+	DelegationIndices uint64
+	DelegatorEntries  uint64
+	DelegatorIndices  uint64
+}
+
+// RedelegateTarget is one destination of a split redelegation: the provider and
+// chain to move the amount to
+type RedelegateTarget struct {
+	Provider string
+	ChainID  string
+	Amount   sdk.Coin
+}
+
+type DelegationChangeType int
+
+const (
+	DelegationAdded DelegationChangeType = iota
+	DelegationRemoved
+	DelegationAmountChanged
+)
+
+func (t DelegationChangeType) String() string {
+	switch t {
+	case DelegationAdded:
+		return "added"
+	case DelegationRemoved:
+		return "removed"
+	case DelegationAmountChanged:
+		return "amount_changed"
+	default:
+		return "unknown"
+	}
+}
+
+// DelegationChange describes how a delegation differs between two epochs. Before
+// is zero for added delegations and After is zero for removed ones
+type DelegationChange struct {
+	Delegator string
+	Provider  string
+	ChainID   string
+	Type      DelegationChangeType
+	Before    sdk.Coin
+	After     sdk.Coin
+}
+
+// MaxDelegationTagLength is the maximal length of a delegation's tag
+const MaxDelegationTagLength = 64
+
+// MaxScheduledDelegationsPerBlock is the maximal number of scheduled delegations
+// applied in a single (epoch start) block
+const MaxScheduledDelegationsPerBlock = 100
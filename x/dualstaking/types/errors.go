@@ -13,4 +13,16 @@ var (
 	ErrBadDelegationAmount       = sdkerrors.Register(ModuleName, 1003, "invalid delegation amount")
 	ErrUnbondingInProgress       = sdkerrors.Register(ModuleName, 1004, "unbonding already exists (same block)")
 	ErrCalculatingProviderReward = sdkerrors.Register(ModuleName, 1005, "provider reward calculation failed")
+	ErrRedelegateToSelf          = sdkerrors.Register(ModuleName, 1006, "redelegation source and destination are the same")
+	ErrDelegationLocked          = sdkerrors.Register(ModuleName, 1007, "delegation is locked")
+	ErrHeightPruned              = sdkerrors.Register(ModuleName, 1008, "height is pruned")
+	ErrDelegatorNotAllowed       = sdkerrors.Register(ModuleName, 1009, "delegator is not in the provider's allowlist")
+	ErrInvalidWithdrawAddress    = sdkerrors.Register(ModuleName, 1010, "invalid withdraw address")
+	ErrProviderInactive          = sdkerrors.Register(ModuleName, 1011, "provider is inactive")
+	ErrDelegationBelowMinimum    = sdkerrors.Register(ModuleName, 1012, "delegation is below the minimum delegation")
+	ErrDelegationTagTooLong      = sdkerrors.Register(ModuleName, 1013, "delegation tag is too long")
+	ErrEmptyProviderChainID      = sdkerrors.Register(ModuleName, 1014, "empty provider delegations must use the empty chain ID")
+	ErrInvalidDelegationEpoch    = sdkerrors.Register(ModuleName, 1015, "invalid delegation epoch")
+	ErrProviderMonikerNotFound   = sdkerrors.Register(ModuleName, 1016, "no provider with this moniker on the chain")
+	ErrAmbiguousProviderMoniker  = sdkerrors.Register(ModuleName, 1017, "several providers with this moniker on the chain")
 )
@@ -13,10 +13,19 @@ const DefaultIndex uint64 = 1
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
 		// this line is used by starport scaffolding # genesis/types/default
-		Params:              DefaultParams(),
-		DelegatorRewardList: []DelegatorReward{},
-		DelegationsFS:       *fixationstoretypes.DefaultGenesis(),
-		DelegatorsFS:        *fixationstoretypes.DefaultGenesis(),
+		Params:                  DefaultParams(),
+		DelegatorRewardList:     []DelegatorReward{},
+		ImportedDelegations:     []Delegation{},
+		DelegationLocks:         []DelegationLock{},
+		DelegatorAllowlist:      []DelegatorAllowlistEntry{},
+		WithdrawAddresses:       []WithdrawAddress{},
+		ProviderLastRewards:     []ProviderLastReward{},
+		DelegationTags:          []DelegationTag{},
+		DelegationStartEpochs:   []DelegationStartEpoch{},
+		DelegationAutoCompounds: []DelegationAutoCompound{},
+		ScheduledDelegations:    []ScheduledDelegation{},
+		DelegationsFS:           *fixationstoretypes.DefaultGenesis(),
+		DelegatorsFS:            *fixationstoretypes.DefaultGenesis(),
 	}
 }
 
@@ -33,6 +42,119 @@ func (gs GenesisState) Validate() error {
 		}
 		delegatorRewardIndexMap[index] = struct{}{}
 	}
+
+	// Check for invalid or duplicated imported delegations
+	importedDelegationIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.ImportedDelegations {
+		index := DelegationKey(elem.Provider, elem.Delegator, elem.ChainID)
+		if err := DelegationKeyValidate(index); err != nil {
+			return fmt.Errorf("invalid imported delegation: %w", err)
+		}
+		if _, ok := importedDelegationIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for imported delegation")
+		}
+		importedDelegationIndexMap[index] = struct{}{}
+		if !elem.Amount.IsValid() || !elem.Amount.IsPositive() {
+			return fmt.Errorf("invalid imported delegation amount: %s", elem.Amount)
+		}
+	}
+
+	// Check for duplicated delegation locks
+	delegationLockIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.DelegationLocks {
+		index := DelegationKey(elem.Provider, elem.Delegator, elem.ChainId)
+		if _, ok := delegationLockIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for delegation lock")
+		}
+		delegationLockIndexMap[index] = struct{}{}
+	}
+
+	// Check for duplicated delegator allowlist entries
+	delegatorAllowlistIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.DelegatorAllowlist {
+		index := DelegatorAllowlistKey(elem.Provider, elem.Delegator)
+		if _, ok := delegatorAllowlistIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for delegator allowlist entry")
+		}
+		delegatorAllowlistIndexMap[index] = struct{}{}
+	}
+
+	// Check for duplicated withdraw addresses
+	withdrawAddressIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.WithdrawAddresses {
+		if _, ok := withdrawAddressIndexMap[elem.Delegator]; ok {
+			return fmt.Errorf("duplicated index for withdraw address")
+		}
+		withdrawAddressIndexMap[elem.Delegator] = struct{}{}
+	}
+
+	// Check for duplicated or invalid provider last rewards
+	providerLastRewardIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.ProviderLastRewards {
+		index := ProviderLastRewardKey(elem.Provider, elem.ChainId)
+		if _, ok := providerLastRewardIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for provider last reward")
+		}
+		providerLastRewardIndexMap[index] = struct{}{}
+		if elem.Reward.IsNil() || elem.Reward.IsNegative() {
+			return fmt.Errorf("invalid provider last reward: %s", elem.Reward)
+		}
+	}
+
+	// Check for duplicated or invalid delegation tags
+	delegationTagIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.DelegationTags {
+		index := DelegationKey(elem.Provider, elem.Delegator, elem.ChainId)
+		if _, ok := delegationTagIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for delegation tag")
+		}
+		delegationTagIndexMap[index] = struct{}{}
+		if len(elem.Tag) > MaxDelegationTagLength {
+			return fmt.Errorf("delegation tag is too long: %d", len(elem.Tag))
+		}
+	}
+
+	// Check for duplicated delegation start epochs
+	delegationStartEpochIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.DelegationStartEpochs {
+		index := DelegationKey(elem.Provider, elem.Delegator, elem.ChainId)
+		if _, ok := delegationStartEpochIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for delegation start epoch")
+		}
+		delegationStartEpochIndexMap[index] = struct{}{}
+	}
+
+	// Check for duplicated auto-compound flags
+	delegationAutoCompoundIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.DelegationAutoCompounds {
+		index := DelegationKey(elem.Provider, elem.Delegator, elem.ChainId)
+		if _, ok := delegationAutoCompoundIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for delegation auto-compound")
+		}
+		delegationAutoCompoundIndexMap[index] = struct{}{}
+	}
+
+	// Check for invalid or duplicated scheduled delegations
+	scheduledDelegationIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.ScheduledDelegations {
+		index := string(ScheduledDelegationKey(elem.Epoch, elem.Provider, elem.Delegator, elem.ChainId))
+		if _, ok := scheduledDelegationIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for scheduled delegation")
+		}
+		scheduledDelegationIndexMap[index] = struct{}{}
+		if !elem.Amount.IsValid() || !elem.Amount.IsPositive() {
+			return fmt.Errorf("invalid scheduled delegation amount: %s", elem.Amount)
+		}
+	}
 	// this line is used by starport scaffolding # genesis/types/validate
 
 	return gs.Params.Validate()
@@ -3,11 +3,18 @@ package types_test
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	commontypes "github.com/lavanet/lava/common/types"
+	"github.com/lavanet/lava/testutil/sample"
 	"github.com/lavanet/lava/x/dualstaking/types"
 	"github.com/stretchr/testify/require"
 )
 
 func TestGenesisState_Validate(t *testing.T) {
+	provider := sample.AccAddress()
+	delegator := sample.AccAddress()
+	amount := sdk.NewCoin(commontypes.TokenDenom, sdk.NewInt(100))
+
 	for _, tc := range []struct {
 		desc     string
 		genState *types.GenesisState
@@ -21,6 +28,7 @@ func TestGenesisState_Validate(t *testing.T) {
 		{
 			desc: "valid genesis state",
 			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
 				DelegatorRewardList: []types.DelegatorReward{
 					{
 						Provider:  "p0",
@@ -55,6 +63,134 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			desc: "valid imported delegations",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				ImportedDelegations: []types.Delegation{
+					{Provider: provider, Delegator: delegator, ChainID: "c0", Amount: amount},
+					{Provider: provider, Delegator: delegator, ChainID: "c1", Amount: amount},
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "duplicated imported delegation",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				ImportedDelegations: []types.Delegation{
+					{Provider: provider, Delegator: delegator, ChainID: "c0", Amount: amount},
+					{Provider: provider, Delegator: delegator, ChainID: "c0", Amount: amount},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid imported delegation",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				ImportedDelegations: []types.Delegation{
+					{Provider: provider, Delegator: "d0", ChainID: "c0", Amount: amount},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "zero imported delegation",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				ImportedDelegations: []types.Delegation{
+					{Provider: provider, Delegator: delegator, ChainID: "c0", Amount: sdk.NewCoin(commontypes.TokenDenom, sdk.ZeroInt())},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated delegation lock",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				DelegationLocks: []types.DelegationLock{
+					{Delegator: delegator, Provider: provider, ChainId: "c0", LockUntil: 100},
+					{Delegator: delegator, Provider: provider, ChainId: "c0", LockUntil: 200},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated delegator allowlist entry",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				DelegatorAllowlist: []types.DelegatorAllowlistEntry{
+					{Provider: provider, Delegator: delegator},
+					{Provider: provider, Delegator: delegator},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated withdraw address",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				WithdrawAddresses: []types.WithdrawAddress{
+					{Delegator: delegator, WithdrawAddress: provider},
+					{Delegator: delegator, WithdrawAddress: delegator},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "negative provider last reward",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				ProviderLastRewards: []types.ProviderLastReward{
+					{Provider: provider, ChainId: "c0", Reward: sdk.NewInt(-1)},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated delegation tag",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				DelegationTags: []types.DelegationTag{
+					{Delegator: delegator, Provider: provider, ChainId: "c0", Tag: "a"},
+					{Delegator: delegator, Provider: provider, ChainId: "c0", Tag: "b"},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated delegation start epoch",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				DelegationStartEpochs: []types.DelegationStartEpoch{
+					{Delegator: delegator, Provider: provider, ChainId: "c0", Epoch: 20},
+					{Delegator: delegator, Provider: provider, ChainId: "c0", Epoch: 40},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated delegation auto-compound",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				DelegationAutoCompounds: []types.DelegationAutoCompound{
+					{Delegator: delegator, Provider: provider, ChainId: "c0"},
+					{Delegator: delegator, Provider: provider, ChainId: "c0"},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "zero scheduled delegation",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				ScheduledDelegations: []types.ScheduledDelegation{
+					{Delegator: delegator, Provider: provider, ChainId: "c0", Epoch: 60, Amount: sdk.NewCoin(commontypes.TokenDenom, sdk.ZeroInt())},
+				},
+			},
+			valid: false,
+		},
 		// this line is used by starport scaffolding # types/genesis/testcase
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
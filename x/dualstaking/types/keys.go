@@ -1,7 +1,11 @@
 package types
 
 import (
+	"encoding/binary"
+	"fmt"
 	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 const (
@@ -32,6 +36,42 @@ const (
 
 	// DisableDualstakingHooks prefix
 	DisableDualstakingHookPrefix = "disable-dualstaking-hooks"
+
+	// prefix for the delegation locks store
+	DelegationLockPrefix = "delegation-lock"
+
+	// prefix for the flag of ignoring the delegation locks (during forced unbonds)
+	IgnoreDelegationLocksPrefix = "ignore-delegation-locks"
+
+	// prefix for the providers' delegator allowlists store
+	DelegatorAllowlistPrefix = "delegator-allowlist"
+
+	// prefix for the delegators' reward withdrawal addresses store
+	WithdrawAddressPrefix = "withdraw-address"
+
+	// prefix for the providers' last reward store
+	ProviderLastRewardPrefix = "provider-last-reward"
+
+	// prefix for the delegation tags store
+	DelegationTagPrefix = "delegation-tag"
+
+	// prefix for the delegations' start epochs store
+	DelegationStartEpochPrefix = "delegation-start-epoch"
+
+	// prefix for the delegators' per chain totals store
+	DelegatorChainTotalPrefix = "delegator-chain-total"
+
+	// prefix for the delegations' auto-compound flags store
+	DelegationAutoCompoundPrefix = "delegation-auto-compound"
+
+	// prefix for the scheduled delegations store
+	ScheduledDelegationPrefix = "scheduled-delegation"
+
+	// prefix for the scheduled delegations by delegator index
+	ScheduledDelegationByDelegatorPrefix = "scheduled-delegation-by-delegator"
+
+	// prefix for the genesis imported delegations (pending the first BeginBlock)
+	PendingImportedDelegationsPrefix = "pending-imported-delegations"
 )
 
 func KeyPrefix(p string) []byte {
@@ -46,11 +86,92 @@ func DelegationKey(provider, delegator, chainID string) string {
 	return provider + " " + delegator + " " + chainID
 }
 
+// DelegationKeyDecode returns the provider, delegator and chainID of a Delegation
+// entry key, such that DelegationKey(DelegationKeyDecode(key)) == key. The key must
+// be well-formed (see DelegationKeyValidate).
 func DelegationKeyDecode(prefix string) (provider, delegator, chainID string) {
 	split := strings.Split(prefix, " ")
 	return split[0], split[1], split[2]
 }
 
+// DelegationKeyValidate checks that a (raw) Delegation entry key is well-formed:
+// it has exactly three parts, valid provider (or the empty provider) and delegator
+// addresses, and a chainID that is empty only for the empty provider.
+func DelegationKeyValidate(key string) error {
+	split := strings.Split(key, " ")
+	if len(split) != 3 {
+		return fmt.Errorf("invalid delegation key %q: expected 3 parts, got %d", key, len(split))
+	}
+	provider, delegator, chainID := split[0], split[1], split[2]
+
+	if provider != EMPTY_PROVIDER {
+		if _, err := sdk.AccAddressFromBech32(provider); err != nil {
+			return fmt.Errorf("invalid delegation key %q: invalid provider address: %w", key, err)
+		}
+	}
+	if _, err := sdk.AccAddressFromBech32(delegator); err != nil {
+		return fmt.Errorf("invalid delegation key %q: invalid delegator address: %w", key, err)
+	}
+	if (chainID == EMPTY_PROVIDER_CHAINID) != (provider == EMPTY_PROVIDER) {
+		return fmt.Errorf("invalid delegation key %q: chain ID %q does not match provider %s", key, chainID, provider)
+	}
+	return nil
+}
+
+// DelegatorAllowlistKey returns the key/prefix for a delegator in the provider's
+// allowlist (with an empty delegator, the prefix of the provider's whole allowlist)
+func DelegatorAllowlistKey(provider, delegator string) string {
+	return provider + " " + delegator
+}
+
+// DelegatorChainTotalKey returns the key for the delegator's total on a chain (with
+// an empty chainID, the prefix of all the delegator's totals)
+func DelegatorChainTotalKey(delegator, chainID string) string {
+	return delegator + " " + chainID
+}
+
+func DelegatorChainTotalKeyDecode(key string) (delegator, chainID string) {
+	split := strings.SplitN(key, " ", 2)
+	return split[0], split[1]
+}
+
+// ProviderLastRewardKey returns the key for the provider's last reward on a chain
+func ProviderLastRewardKey(provider, chainID string) string {
+	return provider + " " + chainID
+}
+
+func ProviderLastRewardKeyDecode(key string) (provider, chainID string) {
+	split := strings.SplitN(key, " ", 2)
+	return split[0], split[1]
+}
+
+// ExtensionStakeRequirementKey returns the key identifying the stake requirement
+// of a chain's extension
+func ExtensionStakeRequirementKey(chainID, extension string) string {
+	return chainID + " " + extension
+}
+
+// ScheduledDelegationKey returns the key for a delegation scheduled for an epoch
+// (ordered by epoch, so the due delegations are iterated first)
+func ScheduledDelegationKey(epoch uint64, provider, delegator, chainID string) []byte {
+	key := make([]byte, 8, 8+len(provider)+len(delegator)+len(chainID)+2)
+	binary.BigEndian.PutUint64(key, epoch)
+	return append(key, []byte(DelegationKey(provider, delegator, chainID))...)
+}
+
+// ScheduledDelegationKeyDecode returns the epoch, provider, delegator and chainID of
+// a scheduled delegation key
+func ScheduledDelegationKeyDecode(key []byte) (epoch uint64, provider, delegator, chainID string) {
+	provider, delegator, chainID = DelegationKeyDecode(string(key[8:]))
+	return binary.BigEndian.Uint64(key[:8]), provider, delegator, chainID
+}
+
+// ScheduledDelegationByDelegatorKey returns the key indexing a scheduled delegation
+// by its delegator (with an empty scheduled key, the prefix of all the delegator's)
+func ScheduledDelegationByDelegatorKey(delegator string, scheduledKey []byte) []byte {
+	return append([]byte(delegator+" "), scheduledKey...)
+}
+
 // DelegatorKey returns the key/prefix for the Delegator entry in fixation store.
 func DelegatorKey(delegator string) string {
 	return delegator
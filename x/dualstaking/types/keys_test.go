@@ -0,0 +1,52 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/lavanet/lava/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDelegationKeyRoundTrip(t *testing.T) {
+	provider := sample.AccAddress()
+	delegator := sample.AccAddress()
+
+	tests := []struct {
+		name      string
+		provider  string
+		delegator string
+		chainID   string
+		valid     bool
+	}{
+		{"provider delegation", provider, delegator, "mockspec", true},
+		{"self delegation", provider, provider, "mockspec", true},
+		{"empty provider", EMPTY_PROVIDER, delegator, EMPTY_PROVIDER_CHAINID, true},
+		{"empty chainID", provider, delegator, "", false},
+		{"empty provider with chainID", EMPTY_PROVIDER, delegator, "mockspec", false},
+		{"invalid provider", "invalid", delegator, "mockspec", false},
+		{"invalid delegator", provider, "invalid", "mockspec", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key := DelegationKey(tt.provider, tt.delegator, tt.chainID)
+			p, d, c := DelegationKeyDecode(key)
+			require.Equal(t, tt.provider, p)
+			require.Equal(t, tt.delegator, d)
+			require.Equal(t, tt.chainID, c)
+			require.Equal(t, key, DelegationKey(p, d, c))
+
+			err := DelegationKeyValidate(key)
+			if tt.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+
+	// keys without exactly three parts
+	for _, key := range []string{"", provider, provider + " " + delegator, DelegationKey(provider, delegator, "mockspec") + " extra"} {
+		require.Error(t, DelegationKeyValidate(key), key)
+	}
+}
@@ -0,0 +1,60 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	legacyerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgDelegateAtEpoch = "delegate_at_epoch"
+
+var _ sdk.Msg = &MsgDelegateAtEpoch{}
+
+func NewMsgDelegateAtEpoch(delegator string, provider string, chainID string, amount sdk.Coin, targetEpoch uint64) *MsgDelegateAtEpoch {
+	return &MsgDelegateAtEpoch{
+		Creator:     delegator,
+		Provider:    provider,
+		ChainID:     chainID,
+		Amount:      amount,
+		TargetEpoch: targetEpoch,
+	}
+}
+
+func (msg *MsgDelegateAtEpoch) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgDelegateAtEpoch) Type() string {
+	return TypeMsgDelegateAtEpoch
+}
+
+func (msg *MsgDelegateAtEpoch) GetSigners() []sdk.AccAddress {
+	delegator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{delegator}
+}
+
+func (msg *MsgDelegateAtEpoch) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgDelegateAtEpoch) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(legacyerrors.ErrInvalidAddress, "invalid delegator address (%s)", err)
+	}
+
+	_, err = sdk.AccAddressFromBech32(msg.Provider)
+	if err != nil {
+		return sdkerrors.Wrapf(legacyerrors.ErrInvalidAddress, "invalid provider address (%s)", err)
+	}
+
+	if !msg.Amount.IsValid() {
+		return legacyerrors.ErrInvalidCoins
+	}
+
+	return nil
+}
@@ -0,0 +1,68 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	legacyerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	commontypes "github.com/lavanet/lava/common/types"
+	"github.com/lavanet/lava/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgDelegateAtEpoch_ValidateBasic(t *testing.T) {
+	oneCoin := sdk.NewCoin(commontypes.TokenDenom, sdk.OneInt())
+
+	tests := []struct {
+		name string
+		msg  MsgDelegateAtEpoch
+		err  error
+	}{
+		{
+			name: "invalid delegator address",
+			msg: MsgDelegateAtEpoch{
+				Creator:     "invalid_address",
+				Provider:    sample.AccAddress(),
+				Amount:      oneCoin,
+				TargetEpoch: 100,
+			},
+			err: legacyerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid provider address",
+			msg: MsgDelegateAtEpoch{
+				Creator:     sample.AccAddress(),
+				Provider:    EMPTY_PROVIDER,
+				Amount:      oneCoin,
+				TargetEpoch: 100,
+			},
+			err: legacyerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid amount",
+			msg: MsgDelegateAtEpoch{
+				Creator:     sample.AccAddress(),
+				Provider:    sample.AccAddress(),
+				Amount:      sdk.Coin{Denom: "", Amount: sdk.OneInt()},
+				TargetEpoch: 100,
+			},
+			err: legacyerrors.ErrInvalidCoins,
+		}, {
+			name: "valid message",
+			msg: MsgDelegateAtEpoch{
+				Creator:     sample.AccAddress(),
+				Provider:    sample.AccAddress(),
+				Amount:      oneCoin,
+				TargetEpoch: 100,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
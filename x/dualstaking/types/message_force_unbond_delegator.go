@@ -0,0 +1,54 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	legacyerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgForceUnbondDelegator = "force_unbond_delegator"
+
+var _ sdk.Msg = &MsgForceUnbondDelegator{}
+
+func NewMsgForceUnbondDelegator(authority string, delegator string, skipHoldPeriod bool) *MsgForceUnbondDelegator {
+	return &MsgForceUnbondDelegator{
+		Authority:      authority,
+		Delegator:      delegator,
+		SkipHoldPeriod: skipHoldPeriod,
+	}
+}
+
+func (msg *MsgForceUnbondDelegator) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgForceUnbondDelegator) Type() string {
+	return TypeMsgForceUnbondDelegator
+}
+
+func (msg *MsgForceUnbondDelegator) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{authority}
+}
+
+func (msg *MsgForceUnbondDelegator) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgForceUnbondDelegator) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return sdkerrors.Wrapf(legacyerrors.ErrInvalidAddress, "invalid authority address (%s)", err)
+	}
+
+	_, err = sdk.AccAddressFromBech32(msg.Delegator)
+	if err != nil {
+		return sdkerrors.Wrapf(legacyerrors.ErrInvalidAddress, "invalid delegator address (%s)", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,50 @@
+package types
+
+import (
+	"testing"
+
+	legacyerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/lavanet/lava/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgForceUnbondDelegator_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgForceUnbondDelegator
+		err  error
+	}{
+		{
+			name: "invalid authority address",
+			msg: MsgForceUnbondDelegator{
+				Authority: "invalid_address",
+				Delegator: sample.AccAddress(),
+			},
+			err: legacyerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid delegator address",
+			msg: MsgForceUnbondDelegator{
+				Authority: sample.AccAddress(),
+				Delegator: "invalid_address",
+			},
+			err: legacyerrors.ErrInvalidAddress,
+		}, {
+			name: "valid addresses",
+			msg: MsgForceUnbondDelegator{
+				Authority:      sample.AccAddress(),
+				Delegator:      sample.AccAddress(),
+				SkipHoldPeriod: true,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -62,6 +62,11 @@ func (msg *MsgRedelegate) ValidateBasic() error {
 		}
 	}
 
+	if msg.FromProvider == msg.ToProvider &&
+		(msg.FromChainID == msg.ToChainID || msg.FromProvider == EMPTY_PROVIDER) {
+		return sdkerrors.Wrapf(ErrRedelegateToSelf, "provider %s chainID %s", msg.ToProvider, msg.ToChainID)
+	}
+
 	if !msg.Amount.IsValid() {
 		return legacyerrors.ErrInvalidCoins
 	}
@@ -11,6 +11,7 @@ import (
 
 func TestMsgRedelegate_ValidateBasic(t *testing.T) {
 	oneCoin := sdk.NewCoin("utest", sdk.NewInt(1))
+	provider := sample.AccAddress()
 
 	tests := []struct {
 		name string
@@ -60,6 +61,38 @@ func TestMsgRedelegate_ValidateBasic(t *testing.T) {
 				ToChainID:    EMPTY_PROVIDER_CHAINID,
 			},
 			err: legacyerrors.ErrInvalidCoins,
+		}, {
+			name: "same provider and chain",
+			msg: MsgRedelegate{
+				Creator:      sample.AccAddress(),
+				FromProvider: provider,
+				ToProvider:   provider,
+				Amount:       oneCoin,
+				FromChainID:  "mockspec",
+				ToChainID:    "mockspec",
+			},
+			err: ErrRedelegateToSelf,
+		}, {
+			name: "same provider different chains",
+			msg: MsgRedelegate{
+				Creator:      sample.AccAddress(),
+				FromProvider: provider,
+				ToProvider:   provider,
+				Amount:       oneCoin,
+				FromChainID:  "mockspec",
+				ToChainID:    "mockspec2",
+			},
+		}, {
+			name: "empty provider to empty provider",
+			msg: MsgRedelegate{
+				Creator:      sample.AccAddress(),
+				FromProvider: EMPTY_PROVIDER,
+				ToProvider:   EMPTY_PROVIDER,
+				Amount:       oneCoin,
+				FromChainID:  EMPTY_PROVIDER_CHAINID,
+				ToChainID:    "mockspec",
+			},
+			err: ErrRedelegateToSelf,
 		},
 	}
 	for _, tt := range tests {
@@ -0,0 +1,53 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	legacyerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgSetWithdrawAddress = "set_withdraw_address"
+
+var _ sdk.Msg = &MsgSetWithdrawAddress{}
+
+func NewMsgSetWithdrawAddress(delegator string, withdrawAddr string) *MsgSetWithdrawAddress {
+	return &MsgSetWithdrawAddress{
+		Creator:         delegator,
+		WithdrawAddress: withdrawAddr,
+	}
+}
+
+func (msg *MsgSetWithdrawAddress) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSetWithdrawAddress) Type() string {
+	return TypeMsgSetWithdrawAddress
+}
+
+func (msg *MsgSetWithdrawAddress) GetSigners() []sdk.AccAddress {
+	delegator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{delegator}
+}
+
+func (msg *MsgSetWithdrawAddress) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSetWithdrawAddress) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(legacyerrors.ErrInvalidAddress, "invalid delegator address (%s)", err)
+	}
+
+	_, err = sdk.AccAddressFromBech32(msg.WithdrawAddress)
+	if err != nil {
+		return sdkerrors.Wrapf(legacyerrors.ErrInvalidAddress, "invalid withdraw address (%s)", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,49 @@
+package types
+
+import (
+	"testing"
+
+	legacyerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/lavanet/lava/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgSetWithdrawAddress_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgSetWithdrawAddress
+		err  error
+	}{
+		{
+			name: "invalid delegator address",
+			msg: MsgSetWithdrawAddress{
+				Creator:         "invalid_address",
+				WithdrawAddress: sample.AccAddress(),
+			},
+			err: legacyerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid withdraw address",
+			msg: MsgSetWithdrawAddress{
+				Creator:         sample.AccAddress(),
+				WithdrawAddress: "invalid_address",
+			},
+			err: legacyerrors.ErrInvalidAddress,
+		}, {
+			name: "valid addresses",
+			msg: MsgSetWithdrawAddress{
+				Creator:         sample.AccAddress(),
+				WithdrawAddress: sample.AccAddress(),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
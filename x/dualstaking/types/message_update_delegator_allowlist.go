@@ -0,0 +1,62 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	legacyerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgUpdateDelegatorAllowlist = "update_delegator_allowlist"
+
+var _ sdk.Msg = &MsgUpdateDelegatorAllowlist{}
+
+func NewMsgUpdateDelegatorAllowlist(provider string, add []string, remove []string) *MsgUpdateDelegatorAllowlist {
+	return &MsgUpdateDelegatorAllowlist{
+		Creator: provider,
+		Add:     add,
+		Remove:  remove,
+	}
+}
+
+func (msg *MsgUpdateDelegatorAllowlist) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgUpdateDelegatorAllowlist) Type() string {
+	return TypeMsgUpdateDelegatorAllowlist
+}
+
+func (msg *MsgUpdateDelegatorAllowlist) GetSigners() []sdk.AccAddress {
+	provider, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{provider}
+}
+
+func (msg *MsgUpdateDelegatorAllowlist) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgUpdateDelegatorAllowlist) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(legacyerrors.ErrInvalidAddress, "invalid provider address (%s)", err)
+	}
+
+	if len(msg.Add) == 0 && len(msg.Remove) == 0 {
+		return sdkerrors.Wrapf(legacyerrors.ErrInvalidRequest, "no delegators to add or remove")
+	}
+
+	for _, delegators := range [][]string{msg.Add, msg.Remove} {
+		for _, delegator := range delegators {
+			_, err = sdk.AccAddressFromBech32(delegator)
+			if err != nil {
+				return sdkerrors.Wrapf(legacyerrors.ErrInvalidAddress, "invalid delegator address (%s)", err)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,56 @@
+package types
+
+import (
+	"testing"
+
+	legacyerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/lavanet/lava/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgUpdateDelegatorAllowlist_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgUpdateDelegatorAllowlist
+		err  error
+	}{
+		{
+			name: "invalid provider address",
+			msg: MsgUpdateDelegatorAllowlist{
+				Creator: "invalid_address",
+				Add:     []string{sample.AccAddress()},
+			},
+			err: legacyerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid delegator address",
+			msg: MsgUpdateDelegatorAllowlist{
+				Creator: sample.AccAddress(),
+				Remove:  []string{"invalid_address"},
+			},
+			err: legacyerrors.ErrInvalidAddress,
+		}, {
+			name: "no delegators",
+			msg: MsgUpdateDelegatorAllowlist{
+				Creator: sample.AccAddress(),
+			},
+			err: legacyerrors.ErrInvalidRequest,
+		}, {
+			name: "valid addresses",
+			msg: MsgUpdateDelegatorAllowlist{
+				Creator: sample.AccAddress(),
+				Add:     []string{sample.AccAddress()},
+				Remove:  []string{sample.AccAddress()},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
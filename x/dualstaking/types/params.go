@@ -1,10 +1,44 @@
 package types
 
 import (
+	fmt "fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	spectypes "github.com/lavanet/lava/x/spec/types"
 	"gopkg.in/yaml.v2"
 )
 
+var (
+	KeyRejectInactiveProviders          = []byte("RejectInactiveProviders")
+	DefaultRejectInactiveProviders bool = false
+)
+
+var (
+	KeyMinDelegation     = []byte("MinDelegation")
+	DefaultMinDelegation = sdk.ZeroInt()
+)
+
+var (
+	KeyLoyaltyMaxMultiplier             = []byte("LoyaltyMaxMultiplier")
+	DefaultLoyaltyMaxMultiplier sdk.Dec = sdk.OneDec()
+)
+
+var (
+	KeyLoyaltyRampEpochs            = []byte("LoyaltyRampEpochs")
+	DefaultLoyaltyRampEpochs uint64 = 100
+)
+
+var (
+	KeyProvidersTypeMinStakes                             = []byte("ProvidersTypeMinStakes")
+	DefaultProvidersTypeMinStakes []ProvidersTypeMinStake = []ProvidersTypeMinStake{}
+)
+
+var (
+	KeyExtensionStakeRequirements                                 = []byte("ExtensionStakeRequirements")
+	DefaultExtensionStakeRequirements []ExtensionStakeRequirement = []ExtensionStakeRequirement{}
+)
+
 var _ paramtypes.ParamSet = (*Params)(nil)
 
 // ParamKeyTable the param key table for launch module
@@ -13,22 +47,74 @@ func ParamKeyTable() paramtypes.KeyTable {
 }
 
 // NewParams creates a new Params instance
-func NewParams() Params {
-	return Params{}
+func NewParams(
+	rejectInactiveProviders bool,
+	minDelegation sdk.Int,
+	loyaltyMaxMultiplier sdk.Dec,
+	loyaltyRampEpochs uint64,
+	providersTypeMinStakes []ProvidersTypeMinStake,
+	extensionStakeRequirements []ExtensionStakeRequirement,
+) Params {
+	return Params{
+		RejectInactiveProviders:    rejectInactiveProviders,
+		MinDelegation:              minDelegation,
+		LoyaltyMaxMultiplier:       loyaltyMaxMultiplier,
+		LoyaltyRampEpochs:          loyaltyRampEpochs,
+		ProvidersTypeMinStakes:     providersTypeMinStakes,
+		ExtensionStakeRequirements: extensionStakeRequirements,
+	}
 }
 
 // DefaultParams returns a default set of parameters
 func DefaultParams() Params {
-	return NewParams()
+	return NewParams(
+		DefaultRejectInactiveProviders,
+		DefaultMinDelegation,
+		DefaultLoyaltyMaxMultiplier,
+		DefaultLoyaltyRampEpochs,
+		DefaultProvidersTypeMinStakes,
+		DefaultExtensionStakeRequirements,
+	)
 }
 
 // ParamSetPairs get the params.ParamSet
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
-	return paramtypes.ParamSetPairs{}
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyRejectInactiveProviders, &p.RejectInactiveProviders, validateRejectInactiveProviders),
+		paramtypes.NewParamSetPair(KeyMinDelegation, &p.MinDelegation, validateMinDelegation),
+		paramtypes.NewParamSetPair(KeyLoyaltyMaxMultiplier, &p.LoyaltyMaxMultiplier, validateLoyaltyMaxMultiplier),
+		paramtypes.NewParamSetPair(KeyLoyaltyRampEpochs, &p.LoyaltyRampEpochs, validateLoyaltyRampEpochs),
+		paramtypes.NewParamSetPair(KeyProvidersTypeMinStakes, &p.ProvidersTypeMinStakes, validateProvidersTypeMinStakes),
+		paramtypes.NewParamSetPair(KeyExtensionStakeRequirements, &p.ExtensionStakeRequirements, validateExtensionStakeRequirements),
+	}
 }
 
 // Validate validates the set of params
 func (p Params) Validate() error {
+	if err := validateRejectInactiveProviders(p.RejectInactiveProviders); err != nil {
+		return err
+	}
+
+	if err := validateMinDelegation(p.MinDelegation); err != nil {
+		return err
+	}
+
+	if err := validateLoyaltyMaxMultiplier(p.LoyaltyMaxMultiplier); err != nil {
+		return err
+	}
+
+	if err := validateLoyaltyRampEpochs(p.LoyaltyRampEpochs); err != nil {
+		return err
+	}
+
+	if err := validateProvidersTypeMinStakes(p.ProvidersTypeMinStakes); err != nil {
+		return err
+	}
+
+	if err := validateExtensionStakeRequirements(p.ExtensionStakeRequirements); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -37,3 +123,98 @@ func (p Params) String() string {
 	out, _ := yaml.Marshal(p)
 	return string(out)
 }
+
+func validateRejectInactiveProviders(v interface{}) error {
+	_, ok := v.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return nil
+}
+
+func validateMinDelegation(v interface{}) error {
+	minDelegation, ok := v.(sdk.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if minDelegation.IsNil() || minDelegation.IsNegative() {
+		return fmt.Errorf("invalid parameter minDelegation - must be non-negative: %s", minDelegation)
+	}
+
+	return nil
+}
+
+func validateLoyaltyMaxMultiplier(v interface{}) error {
+	loyaltyMaxMultiplier, ok := v.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if loyaltyMaxMultiplier.IsNil() || loyaltyMaxMultiplier.LT(sdk.OneDec()) {
+		return fmt.Errorf("invalid parameter loyaltyMaxMultiplier - must be at least 1: %s", loyaltyMaxMultiplier)
+	}
+
+	return nil
+}
+
+func validateLoyaltyRampEpochs(v interface{}) error {
+	loyaltyRampEpochs, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if loyaltyRampEpochs == 0 {
+		return fmt.Errorf("invalid parameter loyaltyRampEpochs - must be positive")
+	}
+
+	return nil
+}
+
+func validateProvidersTypeMinStakes(v interface{}) error {
+	providersTypeMinStakes, ok := v.([]ProvidersTypeMinStake)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	seen := map[spectypes.Spec_ProvidersTypes]struct{}{}
+	for _, entry := range providersTypeMinStakes {
+		if _, ok := spectypes.Spec_ProvidersTypes_name[int32(entry.ProvidersType)]; !ok {
+			return fmt.Errorf("invalid parameter providersTypeMinStakes - unknown providers type: %d", entry.ProvidersType)
+		}
+		if _, ok := seen[entry.ProvidersType]; ok {
+			return fmt.Errorf("invalid parameter providersTypeMinStakes - duplicate providers type: %s", entry.ProvidersType)
+		}
+		seen[entry.ProvidersType] = struct{}{}
+		if err := entry.MinStake.Validate(); err != nil {
+			return fmt.Errorf("invalid parameter providersTypeMinStakes - invalid min stake of %s: %w", entry.ProvidersType, err)
+		}
+	}
+
+	return nil
+}
+
+func validateExtensionStakeRequirements(v interface{}) error {
+	extensionStakeRequirements, ok := v.([]ExtensionStakeRequirement)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	seen := map[string]struct{}{}
+	for _, entry := range extensionStakeRequirements {
+		if entry.ChainId == "" || entry.Extension == "" {
+			return fmt.Errorf("invalid parameter extensionStakeRequirements - empty chain ID or extension: %q/%q", entry.ChainId, entry.Extension)
+		}
+		key := ExtensionStakeRequirementKey(entry.ChainId, entry.Extension)
+		if _, ok := seen[key]; ok {
+			return fmt.Errorf("invalid parameter extensionStakeRequirements - duplicate extension %s of %s", entry.Extension, entry.ChainId)
+		}
+		seen[key] = struct{}{}
+		if err := entry.Requirement.Validate(); err != nil {
+			return fmt.Errorf("invalid parameter extensionStakeRequirements - invalid requirement of extension %s of %s: %w", entry.Extension, entry.ChainId, err)
+		}
+	}
+
+	return nil
+}
@@ -5,8 +5,11 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	types "github.com/lavanet/lava/x/spec/types"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -25,6 +28,12 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Params defines the parameters for the module.
 type Params struct {
+	RejectInactiveProviders    bool                                   `protobuf:"varint,1,opt,name=reject_inactive_providers,json=rejectInactiveProviders,proto3" json:"reject_inactive_providers,omitempty" yaml:"reject_inactive_providers"`
+	MinDelegation              github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=min_delegation,json=minDelegation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_delegation" yaml:"min_delegation"`
+	LoyaltyMaxMultiplier       github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=loyalty_max_multiplier,json=loyaltyMaxMultiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"loyalty_max_multiplier" yaml:"loyalty_max_multiplier"`
+	LoyaltyRampEpochs          uint64                                 `protobuf:"varint,4,opt,name=loyalty_ramp_epochs,json=loyaltyRampEpochs,proto3" json:"loyalty_ramp_epochs,omitempty" yaml:"loyalty_ramp_epochs"`
+	ProvidersTypeMinStakes     []ProvidersTypeMinStake                `protobuf:"bytes,5,rep,name=providers_type_min_stakes,json=providersTypeMinStakes,proto3" json:"providers_type_min_stakes" yaml:"providers_type_min_stakes"`
+	ExtensionStakeRequirements []ExtensionStakeRequirement            `protobuf:"bytes,6,rep,name=extension_stake_requirements,json=extensionStakeRequirements,proto3" json:"extension_stake_requirements" yaml:"extension_stake_requirements"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -59,8 +68,152 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+func (m *Params) GetRejectInactiveProviders() bool {
+	if m != nil {
+		return m.RejectInactiveProviders
+	}
+	return false
+}
+
+func (m *Params) GetLoyaltyRampEpochs() uint64 {
+	if m != nil {
+		return m.LoyaltyRampEpochs
+	}
+	return 0
+}
+
+func (m *Params) GetProvidersTypeMinStakes() []ProvidersTypeMinStake {
+	if m != nil {
+		return m.ProvidersTypeMinStakes
+	}
+	return nil
+}
+
+func (m *Params) GetExtensionStakeRequirements() []ExtensionStakeRequirement {
+	if m != nil {
+		return m.ExtensionStakeRequirements
+	}
+	return nil
+}
+
+// ProvidersTypeMinStake is the providers' min stake for the specs of a providers type
+type ProvidersTypeMinStake struct {
+	ProvidersType types.Spec_ProvidersTypes `protobuf:"varint,1,opt,name=providers_type,json=providersType,proto3,enum=lavanet.lava.spec.Spec_ProvidersTypes" json:"providers_type,omitempty"`
+	MinStake      types1.Coin               `protobuf:"bytes,2,opt,name=min_stake,json=minStake,proto3" json:"min_stake"`
+}
+
+func (m *ProvidersTypeMinStake) Reset()         { *m = ProvidersTypeMinStake{} }
+func (m *ProvidersTypeMinStake) String() string { return proto.CompactTextString(m) }
+func (*ProvidersTypeMinStake) ProtoMessage()    {}
+func (*ProvidersTypeMinStake) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df864e1276b03c21, []int{1}
+}
+func (m *ProvidersTypeMinStake) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProvidersTypeMinStake) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProvidersTypeMinStake.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProvidersTypeMinStake) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProvidersTypeMinStake.Merge(m, src)
+}
+func (m *ProvidersTypeMinStake) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProvidersTypeMinStake) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProvidersTypeMinStake.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProvidersTypeMinStake proto.InternalMessageInfo
+
+func (m *ProvidersTypeMinStake) GetProvidersType() types.Spec_ProvidersTypes {
+	if m != nil {
+		return m.ProvidersType
+	}
+	return types.Spec_dynamic
+}
+
+func (m *ProvidersTypeMinStake) GetMinStake() types1.Coin {
+	if m != nil {
+		return m.MinStake
+	}
+	return types1.Coin{}
+}
+
+// ExtensionStakeRequirement is the min effective stake to serve a chain's extension
+type ExtensionStakeRequirement struct {
+	ChainId     string      `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Extension   string      `protobuf:"bytes,2,opt,name=extension,proto3" json:"extension,omitempty"`
+	Requirement types1.Coin `protobuf:"bytes,3,opt,name=requirement,proto3" json:"requirement"`
+}
+
+func (m *ExtensionStakeRequirement) Reset()         { *m = ExtensionStakeRequirement{} }
+func (m *ExtensionStakeRequirement) String() string { return proto.CompactTextString(m) }
+func (*ExtensionStakeRequirement) ProtoMessage()    {}
+func (*ExtensionStakeRequirement) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df864e1276b03c21, []int{2}
+}
+func (m *ExtensionStakeRequirement) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExtensionStakeRequirement) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExtensionStakeRequirement.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExtensionStakeRequirement) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExtensionStakeRequirement.Merge(m, src)
+}
+func (m *ExtensionStakeRequirement) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExtensionStakeRequirement) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExtensionStakeRequirement.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExtensionStakeRequirement proto.InternalMessageInfo
+
+func (m *ExtensionStakeRequirement) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *ExtensionStakeRequirement) GetExtension() string {
+	if m != nil {
+		return m.Extension
+	}
+	return ""
+}
+
+func (m *ExtensionStakeRequirement) GetRequirement() types1.Coin {
+	if m != nil {
+		return m.Requirement
+	}
+	return types1.Coin{}
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "lavanet.lava.dualstaking.Params")
+	proto.RegisterType((*ProvidersTypeMinStake)(nil), "lavanet.lava.dualstaking.ProvidersTypeMinStake")
+	proto.RegisterType((*ExtensionStakeRequirement)(nil), "lavanet.lava.dualstaking.ExtensionStakeRequirement")
 }
 
 func init() {
@@ -68,17 +221,47 @@ func init() {
 }
 
 var fileDescriptor_df864e1276b03c21 = []byte{
-	// 153 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xcd, 0x49, 0x2c, 0x4b,
-	0xcc, 0x4b, 0x2d, 0xd1, 0x07, 0xd1, 0xfa, 0x29, 0xa5, 0x89, 0x39, 0xc5, 0x25, 0x89, 0xd9, 0x99,
-	0x79, 0xe9, 0xfa, 0x05, 0x89, 0x45, 0x89, 0xb9, 0xc5, 0x7a, 0x05, 0x45, 0xf9, 0x25, 0xf9, 0x42,
-	0x12, 0x50, 0x65, 0x7a, 0x20, 0x5a, 0x0f, 0x49, 0x99, 0x94, 0x48, 0x7a, 0x7e, 0x7a, 0x3e, 0x58,
-	0x91, 0x3e, 0x88, 0x05, 0x51, 0xaf, 0xc4, 0xc7, 0xc5, 0x16, 0x00, 0xd6, 0x6f, 0xc5, 0x32, 0x63,
-	0x81, 0x3c, 0x83, 0x93, 0xeb, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24,
-	0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0x69,
-	0xa7, 0x67, 0x96, 0x64, 0x94, 0x26, 0xe9, 0x25, 0xe7, 0xe7, 0xea, 0xa3, 0xb8, 0xa5, 0x02, 0xc5,
-	0x35, 0x25, 0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c, 0x60, 0xd3, 0x8d, 0x01, 0x01, 0x00, 0x00, 0xff,
-	0xff, 0xac, 0x8a, 0x0e, 0xf5, 0xb6, 0x00, 0x00, 0x00,
+	// 635 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x4f, 0x6f, 0xd3, 0x3e,
+	0x18, 0x6e, 0x7e, 0xeb, 0xf6, 0x5b, 0x3d, 0x6d, 0x12, 0x66, 0x1b, 0x69, 0x35, 0x92, 0x2a, 0xc0,
+	0x54, 0x69, 0x22, 0xd1, 0xb6, 0xdb, 0xc4, 0x85, 0xb0, 0x09, 0xed, 0x50, 0x98, 0x3c, 0x4e, 0x5c,
+	0x82, 0x9b, 0x5a, 0x9d, 0x59, 0x6c, 0x87, 0xd8, 0xad, 0xda, 0x0f, 0xc0, 0x9d, 0x03, 0x12, 0x1c,
+	0x11, 0xe2, 0xc3, 0xec, 0xb8, 0x23, 0xe2, 0x50, 0xa1, 0xed, 0x1b, 0x54, 0x7c, 0x00, 0x14, 0x27,
+	0xeb, 0x1a, 0xa9, 0x41, 0x70, 0x89, 0xe3, 0xf7, 0xcf, 0xf3, 0x3e, 0x7e, 0xfc, 0xbe, 0x06, 0x8f,
+	0x22, 0x3c, 0xc0, 0x9c, 0x28, 0x2f, 0x5d, 0xbd, 0x6e, 0x1f, 0x47, 0x52, 0xe1, 0x73, 0xca, 0x7b,
+	0x5e, 0x8c, 0x13, 0xcc, 0xa4, 0x1b, 0x27, 0x42, 0x09, 0x68, 0xe6, 0x61, 0x6e, 0xba, 0xba, 0x33,
+	0x61, 0x8d, 0xf5, 0x9e, 0xe8, 0x09, 0x1d, 0xe4, 0xa5, 0x7f, 0x59, 0x7c, 0xc3, 0x0a, 0x85, 0x64,
+	0x42, 0x7a, 0x1d, 0x2c, 0x89, 0x37, 0xd8, 0xed, 0x10, 0x85, 0x77, 0xbd, 0x50, 0x50, 0x9e, 0xfb,
+	0xb7, 0x0a, 0x65, 0x65, 0x4c, 0x42, 0xfd, 0xc9, 0xbc, 0xce, 0xaf, 0x45, 0xb0, 0x74, 0xa2, 0xcb,
+	0xc3, 0x37, 0xa0, 0x9e, 0x90, 0xb7, 0x24, 0x54, 0x01, 0xe5, 0x38, 0x54, 0x74, 0x40, 0x82, 0x38,
+	0x11, 0x03, 0xda, 0x25, 0x89, 0x34, 0x8d, 0xa6, 0xd1, 0x5a, 0xf6, 0x1f, 0x4e, 0xc6, 0x76, 0x73,
+	0x84, 0x59, 0x74, 0xe0, 0x94, 0x86, 0x3a, 0xe8, 0x5e, 0xe6, 0x3b, 0xce, 0x5d, 0x27, 0x37, 0x1e,
+	0xc8, 0xc1, 0x1a, 0xa3, 0x3c, 0xe8, 0x92, 0x88, 0xf4, 0xb0, 0xa2, 0x82, 0x9b, 0xff, 0x35, 0x8d,
+	0x56, 0xcd, 0x7f, 0x7e, 0x31, 0xb6, 0x2b, 0x3f, 0xc6, 0xf6, 0x76, 0x8f, 0xaa, 0xb3, 0x7e, 0xc7,
+	0x0d, 0x05, 0xf3, 0xf2, 0x53, 0x65, 0xcb, 0x63, 0xd9, 0x3d, 0xf7, 0xd4, 0x28, 0x26, 0xd2, 0x3d,
+	0xe6, 0x6a, 0x32, 0xb6, 0x37, 0x32, 0x12, 0x45, 0x34, 0x07, 0xad, 0x32, 0xca, 0x0f, 0xa7, 0x7b,
+	0xf8, 0xde, 0x00, 0x9b, 0x91, 0x18, 0xe1, 0x48, 0x8d, 0x02, 0x86, 0x87, 0x01, 0xeb, 0x47, 0x8a,
+	0xc6, 0x11, 0x25, 0x89, 0xb9, 0xa0, 0x0b, 0xbf, 0xfc, 0x87, 0xc2, 0x87, 0x24, 0x9c, 0x8c, 0xed,
+	0xfb, 0x59, 0xe1, 0xf9, 0xa8, 0x0e, 0x5a, 0xcf, 0x1d, 0x6d, 0x3c, 0x6c, 0x4f, 0xcd, 0xf0, 0x05,
+	0xb8, 0x7b, 0x93, 0x90, 0x60, 0x16, 0x07, 0x24, 0x16, 0xe1, 0x99, 0x34, 0xab, 0x4d, 0xa3, 0x55,
+	0xf5, 0xad, 0xc9, 0xd8, 0x6e, 0x14, 0x51, 0x67, 0x82, 0x1c, 0x74, 0x27, 0xb7, 0x22, 0xcc, 0xe2,
+	0x23, 0x6d, 0x83, 0x1f, 0x0d, 0x50, 0x9f, 0xea, 0x1d, 0xa4, 0xf4, 0x82, 0x54, 0x89, 0xb4, 0x4f,
+	0x88, 0x34, 0x17, 0x9b, 0x0b, 0xad, 0x95, 0x3d, 0xcf, 0x2d, 0xeb, 0x23, 0x77, 0x7a, 0x21, 0xaf,
+	0x46, 0x31, 0x69, 0x53, 0x7e, 0x9a, 0xe6, 0xf9, 0xad, 0x54, 0x8b, 0xdb, 0xfb, 0x2d, 0xc5, 0x77,
+	0xd0, 0x66, 0x3c, 0x0f, 0x40, 0xc2, 0xaf, 0x06, 0xd8, 0x22, 0x43, 0x45, 0xb8, 0xa4, 0x22, 0x8f,
+	0x0e, 0x12, 0xf2, 0xae, 0x4f, 0x13, 0xc2, 0x08, 0x57, 0xd2, 0x5c, 0xd2, 0xcc, 0xf6, 0xcb, 0x99,
+	0x1d, 0xdd, 0x64, 0x6b, 0x44, 0x74, 0x9b, 0xeb, 0xef, 0xe4, 0xec, 0x1e, 0x64, 0xec, 0xfe, 0x54,
+	0xc6, 0x41, 0x0d, 0x52, 0x86, 0x23, 0x0f, 0xaa, 0x9f, 0xbf, 0xd8, 0x15, 0xe7, 0x9b, 0x01, 0x36,
+	0xe6, 0xca, 0x00, 0xdb, 0x60, 0xad, 0x78, 0x74, 0xdd, 0xfa, 0x6b, 0x7b, 0xdb, 0x45, 0xd6, 0x7a,
+	0x84, 0x4e, 0xd3, 0x4f, 0x01, 0x46, 0xa2, 0xd5, 0x82, 0x38, 0xf0, 0x09, 0xa8, 0x4d, 0xa5, 0xd3,
+	0xdd, 0xbe, 0xb2, 0x57, 0x77, 0xb3, 0xde, 0x72, 0xd3, 0x89, 0x75, 0xf3, 0x89, 0x75, 0x9f, 0x09,
+	0xca, 0xfd, 0x6a, 0x7a, 0x4a, 0xb4, 0xcc, 0x72, 0x32, 0xce, 0x27, 0x03, 0xd4, 0x4b, 0x35, 0x81,
+	0x75, 0xb0, 0x1c, 0x9e, 0x61, 0xca, 0x03, 0xda, 0xd5, 0x24, 0x6b, 0xe8, 0x7f, 0xbd, 0x3f, 0xee,
+	0xc2, 0x2d, 0x50, 0x9b, 0x6a, 0x90, 0x0d, 0x19, 0xba, 0x35, 0xc0, 0xa7, 0x60, 0x65, 0x46, 0x30,
+	0x73, 0xe1, 0xef, 0x68, 0xcd, 0xe6, 0xf8, 0x47, 0x17, 0x57, 0x96, 0x71, 0x79, 0x65, 0x19, 0x3f,
+	0xaf, 0x2c, 0xe3, 0xc3, 0xb5, 0x55, 0xb9, 0xbc, 0xb6, 0x2a, 0xdf, 0xaf, 0xad, 0xca, 0xeb, 0x9d,
+	0x99, 0x59, 0x2a, 0x3c, 0x3d, 0xc3, 0xc2, 0x9b, 0xa7, 0x87, 0xaa, 0xb3, 0xa4, 0x5f, 0xa1, 0xfd,
+	0xdf, 0x03, 0x00, 0xa4, 0x52, 0xae, 0x03, 0x1c, 0x05, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -101,6 +284,154 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ExtensionStakeRequirements) > 0 {
+		for iNdEx := len(m.ExtensionStakeRequirements) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExtensionStakeRequirements[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.ProvidersTypeMinStakes) > 0 {
+		for iNdEx := len(m.ProvidersTypeMinStakes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProvidersTypeMinStakes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.LoyaltyRampEpochs != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.LoyaltyRampEpochs))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.LoyaltyMaxMultiplier.Size()
+		i -= size
+		if _, err := m.LoyaltyMaxMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.MinDelegation.Size()
+		i -= size
+		if _, err := m.MinDelegation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.RejectInactiveProviders {
+		i--
+		if m.RejectInactiveProviders {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProvidersTypeMinStake) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProvidersTypeMinStake) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProvidersTypeMinStake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.MinStake.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.ProvidersType != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ProvidersType))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExtensionStakeRequirement) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExtensionStakeRequirement) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExtensionStakeRequirement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Requirement.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Extension) > 0 {
+		i -= len(m.Extension)
+		copy(dAtA[i:], m.Extension)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Extension)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -121,6 +452,61 @@ func (m *Params) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if m.RejectInactiveProviders {
+		n += 2
+	}
+	l = m.MinDelegation.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.LoyaltyMaxMultiplier.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.LoyaltyRampEpochs != 0 {
+		n += 1 + sovParams(uint64(m.LoyaltyRampEpochs))
+	}
+	if len(m.ProvidersTypeMinStakes) > 0 {
+		for _, e := range m.ProvidersTypeMinStakes {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.ExtensionStakeRequirements) > 0 {
+		for _, e := range m.ExtensionStakeRequirements {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ProvidersTypeMinStake) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProvidersType != 0 {
+		n += 1 + sovParams(uint64(m.ProvidersType))
+	}
+	l = m.MinStake.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func (m *ExtensionStakeRequirement) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = len(m.Extension)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = m.Requirement.Size()
+	n += 1 + l + sovParams(uint64(l))
 	return n
 }
 
@@ -159,6 +545,430 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RejectInactiveProviders", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RejectInactiveProviders = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDelegation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinDelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LoyaltyMaxMultiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LoyaltyMaxMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LoyaltyRampEpochs", wireType)
+			}
+			m.LoyaltyRampEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LoyaltyRampEpochs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProvidersTypeMinStakes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProvidersTypeMinStakes = append(m.ProvidersTypeMinStakes, ProvidersTypeMinStake{})
+			if err := m.ProvidersTypeMinStakes[len(m.ProvidersTypeMinStakes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtensionStakeRequirements", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExtensionStakeRequirements = append(m.ExtensionStakeRequirements, ExtensionStakeRequirement{})
+			if err := m.ExtensionStakeRequirements[len(m.ExtensionStakeRequirements)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProvidersTypeMinStake) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProvidersTypeMinStake: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProvidersTypeMinStake: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProvidersType", wireType)
+			}
+			m.ProvidersType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProvidersType |= types.Spec_ProvidersTypes(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinStake", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinStake.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExtensionStakeRequirement) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExtensionStakeRequirement: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExtensionStakeRequirement: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Extension", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Extension = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Requirement", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Requirement.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
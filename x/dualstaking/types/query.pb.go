@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -470,757 +471,3357 @@ func (m *DelegatorRewardInfo) GetAmount() types.Coin {
 	return types.Coin{}
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "lavanet.lava.dualstaking.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "lavanet.lava.dualstaking.QueryParamsResponse")
-	proto.RegisterType((*QueryDelegatorProvidersRequest)(nil), "lavanet.lava.dualstaking.QueryDelegatorProvidersRequest")
-	proto.RegisterType((*QueryDelegatorProvidersResponse)(nil), "lavanet.lava.dualstaking.QueryDelegatorProvidersResponse")
-	proto.RegisterType((*QueryProviderDelegatorsRequest)(nil), "lavanet.lava.dualstaking.QueryProviderDelegatorsRequest")
-	proto.RegisterType((*QueryProviderDelegatorsResponse)(nil), "lavanet.lava.dualstaking.QueryProviderDelegatorsResponse")
-	proto.RegisterType((*QueryDelegatorRewardsRequest)(nil), "lavanet.lava.dualstaking.QueryDelegatorRewardsRequest")
-	proto.RegisterType((*QueryDelegatorRewardsResponse)(nil), "lavanet.lava.dualstaking.QueryDelegatorRewardsResponse")
-	proto.RegisterType((*DelegatorRewardInfo)(nil), "lavanet.lava.dualstaking.DelegatorRewardInfo")
+type QueryProviderByMonikerRequest struct {
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Moniker string `protobuf:"bytes,2,opt,name=moniker,proto3" json:"moniker,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("lavanet/lava/dualstaking/query.proto", fileDescriptor_8393eed0cfbc46b2)
+func (m *QueryProviderByMonikerRequest) Reset()         { *m = QueryProviderByMonikerRequest{} }
+func (m *QueryProviderByMonikerRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProviderByMonikerRequest) ProtoMessage()    {}
+func (*QueryProviderByMonikerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8393eed0cfbc46b2, []int{9}
 }
-
-var fileDescriptor_8393eed0cfbc46b2 = []byte{
-	// 670 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x95, 0x41, 0x4f, 0x13, 0x4f,
-	0x18, 0xc6, 0x3b, 0xe5, 0xff, 0x2f, 0x30, 0xf5, 0x60, 0x06, 0x0e, 0xa5, 0xc1, 0xa5, 0x6e, 0x30,
-	0x36, 0x2a, 0x3b, 0xa1, 0x26, 0x02, 0x9a, 0x18, 0x45, 0x3c, 0x90, 0x48, 0xc4, 0x26, 0x5c, 0xbc,
-	0x34, 0xd3, 0xee, 0xb8, 0x4c, 0x6c, 0x67, 0x96, 0x9d, 0x29, 0x48, 0x08, 0x17, 0x13, 0xcf, 0x9a,
-	0xf0, 0xa5, 0x48, 0xf4, 0x40, 0xe2, 0xc5, 0x78, 0x30, 0x06, 0xfc, 0x20, 0x66, 0x67, 0x67, 0xeb,
-	0x2e, 0x65, 0xd9, 0x62, 0xe2, 0x69, 0xbb, 0xef, 0x3c, 0x33, 0xcf, 0xfb, 0x9b, 0x37, 0xcf, 0x16,
-	0xce, 0x77, 0xc9, 0x2e, 0xe1, 0x54, 0xe1, 0xf0, 0x89, 0xdd, 0x3e, 0xe9, 0x4a, 0x45, 0xde, 0x32,
-	0xee, 0xe1, 0x9d, 0x3e, 0x0d, 0xf6, 0x1d, 0x3f, 0x10, 0x4a, 0xa0, 0x8a, 0x51, 0x39, 0xe1, 0xd3,
-	0x49, 0xa8, 0xaa, 0xd3, 0x9e, 0xf0, 0x84, 0x16, 0xe1, 0xf0, 0x57, 0xa4, 0xaf, 0xce, 0x7a, 0x42,
-	0x78, 0x5d, 0x8a, 0x89, 0xcf, 0x30, 0xe1, 0x5c, 0x28, 0xa2, 0x98, 0xe0, 0xd2, 0xac, 0xde, 0xe9,
-	0x08, 0xd9, 0x13, 0x12, 0xb7, 0x89, 0xa4, 0x91, 0x0d, 0xde, 0x5d, 0x6c, 0x53, 0x45, 0x16, 0xb1,
-	0x4f, 0x3c, 0xc6, 0xb5, 0xd8, 0x68, 0x6f, 0x65, 0xf6, 0xe7, 0x93, 0x80, 0xf4, 0xe2, 0x23, 0x6f,
-	0x67, 0xca, 0x5c, 0xda, 0xa5, 0x1e, 0x51, 0xd4, 0x08, 0xad, 0xa4, 0x77, 0xec, 0xda, 0x11, 0xcc,
-	0xf8, 0xd9, 0xd3, 0x10, 0xbd, 0x0a, 0x3b, 0xda, 0xd4, 0xa7, 0x37, 0xe9, 0x4e, 0x9f, 0x4a, 0x65,
-	0x6f, 0xc1, 0xa9, 0x54, 0x55, 0xfa, 0x82, 0x4b, 0x8a, 0x1e, 0xc3, 0x52, 0xd4, 0x45, 0x05, 0xd4,
-	0x40, 0xbd, 0xdc, 0xa8, 0x39, 0x59, 0xf7, 0xe4, 0x44, 0x3b, 0x57, 0xff, 0x3b, 0xfe, 0x31, 0x57,
-	0x68, 0x9a, 0x5d, 0x36, 0x81, 0x96, 0x3e, 0x76, 0x2d, 0xea, 0x51, 0x04, 0x9b, 0x81, 0xd8, 0x65,
-	0x2e, 0x0d, 0x62, 0x63, 0x34, 0x0b, 0x27, 0xdd, 0x78, 0x51, 0x9b, 0x4c, 0x36, 0xff, 0x14, 0xd0,
-	0x4d, 0x78, 0x6d, 0x8f, 0xa9, 0xed, 0x96, 0x4f, 0xb9, 0xcb, 0xb8, 0x57, 0x29, 0xd6, 0x40, 0x7d,
-	0xa2, 0x59, 0x0e, 0x6b, 0x9b, 0x51, 0xc9, 0x16, 0x70, 0x2e, 0xd3, 0xc2, 0x50, 0xbc, 0x80, 0x65,
-	0x73, 0x64, 0x38, 0xa3, 0x0a, 0xa8, 0x8d, 0xd5, 0xcb, 0x8d, 0xf9, 0x6c, 0x94, 0xb5, 0x81, 0xd8,
-	0xe0, 0x24, 0xb7, 0xdb, 0x2d, 0xc3, 0x14, 0xfb, 0x0c, 0x8c, 0x07, 0x4c, 0x55, 0x38, 0xe1, 0x9b,
-	0x45, 0x83, 0x34, 0x78, 0xbf, 0x0a, 0xd1, 0x45, 0x06, 0xff, 0x84, 0x48, 0xc2, 0xd9, 0xf4, 0x15,
-	0x36, 0xe9, 0x1e, 0x09, 0xdc, 0x11, 0x67, 0x94, 0xa4, 0x2d, 0x9e, 0xa3, 0x9d, 0x81, 0x13, 0x9d,
-	0x6d, 0xc2, 0x78, 0x8b, 0xb9, 0x95, 0x31, 0xbd, 0x36, 0xae, 0xdf, 0xd7, 0x5d, 0x9b, 0xc3, 0x1b,
-	0x19, 0xa6, 0x86, 0x71, 0x03, 0x8e, 0x07, 0x51, 0xc9, 0xf0, 0x2d, 0xe4, 0xf2, 0xc5, 0x87, 0xac,
-	0xf3, 0x37, 0xc2, 0x80, 0xc6, 0x67, 0xd8, 0x1f, 0x00, 0x9c, 0xba, 0x40, 0x76, 0xe9, 0xb0, 0x92,
-	0xed, 0x17, 0x53, 0xed, 0xa3, 0x25, 0x58, 0x22, 0x3d, 0xd1, 0xe7, 0x4a, 0x73, 0x95, 0x1b, 0x33,
-	0x4e, 0x94, 0x3b, 0x27, 0xcc, 0x9d, 0x63, 0x72, 0xe7, 0x3c, 0x13, 0x2c, 0xbe, 0x71, 0x23, 0x6f,
-	0x1c, 0x95, 0xe0, 0xff, 0x1a, 0x1c, 0x7d, 0x04, 0xb0, 0x14, 0xa5, 0x06, 0xdd, 0xcb, 0x46, 0x1b,
-	0x0e, 0x6b, 0x75, 0x61, 0x44, 0x75, 0x74, 0x91, 0x76, 0xfd, 0xfd, 0xd7, 0x5f, 0x47, 0x45, 0x1b,
-	0xd5, 0x70, 0xce, 0xa7, 0x06, 0x7d, 0x01, 0x10, 0x0d, 0xe7, 0x08, 0x2d, 0xe7, 0xf8, 0x65, 0xa6,
-	0xbb, 0xba, 0xf2, 0x17, 0x3b, 0x4d, 0xd7, 0x4f, 0x75, 0xd7, 0x8f, 0xd0, 0x0a, 0xce, 0xfb, 0xf2,
-	0x89, 0xa0, 0x15, 0x4f, 0x4c, 0xe2, 0x83, 0x41, 0xf1, 0x10, 0x7d, 0x06, 0x10, 0x0d, 0x87, 0x28,
-	0x17, 0x27, 0x33, 0xd8, 0xb9, 0x38, 0xd9, 0x89, 0xb5, 0x9f, 0x68, 0x9c, 0x87, 0x68, 0xf9, 0x92,
-	0x21, 0x98, 0xdd, 0xad, 0x01, 0x82, 0xc4, 0x07, 0x71, 0xf1, 0x10, 0x7d, 0x07, 0xf0, 0xfa, 0xf9,
-	0xb0, 0xa0, 0x07, 0xa3, 0x5e, 0x70, 0x3a, 0xd2, 0xd5, 0xa5, 0x2b, 0xef, 0x33, 0x1c, 0x5b, 0x9a,
-	0xe3, 0x25, 0xda, 0x18, 0x65, 0x2c, 0x26, 0x7b, 0xc9, 0xa1, 0x24, 0x88, 0xf0, 0x41, 0x1c, 0xae,
-	0xc3, 0xd5, 0xe7, 0xc7, 0xa7, 0x16, 0x38, 0x39, 0xb5, 0xc0, 0xcf, 0x53, 0x0b, 0x7c, 0x3a, 0xb3,
-	0x0a, 0x27, 0x67, 0x56, 0xe1, 0xdb, 0x99, 0x55, 0x78, 0x7d, 0xd7, 0x63, 0x6a, 0xbb, 0xdf, 0x76,
-	0x3a, 0xa2, 0x97, 0xb6, 0x7c, 0x97, 0x32, 0x55, 0xfb, 0x3e, 0x95, 0xed, 0x92, 0xfe, 0x8f, 0xbb,
-	0xff, 0x3b, 0x00, 0x00, 0xff, 0xff, 0x17, 0xcc, 0x53, 0x88, 0xf5, 0x07, 0x00, 0x00,
+func (m *QueryProviderByMonikerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProviderByMonikerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProviderByMonikerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProviderByMonikerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProviderByMonikerRequest.Merge(m, src)
+}
+func (m *QueryProviderByMonikerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProviderByMonikerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProviderByMonikerRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var xxx_messageInfo_QueryProviderByMonikerRequest proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *QueryProviderByMonikerRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Parameters queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// Queries a delegator for all its providers.
-	DelegatorProviders(ctx context.Context, in *QueryDelegatorProvidersRequest, opts ...grpc.CallOption) (*QueryDelegatorProvidersResponse, error)
-	// Queries a provider for all its delegators.
-	ProviderDelegators(ctx context.Context, in *QueryProviderDelegatorsRequest, opts ...grpc.CallOption) (*QueryProviderDelegatorsResponse, error)
-	// Queries a the unclaimed rewards of a delegator.
-	DelegatorRewards(ctx context.Context, in *QueryDelegatorRewardsRequest, opts ...grpc.CallOption) (*QueryDelegatorRewardsResponse, error)
+func (m *QueryProviderByMonikerRequest) GetMoniker() string {
+	if m != nil {
+		return m.Moniker
+	}
+	return ""
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+type QueryProviderByMonikerResponse struct {
+	Provider string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryProviderByMonikerResponse) Reset()         { *m = QueryProviderByMonikerResponse{} }
+func (m *QueryProviderByMonikerResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProviderByMonikerResponse) ProtoMessage()    {}
+func (*QueryProviderByMonikerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8393eed0cfbc46b2, []int{10}
+}
+func (m *QueryProviderByMonikerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProviderByMonikerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProviderByMonikerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProviderByMonikerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProviderByMonikerResponse.Merge(m, src)
+}
+func (m *QueryProviderByMonikerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProviderByMonikerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProviderByMonikerResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/lavanet.lava.dualstaking.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryProviderByMonikerResponse proto.InternalMessageInfo
+
+func (m *QueryProviderByMonikerResponse) GetProvider() string {
+	if m != nil {
+		return m.Provider
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) DelegatorProviders(ctx context.Context, in *QueryDelegatorProvidersRequest, opts ...grpc.CallOption) (*QueryDelegatorProvidersResponse, error) {
-	out := new(QueryDelegatorProvidersResponse)
-	err := c.cc.Invoke(ctx, "/lavanet.lava.dualstaking.Query/DelegatorProviders", in, out, opts...)
-	if err != nil {
-		return nil, err
+type QueryDelegatorChainDelegationsRequest struct {
+	Delegator   string `protobuf:"bytes,1,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	ChainId     string `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	WithPending bool   `protobuf:"varint,3,opt,name=with_pending,json=withPending,proto3" json:"with_pending,omitempty"`
+}
+
+func (m *QueryDelegatorChainDelegationsRequest) Reset()         { *m = QueryDelegatorChainDelegationsRequest{} }
+func (m *QueryDelegatorChainDelegationsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegatorChainDelegationsRequest) ProtoMessage()    {}
+func (*QueryDelegatorChainDelegationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8393eed0cfbc46b2, []int{11}
+}
+func (m *QueryDelegatorChainDelegationsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegatorChainDelegationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegatorChainDelegationsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryDelegatorChainDelegationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegatorChainDelegationsRequest.Merge(m, src)
+}
+func (m *QueryDelegatorChainDelegationsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegatorChainDelegationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegatorChainDelegationsRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) ProviderDelegators(ctx context.Context, in *QueryProviderDelegatorsRequest, opts ...grpc.CallOption) (*QueryProviderDelegatorsResponse, error) {
-	out := new(QueryProviderDelegatorsResponse)
-	err := c.cc.Invoke(ctx, "/lavanet.lava.dualstaking.Query/ProviderDelegators", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryDelegatorChainDelegationsRequest proto.InternalMessageInfo
+
+func (m *QueryDelegatorChainDelegationsRequest) GetDelegator() string {
+	if m != nil {
+		return m.Delegator
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) DelegatorRewards(ctx context.Context, in *QueryDelegatorRewardsRequest, opts ...grpc.CallOption) (*QueryDelegatorRewardsResponse, error) {
-	out := new(QueryDelegatorRewardsResponse)
-	err := c.cc.Invoke(ctx, "/lavanet.lava.dualstaking.Query/DelegatorRewards", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryDelegatorChainDelegationsRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	return out, nil
+	return ""
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Parameters queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// Queries a delegator for all its providers.
-	DelegatorProviders(context.Context, *QueryDelegatorProvidersRequest) (*QueryDelegatorProvidersResponse, error)
-	// Queries a provider for all its delegators.
-	ProviderDelegators(context.Context, *QueryProviderDelegatorsRequest) (*QueryProviderDelegatorsResponse, error)
-	// Queries a the unclaimed rewards of a delegator.
-	DelegatorRewards(context.Context, *QueryDelegatorRewardsRequest) (*QueryDelegatorRewardsResponse, error)
+func (m *QueryDelegatorChainDelegationsRequest) GetWithPending() bool {
+	if m != nil {
+		return m.WithPending
+	}
+	return false
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+type QueryDelegatorChainDelegationsResponse struct {
+	Delegations []Delegation `protobuf:"bytes,1,rep,name=delegations,proto3" json:"delegations"`
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryDelegatorChainDelegationsResponse) Reset() {
+	*m = QueryDelegatorChainDelegationsResponse{}
 }
-func (*UnimplementedQueryServer) DelegatorProviders(ctx context.Context, req *QueryDelegatorProvidersRequest) (*QueryDelegatorProvidersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DelegatorProviders not implemented")
+func (m *QueryDelegatorChainDelegationsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegatorChainDelegationsResponse) ProtoMessage()    {}
+func (*QueryDelegatorChainDelegationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8393eed0cfbc46b2, []int{12}
 }
-func (*UnimplementedQueryServer) ProviderDelegators(ctx context.Context, req *QueryProviderDelegatorsRequest) (*QueryProviderDelegatorsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ProviderDelegators not implemented")
+func (m *QueryDelegatorChainDelegationsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) DelegatorRewards(ctx context.Context, req *QueryDelegatorRewardsRequest) (*QueryDelegatorRewardsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DelegatorRewards not implemented")
+func (m *QueryDelegatorChainDelegationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegatorChainDelegationsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryDelegatorChainDelegationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegatorChainDelegationsResponse.Merge(m, src)
+}
+func (m *QueryDelegatorChainDelegationsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegatorChainDelegationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegatorChainDelegationsResponse.DiscardUnknown(m)
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lavanet.lava.dualstaking.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+var xxx_messageInfo_QueryDelegatorChainDelegationsResponse proto.InternalMessageInfo
+
+func (m *QueryDelegatorChainDelegationsResponse) GetDelegations() []Delegation {
+	if m != nil {
+		return m.Delegations
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_DelegatorProviders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDelegatorProvidersRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).DelegatorProviders(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lavanet.lava.dualstaking.Query/DelegatorProviders",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).DelegatorProviders(ctx, req.(*QueryDelegatorProvidersRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryProviderDelegatorCountRequest struct {
+	Provider    string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	ChainId     string `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	WithPending bool   `protobuf:"varint,3,opt,name=with_pending,json=withPending,proto3" json:"with_pending,omitempty"`
 }
 
-func _Query_ProviderDelegators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryProviderDelegatorsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ProviderDelegators(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lavanet.lava.dualstaking.Query/ProviderDelegators",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ProviderDelegators(ctx, req.(*QueryProviderDelegatorsRequest))
+func (m *QueryProviderDelegatorCountRequest) Reset()         { *m = QueryProviderDelegatorCountRequest{} }
+func (m *QueryProviderDelegatorCountRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProviderDelegatorCountRequest) ProtoMessage()    {}
+func (*QueryProviderDelegatorCountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8393eed0cfbc46b2, []int{13}
+}
+func (m *QueryProviderDelegatorCountRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProviderDelegatorCountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProviderDelegatorCountRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryProviderDelegatorCountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProviderDelegatorCountRequest.Merge(m, src)
+}
+func (m *QueryProviderDelegatorCountRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProviderDelegatorCountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProviderDelegatorCountRequest.DiscardUnknown(m)
 }
 
-func _Query_DelegatorRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDelegatorRewardsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).DelegatorRewards(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lavanet.lava.dualstaking.Query/DelegatorRewards",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).DelegatorRewards(ctx, req.(*QueryDelegatorRewardsRequest))
+var xxx_messageInfo_QueryProviderDelegatorCountRequest proto.InternalMessageInfo
+
+func (m *QueryProviderDelegatorCountRequest) GetProvider() string {
+	if m != nil {
+		return m.Provider
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "lavanet.lava.dualstaking.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "DelegatorProviders",
-			Handler:    _Query_DelegatorProviders_Handler,
-		},
-		{
-			MethodName: "ProviderDelegators",
-			Handler:    _Query_ProviderDelegators_Handler,
-		},
-		{
-			MethodName: "DelegatorRewards",
-			Handler:    _Query_DelegatorRewards_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "lavanet/lava/dualstaking/query.proto",
+func (m *QueryProviderDelegatorCountRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryProviderDelegatorCountRequest) GetWithPending() bool {
+	if m != nil {
+		return m.WithPending
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryProviderDelegatorCountResponse struct {
+	Count uint64 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryProviderDelegatorCountResponse) Reset()         { *m = QueryProviderDelegatorCountResponse{} }
+func (m *QueryProviderDelegatorCountResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProviderDelegatorCountResponse) ProtoMessage()    {}
+func (*QueryProviderDelegatorCountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8393eed0cfbc46b2, []int{14}
+}
+func (m *QueryProviderDelegatorCountResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProviderDelegatorCountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProviderDelegatorCountResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProviderDelegatorCountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProviderDelegatorCountResponse.Merge(m, src)
+}
+func (m *QueryProviderDelegatorCountResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProviderDelegatorCountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProviderDelegatorCountResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryProviderDelegatorCountResponse proto.InternalMessageInfo
+
+func (m *QueryProviderDelegatorCountResponse) GetCount() uint64 {
+	if m != nil {
+		return m.Count
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryDelegationAtHeightRequest struct {
+	Delegator string `protobuf:"bytes,1,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	Provider  string `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
+	ChainId   string `protobuf:"bytes,3,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Height    uint64 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryDelegationAtHeightRequest) Reset()         { *m = QueryDelegationAtHeightRequest{} }
+func (m *QueryDelegationAtHeightRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegationAtHeightRequest) ProtoMessage()    {}
+func (*QueryDelegationAtHeightRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8393eed0cfbc46b2, []int{15}
+}
+func (m *QueryDelegationAtHeightRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegationAtHeightRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegationAtHeightRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryDelegationAtHeightRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegationAtHeightRequest.Merge(m, src)
+}
+func (m *QueryDelegationAtHeightRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegationAtHeightRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegationAtHeightRequest.DiscardUnknown(m)
 }
 
-func (m *QueryDelegatorProvidersRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryDelegationAtHeightRequest proto.InternalMessageInfo
+
+func (m *QueryDelegationAtHeightRequest) GetDelegator() string {
+	if m != nil {
+		return m.Delegator
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryDelegatorProvidersRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryDelegationAtHeightRequest) GetProvider() string {
+	if m != nil {
+		return m.Provider
+	}
+	return ""
 }
 
-func (m *QueryDelegatorProvidersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.WithPending {
-		i--
-		if m.WithPending {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Delegator) > 0 {
-		i -= len(m.Delegator)
-		copy(dAtA[i:], m.Delegator)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Delegator)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryDelegationAtHeightRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryDelegatorProvidersResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryDelegationAtHeightRequest) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryDelegatorProvidersResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryDelegationAtHeightResponse struct {
+	Delegation Delegation `protobuf:"bytes,1,opt,name=delegation,proto3" json:"delegation"`
 }
 
-func (m *QueryDelegatorProvidersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Delegations) > 0 {
-		for iNdEx := len(m.Delegations) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Delegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryDelegationAtHeightResponse) Reset()         { *m = QueryDelegationAtHeightResponse{} }
+func (m *QueryDelegationAtHeightResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegationAtHeightResponse) ProtoMessage()    {}
+func (*QueryDelegationAtHeightResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8393eed0cfbc46b2, []int{16}
+}
+func (m *QueryDelegationAtHeightResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegationAtHeightResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegationAtHeightResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryDelegationAtHeightResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegationAtHeightResponse.Merge(m, src)
+}
+func (m *QueryDelegationAtHeightResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegationAtHeightResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegationAtHeightResponse.DiscardUnknown(m)
 }
 
-func (m *QueryProviderDelegatorsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryDelegationAtHeightResponse proto.InternalMessageInfo
+
+func (m *QueryDelegationAtHeightResponse) GetDelegation() Delegation {
+	if m != nil {
+		return m.Delegation
 	}
-	return dAtA[:n], nil
+	return Delegation{}
 }
 
-func (m *QueryProviderDelegatorsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryPoolReconciliationRequest struct {
 }
 
-func (m *QueryProviderDelegatorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.WithPending {
-		i--
-		if m.WithPending {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *QueryPoolReconciliationRequest) Reset()         { *m = QueryPoolReconciliationRequest{} }
+func (m *QueryPoolReconciliationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolReconciliationRequest) ProtoMessage()    {}
+func (*QueryPoolReconciliationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8393eed0cfbc46b2, []int{17}
+}
+func (m *QueryPoolReconciliationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPoolReconciliationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPoolReconciliationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Provider) > 0 {
-		i -= len(m.Provider)
-		copy(dAtA[i:], m.Provider)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Provider)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryProviderDelegatorsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryPoolReconciliationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolReconciliationRequest.Merge(m, src)
+}
+func (m *QueryPoolReconciliationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPoolReconciliationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolReconciliationRequest.DiscardUnknown(m)
 }
 
-func (m *QueryProviderDelegatorsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryPoolReconciliationRequest proto.InternalMessageInfo
+
+type QueryPoolReconciliationResponse struct {
+	PoolBalance       types.Coin                             `protobuf:"bytes,1,opt,name=pool_balance,json=poolBalance,proto3" json:"pool_balance"`
+	SummedDelegations types.Coin                             `protobuf:"bytes,2,opt,name=summed_delegations,json=summedDelegations,proto3" json:"summed_delegations"`
+	Diff              github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=diff,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"diff"`
 }
 
-func (m *QueryProviderDelegatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Delegations) > 0 {
-		for iNdEx := len(m.Delegations) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Delegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryPoolReconciliationResponse) Reset()         { *m = QueryPoolReconciliationResponse{} }
+func (m *QueryPoolReconciliationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolReconciliationResponse) ProtoMessage()    {}
+func (*QueryPoolReconciliationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8393eed0cfbc46b2, []int{18}
+}
+func (m *QueryPoolReconciliationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPoolReconciliationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPoolReconciliationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryDelegatorRewardsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryPoolReconciliationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolReconciliationResponse.Merge(m, src)
 }
-
-func (m *QueryDelegatorRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryPoolReconciliationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPoolReconciliationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolReconciliationResponse.DiscardUnknown(m)
 }
 
-func (m *QueryDelegatorRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Provider) > 0 {
-		i -= len(m.Provider)
-		copy(dAtA[i:], m.Provider)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Provider)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Delegator) > 0 {
-		i -= len(m.Delegator)
-		copy(dAtA[i:], m.Delegator)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Delegator)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryPoolReconciliationResponse proto.InternalMessageInfo
+
+func (m *QueryPoolReconciliationResponse) GetPoolBalance() types.Coin {
+	if m != nil {
+		return m.PoolBalance
 	}
-	return len(dAtA) - i, nil
+	return types.Coin{}
 }
 
-func (m *QueryDelegatorRewardsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryPoolReconciliationResponse) GetSummedDelegations() types.Coin {
+	if m != nil {
+		return m.SummedDelegations
 	}
-	return dAtA[:n], nil
+	return types.Coin{}
 }
 
-func (m *QueryDelegatorRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryStoreStatsRequest struct {
 }
 
-func (m *QueryDelegatorRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Rewards) > 0 {
-		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryStoreStatsRequest) Reset()         { *m = QueryStoreStatsRequest{} }
+func (m *QueryStoreStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryStoreStatsRequest) ProtoMessage()    {}
+func (*QueryStoreStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8393eed0cfbc46b2, []int{19}
+}
+func (m *QueryStoreStatsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStoreStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStoreStatsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *DelegatorRewardInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryStoreStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStoreStatsRequest.Merge(m, src)
+}
+func (m *QueryStoreStatsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStoreStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStoreStatsRequest.DiscardUnknown(m)
 }
 
-func (m *DelegatorRewardInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryStoreStatsRequest proto.InternalMessageInfo
+
+type QueryStoreStatsResponse struct {
+	DelegationEntries uint64 `protobuf:"varint,1,opt,name=delegation_entries,json=delegationEntries,proto3" json:"delegation_entries,omitempty"`
+	DelegationIndices uint64 `protobuf:"varint,2,opt,name=delegation_indices,json=delegationIndices,proto3" json:"delegation_indices,omitempty"`
+	DelegatorEntries  uint64 `protobuf:"varint,3,opt,name=delegator_entries,json=delegatorEntries,proto3" json:"delegator_entries,omitempty"`
+	DelegatorIndices  uint64 `protobuf:"varint,4,opt,name=delegator_indices,json=delegatorIndices,proto3" json:"delegator_indices,omitempty"`
 }
 
-func (m *DelegatorRewardInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryStoreStatsResponse) Reset()         { *m = QueryStoreStatsResponse{} }
+func (m *QueryStoreStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryStoreStatsResponse) ProtoMessage()    {}
+func (*QueryStoreStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8393eed0cfbc46b2, []int{20}
+}
+func (m *QueryStoreStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStoreStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStoreStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Provider) > 0 {
-		i -= len(m.Provider)
-		copy(dAtA[i:], m.Provider)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Provider)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryStoreStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStoreStatsResponse.Merge(m, src)
+}
+func (m *QueryStoreStatsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStoreStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStoreStatsResponse.DiscardUnknown(m)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_QueryStoreStatsResponse proto.InternalMessageInfo
+
+func (m *QueryStoreStatsResponse) GetDelegationEntries() uint64 {
+	if m != nil {
+		return m.DelegationEntries
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return 0
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *QueryStoreStatsResponse) GetDelegationIndices() uint64 {
+	if m != nil {
+		return m.DelegationIndices
 	}
-	var l int
-	_ = l
-	return n
+	return 0
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryStoreStatsResponse) GetDelegatorEntries() uint64 {
+	if m != nil {
+		return m.DelegatorEntries
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return 0
 }
 
-func (m *QueryDelegatorProvidersRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Delegator)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.WithPending {
-		n += 2
+func (m *QueryStoreStatsResponse) GetDelegatorIndices() uint64 {
+	if m != nil {
+		return m.DelegatorIndices
 	}
-	return n
+	return 0
 }
 
-func (m *QueryDelegatorProvidersResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Delegations) > 0 {
-		for _, e := range m.Delegations {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	return n
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "lavanet.lava.dualstaking.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "lavanet.lava.dualstaking.QueryParamsResponse")
+	proto.RegisterType((*QueryDelegatorProvidersRequest)(nil), "lavanet.lava.dualstaking.QueryDelegatorProvidersRequest")
+	proto.RegisterType((*QueryDelegatorProvidersResponse)(nil), "lavanet.lava.dualstaking.QueryDelegatorProvidersResponse")
+	proto.RegisterType((*QueryProviderDelegatorsRequest)(nil), "lavanet.lava.dualstaking.QueryProviderDelegatorsRequest")
+	proto.RegisterType((*QueryProviderDelegatorsResponse)(nil), "lavanet.lava.dualstaking.QueryProviderDelegatorsResponse")
+	proto.RegisterType((*QueryDelegatorRewardsRequest)(nil), "lavanet.lava.dualstaking.QueryDelegatorRewardsRequest")
+	proto.RegisterType((*QueryDelegatorRewardsResponse)(nil), "lavanet.lava.dualstaking.QueryDelegatorRewardsResponse")
+	proto.RegisterType((*DelegatorRewardInfo)(nil), "lavanet.lava.dualstaking.DelegatorRewardInfo")
+	proto.RegisterType((*QueryProviderByMonikerRequest)(nil), "lavanet.lava.dualstaking.QueryProviderByMonikerRequest")
+	proto.RegisterType((*QueryProviderByMonikerResponse)(nil), "lavanet.lava.dualstaking.QueryProviderByMonikerResponse")
+	proto.RegisterType((*QueryDelegatorChainDelegationsRequest)(nil), "lavanet.lava.dualstaking.QueryDelegatorChainDelegationsRequest")
+	proto.RegisterType((*QueryDelegatorChainDelegationsResponse)(nil), "lavanet.lava.dualstaking.QueryDelegatorChainDelegationsResponse")
+	proto.RegisterType((*QueryProviderDelegatorCountRequest)(nil), "lavanet.lava.dualstaking.QueryProviderDelegatorCountRequest")
+	proto.RegisterType((*QueryProviderDelegatorCountResponse)(nil), "lavanet.lava.dualstaking.QueryProviderDelegatorCountResponse")
+	proto.RegisterType((*QueryDelegationAtHeightRequest)(nil), "lavanet.lava.dualstaking.QueryDelegationAtHeightRequest")
+	proto.RegisterType((*QueryDelegationAtHeightResponse)(nil), "lavanet.lava.dualstaking.QueryDelegationAtHeightResponse")
+	proto.RegisterType((*QueryPoolReconciliationRequest)(nil), "lavanet.lava.dualstaking.QueryPoolReconciliationRequest")
+	proto.RegisterType((*QueryPoolReconciliationResponse)(nil), "lavanet.lava.dualstaking.QueryPoolReconciliationResponse")
+	proto.RegisterType((*QueryStoreStatsRequest)(nil), "lavanet.lava.dualstaking.QueryStoreStatsRequest")
+	proto.RegisterType((*QueryStoreStatsResponse)(nil), "lavanet.lava.dualstaking.QueryStoreStatsResponse")
 }
 
-func (m *QueryProviderDelegatorsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Provider)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.WithPending {
-		n += 2
-	}
-	return n
+func init() {
+	proto.RegisterFile("lavanet/lava/dualstaking/query.proto", fileDescriptor_8393eed0cfbc46b2)
 }
 
-func (m *QueryProviderDelegatorsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Delegations) > 0 {
-		for _, e := range m.Delegations {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	return n
+var fileDescriptor_8393eed0cfbc46b2 = []byte{
+	// 1231 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xce, 0x24, 0x69, 0x92, 0x3e, 0xf7, 0x90, 0x4c, 0xa3, 0xe0, 0xac, 0x52, 0xc7, 0x2c, 0x4d,
+	0x1b, 0x51, 0xbc, 0x4b, 0x82, 0x20, 0x09, 0x2d, 0xd0, 0x3a, 0xa9, 0xd4, 0x44, 0x4d, 0x09, 0x6e,
+	0x7b, 0x00, 0x0e, 0xab, 0xb1, 0x77, 0xe2, 0xac, 0x62, 0xef, 0xb8, 0xbb, 0xeb, 0x94, 0x28, 0x0a,
+	0x07, 0x10, 0x47, 0x04, 0x12, 0x77, 0xc4, 0x9f, 0xc2, 0xb1, 0x12, 0x1c, 0x0a, 0x5c, 0x50, 0x0f,
+	0x15, 0x4a, 0x90, 0xb8, 0x70, 0xe4, 0xc2, 0x0d, 0xed, 0xcc, 0xac, 0xbd, 0x6b, 0x67, 0xbd, 0x6b,
+	0xa3, 0x9c, 0x9c, 0x9d, 0xf9, 0xde, 0x8f, 0xef, 0xbd, 0x37, 0x33, 0x9f, 0x02, 0x57, 0x6b, 0xe4,
+	0x80, 0xd8, 0xd4, 0xd3, 0xfd, 0x5f, 0xdd, 0x6c, 0x92, 0x9a, 0xeb, 0x91, 0x7d, 0xcb, 0xae, 0xea,
+	0x4f, 0x9a, 0xd4, 0x39, 0xd4, 0x1a, 0x0e, 0xf3, 0x18, 0xce, 0x4a, 0x94, 0xe6, 0xff, 0x6a, 0x21,
+	0x94, 0x32, 0x5d, 0x65, 0x55, 0xc6, 0x41, 0xba, 0xff, 0x97, 0xc0, 0x2b, 0x73, 0x55, 0xc6, 0xaa,
+	0x35, 0xaa, 0x93, 0x86, 0xa5, 0x13, 0xdb, 0x66, 0x1e, 0xf1, 0x2c, 0x66, 0xbb, 0x72, 0xf7, 0xf5,
+	0x0a, 0x73, 0xeb, 0xcc, 0xd5, 0xcb, 0xc4, 0xa5, 0x22, 0x8c, 0x7e, 0xb0, 0x54, 0xa6, 0x1e, 0x59,
+	0xd2, 0x1b, 0xa4, 0x6a, 0xd9, 0x1c, 0x2c, 0xb1, 0x0b, 0xb1, 0xf9, 0x35, 0x88, 0x43, 0xea, 0x81,
+	0xcb, 0xeb, 0xb1, 0x30, 0x93, 0xd6, 0x68, 0x95, 0x78, 0x54, 0x02, 0x73, 0xe1, 0xd8, 0x41, 0xd4,
+	0x0a, 0xb3, 0x64, 0x3c, 0x75, 0x1a, 0xf0, 0x47, 0x7e, 0x46, 0x3b, 0xdc, 0x7b, 0x89, 0x3e, 0x69,
+	0x52, 0xd7, 0x53, 0x1f, 0xc3, 0xe5, 0xc8, 0xaa, 0xdb, 0x60, 0xb6, 0x4b, 0xf1, 0xfb, 0x30, 0x26,
+	0xb2, 0xc8, 0xa2, 0x3c, 0x5a, 0xcc, 0x2c, 0xe7, 0xb5, 0xb8, 0x3a, 0x69, 0xc2, 0xb2, 0x38, 0xfa,
+	0xec, 0xe5, 0xfc, 0x50, 0x49, 0x5a, 0xa9, 0x04, 0x72, 0xdc, 0xed, 0x86, 0xc8, 0x91, 0x39, 0x3b,
+	0x0e, 0x3b, 0xb0, 0x4c, 0xea, 0x04, 0x81, 0xf1, 0x1c, 0x5c, 0x34, 0x83, 0x4d, 0x1e, 0xe4, 0x62,
+	0xa9, 0xbd, 0x80, 0x5f, 0x85, 0x4b, 0x4f, 0x2d, 0x6f, 0xcf, 0x68, 0x50, 0xdb, 0xb4, 0xec, 0x6a,
+	0x76, 0x38, 0x8f, 0x16, 0x27, 0x4a, 0x19, 0x7f, 0x6d, 0x47, 0x2c, 0xa9, 0x0c, 0xe6, 0x63, 0x43,
+	0x48, 0x16, 0xf7, 0x21, 0x23, 0x5d, 0xfa, 0x3d, 0xca, 0xa2, 0xfc, 0xc8, 0x62, 0x66, 0xf9, 0x6a,
+	0x3c, 0x95, 0x8d, 0x16, 0x58, 0xd2, 0x09, 0x9b, 0xab, 0x86, 0xe4, 0x14, 0xc4, 0x69, 0x05, 0x6e,
+	0x71, 0x52, 0x60, 0xa2, 0x21, 0x37, 0x25, 0xa5, 0xd6, 0x77, 0x3f, 0x8c, 0xce, 0x0a, 0x70, 0x2e,
+	0x8c, 0x5c, 0x98, 0x8b, 0x96, 0xb0, 0x44, 0x9f, 0x12, 0xc7, 0x4c, 0xd9, 0xa3, 0x30, 0xdb, 0xe1,
+	0x0e, 0xb6, 0xb3, 0x30, 0x51, 0xd9, 0x23, 0x96, 0x6d, 0x58, 0x66, 0x76, 0x84, 0xef, 0x8d, 0xf3,
+	0xef, 0x4d, 0x53, 0xb5, 0xe1, 0x4a, 0x4c, 0x50, 0xc9, 0x71, 0x1b, 0xc6, 0x1d, 0xb1, 0x24, 0xf9,
+	0x15, 0x12, 0xf9, 0x05, 0x4e, 0x36, 0xed, 0x5d, 0x26, 0x89, 0x06, 0x3e, 0xd4, 0xaf, 0x10, 0x5c,
+	0x3e, 0x03, 0xd6, 0xb3, 0x59, 0xe1, 0xf4, 0x87, 0x23, 0xe9, 0xe3, 0x15, 0x18, 0x23, 0x75, 0xd6,
+	0xb4, 0x3d, 0xce, 0x2b, 0xb3, 0x3c, 0xab, 0x89, 0x73, 0xa7, 0xf9, 0xe7, 0x4e, 0x93, 0xe7, 0x4e,
+	0x5b, 0x67, 0x56, 0x50, 0x71, 0x09, 0x57, 0x1f, 0xc1, 0x95, 0x48, 0x77, 0x8b, 0x87, 0xdb, 0xcc,
+	0xb6, 0xf6, 0xa9, 0x13, 0x54, 0x3b, 0x1c, 0x14, 0x45, 0x83, 0x66, 0x61, 0xbc, 0x2e, 0xc0, 0x41,
+	0x3a, 0xf2, 0x53, 0xbd, 0x05, 0xb9, 0x38, 0xaf, 0xb2, 0x9c, 0x3d, 0x78, 0xaa, 0x5f, 0x22, 0x58,
+	0x88, 0x36, 0x63, 0xdd, 0x8f, 0xd8, 0x9e, 0x9a, 0x94, 0xa3, 0xd0, 0xa3, 0x5e, 0x9d, 0x73, 0x3f,
+	0xd2, 0x3d, 0xf7, 0x07, 0x70, 0x2d, 0x29, 0x89, 0x73, 0x19, 0xff, 0xcf, 0x41, 0x3d, 0xfb, 0xbc,
+	0xad, 0xfb, 0x0d, 0x4b, 0x73, 0xa8, 0xff, 0x1f, 0xef, 0x9b, 0xf0, 0x5a, 0xcf, 0xf8, 0x92, 0xf4,
+	0x34, 0x5c, 0xa8, 0xf0, 0x81, 0xf3, 0xa3, 0x8f, 0x96, 0xc4, 0x87, 0xfa, 0x35, 0x8a, 0x5e, 0xb1,
+	0x16, 0xb3, 0xef, 0x78, 0xf7, 0xa8, 0x55, 0xdd, 0xf3, 0xce, 0xf3, 0xf8, 0xe2, 0x19, 0x18, 0xdb,
+	0xe3, 0x51, 0xb2, 0xa3, 0x3c, 0x1d, 0xf9, 0xa5, 0xd6, 0x61, 0x3e, 0x36, 0x1d, 0x49, 0x64, 0x0b,
+	0xa0, 0x5d, 0x7e, 0xf9, 0xb0, 0xf4, 0xd3, 0xbc, 0x90, 0xb5, 0x9a, 0x0f, 0xe6, 0x9e, 0xb1, 0x5a,
+	0x89, 0x56, 0x98, 0x5d, 0xb1, 0x6a, 0x16, 0xdf, 0x0a, 0x5e, 0xb6, 0x7f, 0x11, 0xcc, 0xc7, 0x42,
+	0x64, 0x46, 0x45, 0xb8, 0xd4, 0x60, 0xac, 0x66, 0x94, 0x49, 0x8d, 0xd8, 0x15, 0x9a, 0x45, 0xe9,
+	0x8e, 0x74, 0xc6, 0x37, 0x2a, 0x0a, 0x1b, 0xfc, 0x00, 0xb0, 0xdb, 0xac, 0xd7, 0xa9, 0x69, 0x84,
+	0x47, 0x73, 0x38, 0x9d, 0xa7, 0x29, 0x61, 0x1a, 0x9a, 0x75, 0x5c, 0x84, 0x51, 0xd3, 0xda, 0xdd,
+	0x15, 0x75, 0x2f, 0x6a, 0x3e, 0xec, 0xc5, 0xcb, 0xf9, 0x6b, 0x55, 0xcb, 0xdb, 0x6b, 0x96, 0xb5,
+	0x0a, 0xab, 0xeb, 0xf2, 0xa1, 0x17, 0x3f, 0x05, 0xd7, 0xdc, 0xd7, 0xbd, 0xc3, 0x06, 0x75, 0xb5,
+	0x4d, 0xdb, 0x2b, 0x71, 0x5b, 0x35, 0x0b, 0x33, 0x9c, 0xfa, 0x43, 0x8f, 0x39, 0xf4, 0xa1, 0x47,
+	0xbc, 0xd6, 0x7b, 0xff, 0x0b, 0x82, 0x57, 0xba, 0xb6, 0x64, 0x35, 0x0a, 0x80, 0xdb, 0x14, 0x0c,
+	0x6a, 0x7b, 0x8e, 0x45, 0x5d, 0x39, 0x75, 0x53, 0xed, 0x9d, 0xbb, 0x62, 0xa3, 0x03, 0x6e, 0xd9,
+	0xa6, 0x55, 0xa1, 0x82, 0x78, 0x04, 0xbe, 0x29, 0x36, 0xf0, 0x0d, 0x98, 0x6a, 0x0d, 0x5f, 0xcb,
+	0xf9, 0x08, 0x47, 0x4f, 0xb6, 0x36, 0x02, 0xdf, 0x11, 0x70, 0xe0, 0x7a, 0xb4, 0x03, 0x2c, 0x3d,
+	0x2f, 0x7f, 0x3f, 0x09, 0x17, 0x38, 0x27, 0xfc, 0x0d, 0x82, 0x31, 0xa1, 0x47, 0xf0, 0x1b, 0xf1,
+	0x83, 0xd5, 0x2d, 0x83, 0x94, 0x42, 0x4a, 0xb4, 0xa8, 0x94, 0xba, 0xf8, 0xc5, 0x6f, 0x7f, 0x7e,
+	0x37, 0xac, 0xe2, 0xbc, 0x9e, 0x20, 0xe2, 0xf0, 0xcf, 0x08, 0x70, 0xb7, 0x42, 0xc1, 0xab, 0x09,
+	0xf1, 0x62, 0x75, 0x93, 0xb2, 0x36, 0x80, 0xa5, 0xcc, 0xfa, 0x0e, 0xcf, 0xfa, 0x26, 0x5e, 0xd3,
+	0x93, 0x34, 0x25, 0x73, 0x8c, 0xe0, 0x2e, 0x70, 0xf5, 0xa3, 0xd6, 0xe2, 0x31, 0xfe, 0x09, 0x01,
+	0xee, 0x96, 0x27, 0x89, 0x74, 0x62, 0x25, 0x93, 0xb2, 0x36, 0x80, 0xa5, 0xa4, 0x73, 0x9b, 0xd3,
+	0x79, 0x17, 0xaf, 0xf6, 0x68, 0x82, 0xb4, 0x36, 0x5a, 0x14, 0x5c, 0xfd, 0x28, 0x58, 0x3c, 0xc6,
+	0x2f, 0x10, 0x4c, 0x76, 0xca, 0x10, 0xfc, 0x4e, 0xda, 0x02, 0x47, 0xc5, 0x92, 0xb2, 0xd2, 0xb7,
+	0x9d, 0xe4, 0xf1, 0x98, 0xf3, 0xf8, 0x10, 0x6f, 0xa7, 0x69, 0x8b, 0x54, 0x35, 0xe1, 0xa6, 0x84,
+	0x18, 0xe9, 0x47, 0xc1, 0xb5, 0x7d, 0x8c, 0x7f, 0x45, 0x30, 0xd5, 0xa5, 0x0a, 0xf0, 0x4a, 0xca,
+	0x7a, 0x77, 0xaa, 0x13, 0x65, 0xb5, 0x7f, 0x43, 0xc9, 0x6f, 0x8b, 0xf3, 0xdb, 0xc0, 0xc5, 0x14,
+	0x7d, 0x2a, 0x1f, 0x1a, 0x52, 0xd9, 0x84, 0xa8, 0xe8, 0x47, 0x72, 0xed, 0x18, 0xff, 0x83, 0x60,
+	0x36, 0x56, 0x26, 0xe0, 0x0f, 0xd2, 0xb6, 0x20, 0x46, 0xe5, 0x28, 0xb7, 0x07, 0x77, 0x20, 0xc9,
+	0x3e, 0xe2, 0x64, 0x1f, 0xe0, 0xfb, 0x69, 0x9a, 0x29, 0x18, 0x86, 0x9e, 0x8d, 0x68, 0x5b, 0xdb,
+	0xbd, 0xfc, 0x0b, 0xc1, 0xcc, 0xd9, 0x2a, 0x01, 0xdf, 0xea, 0xf7, 0x00, 0x85, 0xc5, 0x8d, 0xf2,
+	0xde, 0x80, 0xd6, 0x92, 0xed, 0x0e, 0x67, 0xbb, 0x85, 0xef, 0xf5, 0x73, 0x04, 0x0d, 0x2e, 0x60,
+	0x62, 0xa6, 0xf6, 0xef, 0xf6, 0x7d, 0x19, 0x92, 0x10, 0x69, 0xef, 0xcb, 0x6e, 0x11, 0xa4, 0xac,
+	0x0d, 0x60, 0x29, 0xd9, 0x11, 0xce, 0xee, 0x53, 0xfc, 0x71, 0x62, 0x2f, 0xfd, 0x07, 0x90, 0x78,
+	0x86, 0x90, 0x42, 0xc9, 0x67, 0x53, 0x3f, 0x12, 0xc8, 0x63, 0xfc, 0xa3, 0x7f, 0x9f, 0x76, 0xe9,
+	0x93, 0xe4, 0xfb, 0x34, 0x4e, 0xf5, 0x28, 0x6b, 0x03, 0x58, 0x4a, 0xba, 0x6f, 0x73, 0xba, 0x3a,
+	0x2e, 0xf4, 0x68, 0xa6, 0x2f, 0x96, 0x9c, 0x68, 0xae, 0x3f, 0x20, 0x80, 0xb6, 0x98, 0xc0, 0x6f,
+	0x26, 0x24, 0xd0, 0x25, 0x49, 0x94, 0xa5, 0x3e, 0x2c, 0x64, 0xaa, 0x05, 0x9e, 0xea, 0x75, 0xbc,
+	0x10, 0x9f, 0xaa, 0xeb, 0x5b, 0x19, 0xae, 0x6f, 0x56, 0xbc, 0xfb, 0xec, 0x24, 0x87, 0x9e, 0x9f,
+	0xe4, 0xd0, 0x1f, 0x27, 0x39, 0xf4, 0xed, 0x69, 0x6e, 0xe8, 0xf9, 0x69, 0x6e, 0xe8, 0xf7, 0xd3,
+	0xdc, 0xd0, 0x27, 0x37, 0x42, 0xb2, 0x2a, 0xe2, 0xea, 0xb3, 0x88, 0x33, 0xae, 0xaf, 0xca, 0x63,
+	0xfc, 0x1f, 0x29, 0x6f, 0xfd, 0x37, 0x00, 0x63, 0x1f, 0x5e, 0xc8, 0x5a, 0x12, 0x00, 0x00,
 }
 
-func (m *QueryDelegatorRewardsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Queries a delegator for all its providers.
+	DelegatorProviders(ctx context.Context, in *QueryDelegatorProvidersRequest, opts ...grpc.CallOption) (*QueryDelegatorProvidersResponse, error)
+	// Queries a provider for all its delegators.
+	ProviderDelegators(ctx context.Context, in *QueryProviderDelegatorsRequest, opts ...grpc.CallOption) (*QueryProviderDelegatorsResponse, error)
+	// Queries a the unclaimed rewards of a delegator.
+	DelegatorRewards(ctx context.Context, in *QueryDelegatorRewardsRequest, opts ...grpc.CallOption) (*QueryDelegatorRewardsResponse, error)
+	// Queries the provider staked on a chain with a given moniker.
+	ProviderByMoniker(ctx context.Context, in *QueryProviderByMonikerRequest, opts ...grpc.CallOption) (*QueryProviderByMonikerResponse, error)
+	// Queries the delegations of a delegator on a specific chain.
+	DelegatorChainDelegations(ctx context.Context, in *QueryDelegatorChainDelegationsRequest, opts ...grpc.CallOption) (*QueryDelegatorChainDelegationsResponse, error)
+	// Queries the number of delegators of a provider on a specific chain.
+	ProviderDelegatorCount(ctx context.Context, in *QueryProviderDelegatorCountRequest, opts ...grpc.CallOption) (*QueryProviderDelegatorCountResponse, error)
+	// Queries the delegation that was in effect at a past block height.
+	DelegationAtHeight(ctx context.Context, in *QueryDelegationAtHeightRequest, opts ...grpc.CallOption) (*QueryDelegationAtHeightResponse, error)
+	// Queries the difference between the staking module's tokens and the sum of all the provider delegations.
+	PoolReconciliation(ctx context.Context, in *QueryPoolReconciliationRequest, opts ...grpc.CallOption) (*QueryPoolReconciliationResponse, error)
+	// Queries the entry counts of the delegation and delegator fixation stores.
+	StoreStats(ctx context.Context, in *QueryStoreStatsRequest, opts ...grpc.CallOption) (*QueryStoreStatsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.dualstaking.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Delegator)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) DelegatorProviders(ctx context.Context, in *QueryDelegatorProvidersRequest, opts ...grpc.CallOption) (*QueryDelegatorProvidersResponse, error) {
+	out := new(QueryDelegatorProvidersResponse)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.dualstaking.Query/DelegatorProviders", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Provider)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) ProviderDelegators(ctx context.Context, in *QueryProviderDelegatorsRequest, opts ...grpc.CallOption) (*QueryProviderDelegatorsResponse, error) {
+	out := new(QueryProviderDelegatorsResponse)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.dualstaking.Query/ProviderDelegators", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) DelegatorRewards(ctx context.Context, in *QueryDelegatorRewardsRequest, opts ...grpc.CallOption) (*QueryDelegatorRewardsResponse, error) {
+	out := new(QueryDelegatorRewardsResponse)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.dualstaking.Query/DelegatorRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryDelegatorRewardsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) ProviderByMoniker(ctx context.Context, in *QueryProviderByMonikerRequest, opts ...grpc.CallOption) (*QueryProviderByMonikerResponse, error) {
+	out := new(QueryProviderByMonikerResponse)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.dualstaking.Query/ProviderByMoniker", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Rewards) > 0 {
-		for _, e := range m.Rewards {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	return out, nil
+}
+
+func (c *queryClient) DelegatorChainDelegations(ctx context.Context, in *QueryDelegatorChainDelegationsRequest, opts ...grpc.CallOption) (*QueryDelegatorChainDelegationsResponse, error) {
+	out := new(QueryDelegatorChainDelegationsResponse)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.dualstaking.Query/DelegatorChainDelegations", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *DelegatorRewardInfo) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) ProviderDelegatorCount(ctx context.Context, in *QueryProviderDelegatorCountRequest, opts ...grpc.CallOption) (*QueryProviderDelegatorCountResponse, error) {
+	out := new(QueryProviderDelegatorCountResponse)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.dualstaking.Query/ProviderDelegatorCount", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Provider)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) DelegationAtHeight(ctx context.Context, in *QueryDelegationAtHeightRequest, opts ...grpc.CallOption) (*QueryDelegationAtHeightResponse, error) {
+	out := new(QueryDelegationAtHeightResponse)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.dualstaking.Query/DelegationAtHeight", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) PoolReconciliation(ctx context.Context, in *QueryPoolReconciliationRequest, opts ...grpc.CallOption) (*QueryPoolReconciliationResponse, error) {
+	out := new(QueryPoolReconciliationResponse)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.dualstaking.Query/PoolReconciliation", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return out, nil
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (c *queryClient) StoreStats(ctx context.Context, in *QueryStoreStatsRequest, opts ...grpc.CallOption) (*QueryStoreStatsResponse, error) {
+	out := new(QueryStoreStatsResponse)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.dualstaking.Query/StoreStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Queries a delegator for all its providers.
+	DelegatorProviders(context.Context, *QueryDelegatorProvidersRequest) (*QueryDelegatorProvidersResponse, error)
+	// Queries a provider for all its delegators.
+	ProviderDelegators(context.Context, *QueryProviderDelegatorsRequest) (*QueryProviderDelegatorsResponse, error)
+	// Queries a the unclaimed rewards of a delegator.
+	DelegatorRewards(context.Context, *QueryDelegatorRewardsRequest) (*QueryDelegatorRewardsResponse, error)
+	// Queries the provider staked on a chain with a given moniker.
+	ProviderByMoniker(context.Context, *QueryProviderByMonikerRequest) (*QueryProviderByMonikerResponse, error)
+	// Queries the delegations of a delegator on a specific chain.
+	DelegatorChainDelegations(context.Context, *QueryDelegatorChainDelegationsRequest) (*QueryDelegatorChainDelegationsResponse, error)
+	// Queries the number of delegators of a provider on a specific chain.
+	ProviderDelegatorCount(context.Context, *QueryProviderDelegatorCountRequest) (*QueryProviderDelegatorCountResponse, error)
+	// Queries the delegation that was in effect at a past block height.
+	DelegationAtHeight(context.Context, *QueryDelegationAtHeightRequest) (*QueryDelegationAtHeightResponse, error)
+	// Queries the difference between the staking module's tokens and the sum of all the provider delegations.
+	PoolReconciliation(context.Context, *QueryPoolReconciliationRequest) (*QueryPoolReconciliationResponse, error)
+	// Queries the entry counts of the delegation and delegator fixation stores.
+	StoreStats(context.Context, *QueryStoreStatsRequest) (*QueryStoreStatsResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) DelegatorProviders(ctx context.Context, req *QueryDelegatorProvidersRequest) (*QueryDelegatorProvidersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegatorProviders not implemented")
+}
+func (*UnimplementedQueryServer) ProviderDelegators(ctx context.Context, req *QueryProviderDelegatorsRequest) (*QueryProviderDelegatorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProviderDelegators not implemented")
+}
+func (*UnimplementedQueryServer) DelegatorRewards(ctx context.Context, req *QueryDelegatorRewardsRequest) (*QueryDelegatorRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegatorRewards not implemented")
+}
+func (*UnimplementedQueryServer) ProviderByMoniker(ctx context.Context, req *QueryProviderByMonikerRequest) (*QueryProviderByMonikerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProviderByMoniker not implemented")
+}
+func (*UnimplementedQueryServer) DelegatorChainDelegations(ctx context.Context, req *QueryDelegatorChainDelegationsRequest) (*QueryDelegatorChainDelegationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegatorChainDelegations not implemented")
+}
+func (*UnimplementedQueryServer) ProviderDelegatorCount(ctx context.Context, req *QueryProviderDelegatorCountRequest) (*QueryProviderDelegatorCountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProviderDelegatorCount not implemented")
+}
+func (*UnimplementedQueryServer) DelegationAtHeight(ctx context.Context, req *QueryDelegationAtHeightRequest) (*QueryDelegationAtHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegationAtHeight not implemented")
+}
+func (*UnimplementedQueryServer) PoolReconciliation(ctx context.Context, req *QueryPoolReconciliationRequest) (*QueryPoolReconciliationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolReconciliation not implemented")
+}
+func (*UnimplementedQueryServer) StoreStats(ctx context.Context, req *QueryStoreStatsRequest) (*QueryStoreStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StoreStats not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.dualstaking.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DelegatorProviders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegatorProvidersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelegatorProviders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.dualstaking.Query/DelegatorProviders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegatorProviders(ctx, req.(*QueryDelegatorProvidersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProviderDelegators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProviderDelegatorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProviderDelegators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.dualstaking.Query/ProviderDelegators",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProviderDelegators(ctx, req.(*QueryProviderDelegatorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DelegatorRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegatorRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelegatorRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.dualstaking.Query/DelegatorRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegatorRewards(ctx, req.(*QueryDelegatorRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProviderByMoniker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProviderByMonikerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProviderByMoniker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.dualstaking.Query/ProviderByMoniker",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProviderByMoniker(ctx, req.(*QueryProviderByMonikerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DelegatorChainDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegatorChainDelegationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelegatorChainDelegations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.dualstaking.Query/DelegatorChainDelegations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegatorChainDelegations(ctx, req.(*QueryDelegatorChainDelegationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProviderDelegatorCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProviderDelegatorCountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProviderDelegatorCount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.dualstaking.Query/ProviderDelegatorCount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProviderDelegatorCount(ctx, req.(*QueryProviderDelegatorCountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DelegationAtHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegationAtHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelegationAtHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.dualstaking.Query/DelegationAtHeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegationAtHeight(ctx, req.(*QueryDelegationAtHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PoolReconciliation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPoolReconciliationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PoolReconciliation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.dualstaking.Query/PoolReconciliation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PoolReconciliation(ctx, req.(*QueryPoolReconciliationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_StoreStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStoreStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StoreStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.dualstaking.Query/StoreStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StoreStats(ctx, req.(*QueryStoreStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "lavanet.lava.dualstaking.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "DelegatorProviders",
+			Handler:    _Query_DelegatorProviders_Handler,
+		},
+		{
+			MethodName: "ProviderDelegators",
+			Handler:    _Query_ProviderDelegators_Handler,
+		},
+		{
+			MethodName: "DelegatorRewards",
+			Handler:    _Query_DelegatorRewards_Handler,
+		},
+		{
+			MethodName: "ProviderByMoniker",
+			Handler:    _Query_ProviderByMoniker_Handler,
+		},
+		{
+			MethodName: "DelegatorChainDelegations",
+			Handler:    _Query_DelegatorChainDelegations_Handler,
+		},
+		{
+			MethodName: "ProviderDelegatorCount",
+			Handler:    _Query_ProviderDelegatorCount_Handler,
+		},
+		{
+			MethodName: "DelegationAtHeight",
+			Handler:    _Query_DelegationAtHeight_Handler,
+		},
+		{
+			MethodName: "PoolReconciliation",
+			Handler:    _Query_PoolReconciliation_Handler,
+		},
+		{
+			MethodName: "StoreStats",
+			Handler:    _Query_StoreStats_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "lavanet/lava/dualstaking/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegatorProvidersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegatorProvidersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatorProvidersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.WithPending {
+		i--
+		if m.WithPending {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegatorProvidersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegatorProvidersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatorProvidersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Delegations) > 0 {
+		for iNdEx := len(m.Delegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Delegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProviderDelegatorsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProviderDelegatorsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProviderDelegatorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.WithPending {
+		i--
+		if m.WithPending {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProviderDelegatorsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProviderDelegatorsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProviderDelegatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Delegations) > 0 {
+		for iNdEx := len(m.Delegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Delegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegatorRewardsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegatorRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatorRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegatorRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegatorRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatorRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DelegatorRewardInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegatorRewardInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegatorRewardInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProviderByMonikerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProviderByMonikerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProviderByMonikerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Moniker) > 0 {
+		i -= len(m.Moniker)
+		copy(dAtA[i:], m.Moniker)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Moniker)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProviderByMonikerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProviderByMonikerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProviderByMonikerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegatorChainDelegationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegatorChainDelegationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatorChainDelegationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.WithPending {
+		i--
+		if m.WithPending {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegatorChainDelegationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegatorChainDelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatorChainDelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Delegations) > 0 {
+		for iNdEx := len(m.Delegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Delegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProviderDelegatorCountRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProviderDelegatorCountRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProviderDelegatorCountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.WithPending {
+		i--
+		if m.WithPending {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProviderDelegatorCountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProviderDelegatorCountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProviderDelegatorCountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Count != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegationAtHeightRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegationAtHeightRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegationAtHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegationAtHeightResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegationAtHeightResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegationAtHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Delegation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolReconciliationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolReconciliationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolReconciliationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolReconciliationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolReconciliationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolReconciliationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Diff.Size()
+		i -= size
+		if _, err := m.Diff.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.SummedDelegations.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.PoolBalance.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStoreStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStoreStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStoreStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStoreStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStoreStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStoreStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DelegatorIndices != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.DelegatorIndices))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.DelegatorEntries != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.DelegatorEntries))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.DelegationIndices != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.DelegationIndices))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.DelegationEntries != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.DelegationEntries))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryDelegatorProvidersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.WithPending {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryDelegatorProvidersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Delegations) > 0 {
+		for _, e := range m.Delegations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryProviderDelegatorsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.WithPending {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryProviderDelegatorsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Delegations) > 0 {
+		for _, e := range m.Delegations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryDelegatorRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegatorRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DelegatorRewardInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryProviderByMonikerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Moniker)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProviderByMonikerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegatorChainDelegationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.WithPending {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryDelegatorChainDelegationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Delegations) > 0 {
+		for _, e := range m.Delegations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryProviderDelegatorCountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.WithPending {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryProviderDelegatorCountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Count != 0 {
+		n += 1 + sovQuery(uint64(m.Count))
+	}
+	return n
+}
+
+func (m *QueryDelegationAtHeightRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QueryDelegationAtHeightResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Delegation.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPoolReconciliationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPoolReconciliationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PoolBalance.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.SummedDelegations.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Diff.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryStoreStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryStoreStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DelegationEntries != 0 {
+		n += 1 + sovQuery(uint64(m.DelegationEntries))
+	}
+	if m.DelegationIndices != 0 {
+		n += 1 + sovQuery(uint64(m.DelegationIndices))
+	}
+	if m.DelegatorEntries != 0 {
+		n += 1 + sovQuery(uint64(m.DelegatorEntries))
+	}
+	if m.DelegatorIndices != 0 {
+		n += 1 + sovQuery(uint64(m.DelegatorIndices))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDelegatorProvidersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelegatorProvidersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelegatorProvidersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithPending", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.WithPending = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDelegatorProvidersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelegatorProvidersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelegatorProvidersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegations = append(m.Delegations, Delegation{})
+			if err := m.Delegations[len(m.Delegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProviderDelegatorsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProviderDelegatorsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProviderDelegatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithPending", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.WithPending = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProviderDelegatorsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProviderDelegatorsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProviderDelegatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegations = append(m.Delegations, Delegation{})
+			if err := m.Delegations[len(m.Delegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDelegatorRewardsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelegatorRewardsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelegatorRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDelegatorRewardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelegatorRewardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelegatorRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, DelegatorRewardInfo{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DelegatorRewardInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegatorRewardInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegatorRewardInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProviderByMonikerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProviderByMonikerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProviderByMonikerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Moniker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Moniker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProviderByMonikerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProviderByMonikerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProviderByMonikerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegatorChainDelegationsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1243,12 +3844,96 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelegatorChainDelegationsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelegatorChainDelegationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithPending", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.WithPending = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1270,7 +3955,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegatorChainDelegationsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1293,15 +3978,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelegatorChainDelegationsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelegatorChainDelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1328,7 +4013,8 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Delegations = append(m.Delegations, Delegation{})
+			if err := m.Delegations[len(m.Delegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1353,7 +4039,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDelegatorProvidersRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryProviderDelegatorCountRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1376,15 +4062,15 @@ func (m *QueryDelegatorProvidersRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDelegatorProvidersRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProviderDelegatorCountRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDelegatorProvidersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProviderDelegatorCountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1412,9 +4098,41 @@ func (m *QueryDelegatorProvidersRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Delegator = string(dAtA[iNdEx:postIndex])
+			m.Provider = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field WithPending", wireType)
 			}
@@ -1455,7 +4173,7 @@ func (m *QueryDelegatorProvidersRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDelegatorProvidersResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryProviderDelegatorCountResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1478,17 +4196,150 @@ func (m *QueryDelegatorProvidersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDelegatorProvidersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProviderDelegatorCountResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDelegatorProvidersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProviderDelegatorCountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDelegationAtHeightRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelegationAtHeightRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelegationAtHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delegations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1498,26 +4349,43 @@ func (m *QueryDelegatorProvidersResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Delegations = append(m.Delegations, Delegation{})
-			if err := m.Delegations[len(m.Delegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1539,7 +4407,7 @@ func (m *QueryDelegatorProvidersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryProviderDelegatorsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegationAtHeightResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1562,17 +4430,17 @@ func (m *QueryProviderDelegatorsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryProviderDelegatorsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelegationAtHeightResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryProviderDelegatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelegationAtHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegation", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1582,44 +4450,25 @@ func (m *QueryProviderDelegatorsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Provider = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WithPending", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Delegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.WithPending = bool(v != 0)
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1641,7 +4490,7 @@ func (m *QueryProviderDelegatorsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryProviderDelegatorsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPoolReconciliationRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1664,46 +4513,12 @@ func (m *QueryProviderDelegatorsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryProviderDelegatorsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPoolReconciliationRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryProviderDelegatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPoolReconciliationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delegations", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Delegations = append(m.Delegations, Delegation{})
-			if err := m.Delegations[len(m.Delegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1725,7 +4540,7 @@ func (m *QueryProviderDelegatorsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDelegatorRewardsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPoolReconciliationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1748,17 +4563,17 @@ func (m *QueryDelegatorRewardsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDelegatorRewardsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPoolReconciliationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDelegatorRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPoolReconciliationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolBalance", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1768,29 +4583,30 @@ func (m *QueryDelegatorRewardsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Delegator = string(dAtA[iNdEx:postIndex])
+			if err := m.PoolBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SummedDelegations", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1800,27 +4616,28 @@ func (m *QueryDelegatorRewardsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Provider = string(dAtA[iNdEx:postIndex])
+			if err := m.SummedDelegations.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Diff", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1848,7 +4665,9 @@ func (m *QueryDelegatorRewardsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
+			if err := m.Diff.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1871,7 +4690,7 @@ func (m *QueryDelegatorRewardsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDelegatorRewardsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryStoreStatsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1894,46 +4713,12 @@ func (m *QueryDelegatorRewardsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDelegatorRewardsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryStoreStatsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDelegatorRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryStoreStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Rewards = append(m.Rewards, DelegatorRewardInfo{})
-			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1955,7 +4740,7 @@ func (m *QueryDelegatorRewardsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DelegatorRewardInfo) Unmarshal(dAtA []byte) error {
+func (m *QueryStoreStatsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1978,17 +4763,17 @@ func (m *DelegatorRewardInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DelegatorRewardInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryStoreStatsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DelegatorRewardInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryStoreStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegationEntries", wireType)
 			}
-			var stringLen uint64
+			m.DelegationEntries = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1998,29 +4783,16 @@ func (m *DelegatorRewardInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.DelegationEntries |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Provider = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegationIndices", wireType)
 			}
-			var stringLen uint64
+			m.DelegationIndices = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2030,29 +4802,16 @@ func (m *DelegatorRewardInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.DelegationIndices |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorEntries", wireType)
 			}
-			var msglen int
+			m.DelegatorEntries = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2062,25 +4821,30 @@ func (m *DelegatorRewardInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.DelegatorEntries |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorIndices", wireType)
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.DelegatorIndices = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DelegatorIndices |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7,6 +7,22 @@ const (
 	ClaimRewardsEventName      = "delegator_claim_rewards"
 	ContributorRewardEventName = "contributor_rewards"
 	ValidatorSlashEventName    = "validator_slash"
+
+	EmptyProviderRebalanceEventName    = "empty_provider_rebalance"
+	ProviderMinStakeReachedEventName   = "provider_min_stake_reached"
+	ForceUnbondDelegatorEventName      = "force_unbond_delegator"
+	UpdateDelegatorAllowlistEventName  = "update_delegator_allowlist"
+	SetWithdrawAddressEventName        = "set_withdraw_address"
+	SetAutoCompoundEventName           = "set_auto_compound"
+	ScheduleDelegationEventName        = "schedule_delegation"
+	CancelScheduledDelegationEventName = "cancel_scheduled_delegation"
+)
+
+// reasons for moving funds through the empty provider programmatically
+const (
+	EmptyProviderRebalanceValidatorDelegate = "validator-delegate"
+	EmptyProviderRebalanceUniformUnbond     = "uniform-unbond"
+	EmptyProviderRebalanceProviderUnstaked  = "provider-unstaked"
 )
 
 const (
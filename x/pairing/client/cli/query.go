@@ -43,6 +43,7 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	cmd.AddCommand(CmdSdkPairing())
 	cmd.AddCommand(CmdProviderMonthlyPayout())
 	cmd.AddCommand(CmdSubscriptionMonthlyPayout())
+	cmd.AddCommand(CmdUnbondingHoldBlocks())
 
 	cmd.AddCommand(CmdDebugQuery())
 
@@ -0,0 +1,39 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/lavanet/lava/x/pairing/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdUnbondingHoldBlocks() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unbonding-hold-blocks [chain-id]",
+		Short: "Query the hold window (blocks and estimated duration) of unstaked funds on a chain",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryUnbondingHoldBlocksRequest{
+				ChainID: args[0],
+			}
+
+			res, err := queryClient.UnbondingHoldBlocks(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
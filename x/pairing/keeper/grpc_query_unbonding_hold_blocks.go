@@ -0,0 +1,25 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/x/pairing/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) UnbondingHoldBlocks(goCtx context.Context, req *types.QueryUnbondingHoldBlocksRequest) (*types.QueryUnbondingHoldBlocksResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	blocks, duration, err := k.UnstakeHoldWindow(ctx, req.ChainID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryUnbondingHoldBlocksResponse{Blocks: blocks, EstimatedDuration: duration}, nil
+}
@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/lavanet/lava/testutil/common"
 	spectypes "github.com/lavanet/lava/x/spec/types"
@@ -39,3 +40,45 @@ func TestUnstakeStaticProvider(t *testing.T) {
 	_, found, _ = ts.Keepers.Epochstorage.UnstakeEntryByAddress(ts.Ctx, providerAcct.Addr)
 	require.False(t, found)
 }
+
+func TestUnstakeHoldWindow(t *testing.T) {
+	ts := newTester(t)
+
+	// a dynamic spec (the default "mock") and a static one
+	staticSpec := ts.spec
+	staticSpec.Index = "static"
+	staticSpec.Name = "static"
+	staticSpec.ProvidersTypes = spectypes.Spec_static
+	ts.AddSpec(staticSpec.Index, staticSpec)
+
+	epochBlocks := ts.EpochBlocks()
+	blockTime := ts.Keepers.Downtime.GetParams(ts.Ctx).EpochDuration / time.Duration(epochBlocks)
+
+	blocks, duration, err := ts.Keepers.Pairing.UnstakeHoldWindow(ts.Ctx, ts.spec.Index)
+	require.NoError(t, err)
+	require.Equal(t, ts.Keepers.Epochstorage.UnstakeHoldBlocks(ts.Ctx, ts.BlockHeight()), blocks)
+	require.Equal(t, time.Duration(blocks)*blockTime, duration)
+
+	blocksStatic, durationStatic, err := ts.Keepers.Pairing.UnstakeHoldWindow(ts.Ctx, staticSpec.Index)
+	require.NoError(t, err)
+	require.Equal(t, ts.Keepers.Epochstorage.UnstakeHoldBlocksStatic(ts.Ctx, ts.BlockHeight()), blocksStatic)
+	require.Equal(t, time.Duration(blocksStatic)*blockTime, durationStatic)
+	require.Greater(t, blocksStatic, blocks)
+
+	_, _, err = ts.Keepers.Pairing.UnstakeHoldWindow(ts.Ctx, "nosuchchain")
+	require.ErrorIs(t, err, spectypes.ErrSpecNotFound)
+
+	// the query reports the same windows
+	res, err := ts.QueryPairingUnbondingHoldBlocks(ts.spec.Index)
+	require.NoError(t, err)
+	require.Equal(t, blocks, res.Blocks)
+	require.Equal(t, duration, res.EstimatedDuration)
+
+	res, err = ts.QueryPairingUnbondingHoldBlocks(staticSpec.Index)
+	require.NoError(t, err)
+	require.Equal(t, blocksStatic, res.Blocks)
+	require.Equal(t, durationStatic, res.EstimatedDuration)
+
+	_, err = ts.QueryPairingUnbondingHoldBlocks("nosuchchain")
+	require.ErrorIs(t, err, spectypes.ErrSpecNotFound)
+}
@@ -3,6 +3,7 @@ package keeper
 import (
 	"fmt"
 	"strconv"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/lavanet/lava/utils"
@@ -101,6 +102,33 @@ func (k Keeper) getUnstakeHoldBlocks(ctx sdk.Context, chainID string) uint64 {
 	// NOT REACHED
 }
 
+// UnstakeHoldWindow returns the number of blocks for which an unstaked provider's
+// funds are held on a chain (static specs hold longer than dynamic ones), and the
+// estimated duration of that window based on the expected epoch duration.
+func (k Keeper) UnstakeHoldWindow(ctx sdk.Context, chainID string) (blocks uint64, duration time.Duration, err error) {
+	_, found, _ := k.specKeeper.IsSpecFoundAndActive(ctx, chainID)
+	if !found {
+		return 0, 0, utils.LavaFormatWarning("cannot get unstake hold window", spectypes.ErrSpecNotFound,
+			utils.Attribute{Key: "chainID", Value: chainID},
+		)
+	}
+
+	blocks = k.getUnstakeHoldBlocks(ctx, chainID)
+
+	epochBlocks, err := k.epochStorageKeeper.EpochBlocks(ctx, uint64(ctx.BlockHeight()))
+	if err != nil {
+		return 0, 0, utils.LavaFormatError("cannot get epoch blocks", err,
+			utils.Attribute{Key: "chainID", Value: chainID},
+		)
+	}
+	if epochBlocks == 0 {
+		return blocks, 0, nil
+	}
+
+	blockTime := k.downtimeKeeper.GetParams(ctx).EpochDuration / time.Duration(epochBlocks)
+	return blocks, time.Duration(blocks) * blockTime, nil
+}
+
 func (k Keeper) UnstakeEntryForce(ctx sdk.Context, chainID, provider, unstakeDescription string) error {
 	providerAddr, err := sdk.AccAddressFromBech32(provider)
 	if err != nil {
@@ -10,6 +10,7 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_gogo_protobuf_types "github.com/cosmos/gogoproto/types"
 	v1 "github.com/lavanet/lava/x/downtime/v1"
 	types "github.com/lavanet/lava/x/epochstorage/types"
 	types1 "github.com/lavanet/lava/x/plans/types"
@@ -20,15 +21,18 @@ import (
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -1781,6 +1785,102 @@ func (m *QuerySubscriptionMonthlyPayoutResponse) GetDetails() []*ChainIDPayout {
 	return nil
 }
 
+type QueryUnbondingHoldBlocksRequest struct {
+	ChainID string `protobuf:"bytes,1,opt,name=chainID,proto3" json:"chainID,omitempty"`
+}
+
+func (m *QueryUnbondingHoldBlocksRequest) Reset()         { *m = QueryUnbondingHoldBlocksRequest{} }
+func (m *QueryUnbondingHoldBlocksRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryUnbondingHoldBlocksRequest) ProtoMessage()    {}
+func (*QueryUnbondingHoldBlocksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e149ce9d21da0d8, []int{34}
+}
+func (m *QueryUnbondingHoldBlocksRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUnbondingHoldBlocksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUnbondingHoldBlocksRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUnbondingHoldBlocksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUnbondingHoldBlocksRequest.Merge(m, src)
+}
+func (m *QueryUnbondingHoldBlocksRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUnbondingHoldBlocksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUnbondingHoldBlocksRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUnbondingHoldBlocksRequest proto.InternalMessageInfo
+
+func (m *QueryUnbondingHoldBlocksRequest) GetChainID() string {
+	if m != nil {
+		return m.ChainID
+	}
+	return ""
+}
+
+type QueryUnbondingHoldBlocksResponse struct {
+	Blocks            uint64        `protobuf:"varint,1,opt,name=blocks,proto3" json:"blocks,omitempty"`
+	EstimatedDuration time.Duration `protobuf:"bytes,2,opt,name=estimated_duration,json=estimatedDuration,proto3,stdduration" json:"estimated_duration"`
+}
+
+func (m *QueryUnbondingHoldBlocksResponse) Reset()         { *m = QueryUnbondingHoldBlocksResponse{} }
+func (m *QueryUnbondingHoldBlocksResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryUnbondingHoldBlocksResponse) ProtoMessage()    {}
+func (*QueryUnbondingHoldBlocksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e149ce9d21da0d8, []int{35}
+}
+func (m *QueryUnbondingHoldBlocksResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUnbondingHoldBlocksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUnbondingHoldBlocksResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUnbondingHoldBlocksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUnbondingHoldBlocksResponse.Merge(m, src)
+}
+func (m *QueryUnbondingHoldBlocksResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUnbondingHoldBlocksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUnbondingHoldBlocksResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUnbondingHoldBlocksResponse proto.InternalMessageInfo
+
+func (m *QueryUnbondingHoldBlocksResponse) GetBlocks() uint64 {
+	if m != nil {
+		return m.Blocks
+	}
+	return 0
+}
+
+func (m *QueryUnbondingHoldBlocksResponse) GetEstimatedDuration() time.Duration {
+	if m != nil {
+		return m.EstimatedDuration
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "lavanet.lava.pairing.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "lavanet.lava.pairing.QueryParamsResponse")
@@ -1816,138 +1916,146 @@ func init() {
 	proto.RegisterType((*ChainIDPayout)(nil), "lavanet.lava.pairing.ChainIDPayout")
 	proto.RegisterType((*QuerySubscriptionMonthlyPayoutRequest)(nil), "lavanet.lava.pairing.QuerySubscriptionMonthlyPayoutRequest")
 	proto.RegisterType((*QuerySubscriptionMonthlyPayoutResponse)(nil), "lavanet.lava.pairing.QuerySubscriptionMonthlyPayoutResponse")
+	proto.RegisterType((*QueryUnbondingHoldBlocksRequest)(nil), "lavanet.lava.pairing.QueryUnbondingHoldBlocksRequest")
+	proto.RegisterType((*QueryUnbondingHoldBlocksResponse)(nil), "lavanet.lava.pairing.QueryUnbondingHoldBlocksResponse")
 }
 
 func init() { proto.RegisterFile("lavanet/lava/pairing/query.proto", fileDescriptor_9e149ce9d21da0d8) }
 
 var fileDescriptor_9e149ce9d21da0d8 = []byte{
-	// 2001 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x59, 0xcb, 0x6f, 0xdc, 0x6a,
-	0x15, 0xaf, 0x27, 0x8f, 0x26, 0xa7, 0x4d, 0x5b, 0x7d, 0x37, 0x49, 0x13, 0x93, 0x9b, 0xa6, 0x5f,
-	0x5f, 0x09, 0x0d, 0xe3, 0x9b, 0xe9, 0xe3, 0x46, 0x7d, 0x41, 0xd2, 0x17, 0x29, 0x81, 0x9b, 0x4c,
-	0x08, 0x0b, 0x36, 0x96, 0xe3, 0xf9, 0x32, 0x71, 0xe3, 0xb1, 0x5d, 0x3f, 0xa6, 0x09, 0xa3, 0x01,
-	0x04, 0x62, 0x7b, 0x85, 0xc4, 0x65, 0x81, 0xc4, 0xf2, 0x4a, 0x88, 0x05, 0xec, 0x91, 0xd8, 0x21,
-	0xd0, 0x5d, 0x20, 0x74, 0xa5, 0x6e, 0x58, 0x00, 0x42, 0x2d, 0xff, 0x00, 0xff, 0x01, 0xf2, 0xf7,
-	0x1d, 0x7b, 0xec, 0x89, 0xc7, 0x33, 0xd3, 0x44, 0x6c, 0xda, 0x7c, 0xf6, 0x79, 0xfc, 0xce, 0xef,
-	0x1c, 0xfb, 0x9c, 0xe3, 0x81, 0x39, 0x53, 0xab, 0x6b, 0x16, 0xf3, 0x95, 0xf0, 0x7f, 0xc5, 0xd1,
-	0x0c, 0xd7, 0xb0, 0xaa, 0xca, 0xab, 0x80, 0xb9, 0x87, 0x45, 0xc7, 0xb5, 0x7d, 0x9b, 0x8c, 0xa3,
-	0x44, 0x31, 0xfc, 0xbf, 0x88, 0x12, 0xf2, 0x78, 0xd5, 0xae, 0xda, 0x5c, 0x40, 0x09, 0xff, 0x12,
-	0xb2, 0xf2, 0x4c, 0xd5, 0xb6, 0xab, 0x26, 0x53, 0x34, 0xc7, 0x50, 0x34, 0xcb, 0xb2, 0x7d, 0xcd,
-	0x37, 0x6c, 0xcb, 0xc3, 0xbb, 0x5f, 0xd5, 0x6d, 0xaf, 0x66, 0x7b, 0xca, 0x8e, 0xe6, 0x31, 0xe1,
-	0x42, 0xa9, 0x2f, 0xed, 0x30, 0x5f, 0x5b, 0x52, 0x1c, 0xad, 0x6a, 0x58, 0x5c, 0x18, 0x65, 0x2f,
-	0x67, 0xe2, 0x72, 0x34, 0x57, 0xab, 0x45, 0xe6, 0x16, 0x32, 0x45, 0x98, 0x63, 0xeb, 0x7b, 0xaa,
-	0xa3, 0x1d, 0xd6, 0x98, 0xe5, 0x47, 0xa2, 0x33, 0x29, 0x51, 0xcf, 0x61, 0x3a, 0xff, 0x07, 0xef,
-	0x5e, 0x4a, 0x1b, 0x32, 0x35, 0xcb, 0x53, 0x1c, 0xdb, 0x34, 0x74, 0xa4, 0x40, 0xbe, 0x95, 0x0d,
-	0xc6, 0xb5, 0xeb, 0x46, 0x85, 0xb9, 0x91, 0x33, 0xd5, 0xf3, 0x6d, 0x57, 0xab, 0x32, 0x54, 0x5a,
-	0xc9, 0x54, 0x0a, 0x2c, 0xe3, 0x55, 0xc0, 0xda, 0x55, 0x54, 0xdd, 0x34, 0xc2, 0x63, 0x64, 0x12,
-	0x4d, 0xdc, 0x4c, 0x99, 0xe0, 0x91, 0xa1, 0x82, 0xe2, 0xf9, 0xda, 0x3e, 0x53, 0x99, 0xe5, 0x47,
-	0x79, 0x92, 0x17, 0xd3, 0x31, 0x06, 0x3b, 0x9e, 0xee, 0x1a, 0x4e, 0x48, 0x69, 0xea, 0x80, 0xd2,
-	0x57, 0xd2, 0xe8, 0x5c, 0xfb, 0x25, 0xd3, 0x7d, 0x2f, 0xfa, 0x03, 0x85, 0x6e, 0xa4, 0x84, 0x2a,
-	0xf6, 0x6b, 0xcb, 0x37, 0x6a, 0x4c, 0xa9, 0x2f, 0xc5, 0x7f, 0x0b, 0x41, 0x3a, 0x0e, 0x64, 0x33,
-	0xcc, 0xe7, 0x06, 0xcf, 0x4f, 0x99, 0xbd, 0x0a, 0x98, 0xe7, 0xd3, 0x4d, 0xf8, 0x20, 0x75, 0xd5,
-	0x73, 0x6c, 0xcb, 0x63, 0xe4, 0x1e, 0x0c, 0x8b, 0x3c, 0x4e, 0x49, 0x73, 0xd2, 0xfc, 0x99, 0xd2,
-	0x4c, 0x31, 0xab, 0xc2, 0x8a, 0x42, 0x6b, 0x75, 0xf0, 0x8b, 0x7f, 0x5d, 0x3a, 0x55, 0x46, 0x0d,
-	0xba, 0x09, 0x13, 0xc2, 0x24, 0x12, 0x15, 0xf9, 0x22, 0x53, 0x70, 0x5a, 0xdf, 0xd3, 0x0c, 0x6b,
-	0xed, 0x09, 0xb7, 0x3a, 0x5a, 0x8e, 0x8e, 0x64, 0x16, 0xc0, 0xdb, 0xb3, 0x5f, 0x3f, 0x73, 0xed,
-	0x1f, 0x30, 0x6b, 0xaa, 0x30, 0x27, 0xcd, 0x8f, 0x94, 0x13, 0x57, 0xe8, 0x3e, 0x4c, 0xb6, 0x9b,
-	0x44, 0xa0, 0xdf, 0x02, 0xe0, 0x34, 0x3f, 0x0d, 0x59, 0x9e, 0x92, 0xe6, 0x06, 0xe6, 0xcf, 0x94,
-	0xae, 0xa5, 0xc1, 0x26, 0x73, 0x52, 0xdc, 0x8a, 0x85, 0x11, 0x75, 0x42, 0xfd, 0xc5, 0xe0, 0x48,
-	0xe1, 0xc2, 0x00, 0x7d, 0x81, 0xce, 0x9e, 0x33, 0x7f, 0x43, 0xc4, 0xd9, 0x3d, 0x80, 0x49, 0x18,
-	0x16, 0xe5, 0xc1, 0xc1, 0x8f, 0x96, 0xf1, 0x44, 0x7f, 0x57, 0x80, 0x8b, 0x47, 0x8c, 0x21, 0xf4,
-	0x35, 0x18, 0x8d, 0x6a, 0xc9, 0x7b, 0x1f, 0xe4, 0x2d, 0x6d, 0x72, 0x05, 0xc6, 0xf4, 0xc0, 0x75,
-	0xc3, 0xf2, 0xe4, 0x3a, 0x1c, 0xc5, 0x60, 0xf9, 0x2c, 0x5e, 0x7c, 0x1a, 0x5e, 0x23, 0xcb, 0x30,
-	0x1d, 0x96, 0x83, 0x6a, 0xb2, 0x5d, 0x5f, 0xf5, 0x6d, 0xd5, 0x62, 0x07, 0xbe, 0x8a, 0x99, 0x9c,
-	0x1a, 0xe0, 0x0a, 0x13, 0xa1, 0xc0, 0x3a, 0xdb, 0xf5, 0xbf, 0x6b, 0x7f, 0x87, 0x1d, 0x44, 0x88,
-	0xc9, 0x1d, 0xb8, 0x18, 0x3e, 0x8a, 0xaa, 0xa9, 0x79, 0xbe, 0x1a, 0x38, 0x15, 0xcd, 0x67, 0x15,
-	0x75, 0xc7, 0xb4, 0xf5, 0xfd, 0xa9, 0x41, 0xae, 0x37, 0x1e, 0xde, 0x5e, 0xd7, 0x3c, 0x7f, 0x5b,
-	0xdc, 0x5c, 0x0d, 0xef, 0x91, 0x25, 0x98, 0xe0, 0x42, 0xaa, 0xbd, 0x9b, 0x76, 0x36, 0xc4, 0x95,
-	0x08, 0xbf, 0xf9, 0xc9, 0x6e, 0xc2, 0x13, 0xfd, 0x11, 0x4c, 0x73, 0xba, 0xbe, 0xc7, 0x5c, 0x63,
-	0xf7, 0xf0, 0xb8, 0xf4, 0x13, 0x19, 0x46, 0x22, 0x92, 0x78, 0x84, 0xa3, 0xe5, 0xf8, 0x4c, 0xc6,
-	0x61, 0x28, 0x19, 0x82, 0x38, 0xd0, 0xcf, 0x25, 0x90, 0xb3, 0x10, 0x60, 0xce, 0xc6, 0x61, 0xa8,
-	0xae, 0x99, 0x46, 0x85, 0x03, 0x18, 0x29, 0x8b, 0x03, 0x59, 0x80, 0x0b, 0x61, 0x68, 0xac, 0xa2,
-	0xb6, 0x12, 0x2a, 0x08, 0x3d, 0x2f, 0xae, 0xc7, 0x75, 0x4b, 0xe6, 0xe0, 0xac, 0x1e, 0xa8, 0x0e,
-	0x73, 0x31, 0x51, 0xc2, 0x39, 0xe8, 0xc1, 0x06, 0x73, 0x45, 0x9a, 0x3e, 0x04, 0xc0, 0x27, 0x5c,
-	0x35, 0x2a, 0x9c, 0xaa, 0x51, 0x9e, 0xea, 0xf0, 0xca, 0x5a, 0x05, 0x6b, 0x74, 0x0d, 0x96, 0xa2,
-	0xb2, 0xda, 0xe6, 0x6f, 0xab, 0x0d, 0xf1, 0xb2, 0xda, 0x12, 0xc5, 0xf2, 0x98, 0x87, 0x1f, 0x79,
-	0x8d, 0xf8, 0x1b, 0x87, 0x21, 0xc3, 0xaa, 0xb0, 0x03, 0x64, 0x4f, 0x1c, 0xe8, 0x9f, 0x25, 0x28,
-	0xf5, 0x63, 0x0b, 0x99, 0xf8, 0x54, 0x02, 0x1a, 0x74, 0x15, 0xc7, 0xd7, 0xc7, 0x72, 0xf6, 0xeb,
-	0xa3, 0xbb, 0x3b, 0x2c, 0xf5, 0x1e, 0x3c, 0xd1, 0x06, 0x52, 0xb2, 0x62, 0x9a, 0xbd, 0x53, 0xf2,
-	0x0c, 0xa0, 0xd5, 0xd6, 0x10, 0xec, 0xf5, 0xa2, 0xe8, 0x81, 0xc5, 0xb0, 0x07, 0x16, 0x45, 0x9b,
-	0xc5, 0x1e, 0x58, 0xdc, 0xd0, 0xaa, 0x0c, 0x75, 0xcb, 0x09, 0x4d, 0xfa, 0x69, 0x01, 0x49, 0xec,
-	0xd1, 0x7b, 0xbf, 0x24, 0x0e, 0xfc, 0x7f, 0x48, 0x24, 0xcf, 0x53, 0x7c, 0x14, 0x38, 0x1f, 0x37,
-	0xba, 0xf2, 0x21, 0xa2, 0x49, 0x11, 0xf2, 0x10, 0xae, 0xc5, 0xef, 0x3d, 0x34, 0x9e, 0x76, 0x9c,
-	0x5f, 0x94, 0x9f, 0x49, 0x70, 0xbd, 0x9b, 0x3e, 0x72, 0xf8, 0x12, 0x26, 0x9d, 0x4c, 0x09, 0x4c,
-	0xe7, 0x62, 0x87, 0xd6, 0x95, 0xa9, 0x83, 0x54, 0x75, 0xb0, 0x48, 0x6d, 0x8c, 0x6a, 0xc5, 0x34,
-	0xf3, 0xa3, 0x3a, 0xa9, 0xba, 0xfa, 0x67, 0xc4, 0x43, 0x8e, 0xc7, 0x1e, 0x78, 0x18, 0x38, 0x59,
-	0x1e, 0x4e, 0xae, 0x4c, 0x6e, 0xc3, 0x4c, 0x94, 0x66, 0xfe, 0xf6, 0x43, 0x3f, 0x5e, 0x7e, 0x75,
-	0x38, 0xf0, 0x61, 0x07, 0x2d, 0xe4, 0xe2, 0x13, 0x18, 0x63, 0xc9, 0x1b, 0x98, 0x81, 0x2b, 0xd9,
-	0x14, 0xa4, 0x6c, 0x60, 0xe4, 0x69, 0x7d, 0xba, 0x8b, 0x38, 0x57, 0x4c, 0x33, 0x13, 0xe7, 0x49,
-	0xe5, 0xfb, 0x0f, 0x12, 0x86, 0x76, 0xd4, 0x51, 0xe7, 0xd0, 0x06, 0x8e, 0x13, 0xda, 0xc9, 0xe5,
-	0x52, 0xc3, 0xb9, 0x6f, 0xdb, 0x63, 0x2e, 0x9f, 0x53, 0x12, 0x7d, 0x5b, 0xab, 0x54, 0x5c, 0xe6,
-	0x79, 0x51, 0xdf, 0xc6, 0x63, 0xb2, 0xa3, 0x17, 0xd2, 0x1d, 0x3d, 0xee, 0xce, 0x03, 0xc9, 0xee,
-	0xfc, 0x1a, 0x47, 0xb3, 0x84, 0x0b, 0xa4, 0xe5, 0x39, 0x8c, 0xe8, 0xb6, 0xe5, 0x05, 0xb5, 0xb8,
-	0xe7, 0xf4, 0x35, 0x4b, 0xc5, 0xca, 0xa1, 0xe3, 0x9a, 0x76, 0xf0, 0x78, 0x1b, 0x47, 0x28, 0x71,
-	0xa0, 0xf7, 0xe1, 0x12, 0x77, 0xbc, 0x15, 0x6e, 0x4b, 0x7a, 0xdc, 0xce, 0xd7, 0x0d, 0xcf, 0xef,
-	0x3a, 0x9d, 0xd0, 0x1a, 0xcc, 0x75, 0x56, 0x3e, 0xf1, 0x61, 0x90, 0x6e, 0xc2, 0x57, 0xb8, 0xbb,
-	0xa7, 0xbb, 0xbb, 0x4c, 0xf7, 0x8d, 0x3a, 0xdb, 0xe0, 0x7b, 0x52, 0x84, 0x53, 0x6e, 0x63, 0x6a,
-	0x34, 0x11, 0xfc, 0x24, 0x0c, 0x87, 0x93, 0x5c, 0x9c, 0x0e, 0x3c, 0xd1, 0x5f, 0x4a, 0x58, 0xff,
-	0x47, 0x6c, 0x22, 0xfc, 0x12, 0x0c, 0x8b, 0x6d, 0x0c, 0xc9, 0x97, 0xdb, 0xca, 0x31, 0xdc, 0xd7,
-	0x8a, 0xa8, 0x83, 0x92, 0x64, 0x05, 0xce, 0x39, 0xcc, 0xaa, 0x18, 0x56, 0x55, 0x45, 0xdd, 0x42,
-	0x57, 0xdd, 0x31, 0xd4, 0x10, 0x47, 0xfa, 0x5f, 0x09, 0xc7, 0xeb, 0xad, 0xca, 0x7e, 0xfb, 0xa8,
-	0xf6, 0x1c, 0x4e, 0x47, 0xf3, 0xa6, 0xc0, 0xf4, 0xb5, 0xec, 0x47, 0xa4, 0xc3, 0x78, 0x5e, 0x8e,
-	0xb4, 0xc9, 0x04, 0x0c, 0xd7, 0xb4, 0x03, 0x55, 0x0f, 0x92, 0x25, 0x11, 0x90, 0x9b, 0x30, 0x18,
-	0xb2, 0xc3, 0x0b, 0xf4, 0x4c, 0xe9, 0x62, 0xda, 0x38, 0xdf, 0x5c, 0xb7, 0x1c, 0xa6, 0x97, 0xb9,
-	0x10, 0x59, 0x83, 0xf3, 0xd1, 0x3a, 0xa6, 0xe2, 0x62, 0x35, 0xc8, 0xf5, 0xe6, 0xd2, 0x7a, 0xf1,
-	0xce, 0x56, 0x5f, 0xc2, 0xe5, 0xaa, 0x7c, 0x2e, 0xba, 0x26, 0xce, 0xf4, 0xeb, 0x70, 0x39, 0xb5,
-	0x0b, 0x7d, 0xdb, 0xb6, 0xfc, 0x3d, 0xf3, 0x70, 0x43, 0x3b, 0xb4, 0x03, 0x3f, 0x91, 0x64, 0x27,
-	0x39, 0x82, 0x25, 0x06, 0x5f, 0xba, 0x0f, 0x64, 0x2b, 0xb1, 0x6c, 0x0a, 0x45, 0x42, 0xe1, 0x6c,
-	0x72, 0x05, 0x45, 0xad, 0xd4, 0x35, 0x32, 0x0d, 0x23, 0xbc, 0xa6, 0xc3, 0xc1, 0x34, 0xf5, 0xbc,
-	0x56, 0xc2, 0xca, 0xd1, 0x6a, 0x76, 0x60, 0xf9, 0xf8, 0xc0, 0xe2, 0x89, 0xfe, 0x10, 0x68, 0x1e,
-	0xda, 0xd6, 0x58, 0xed, 0xdb, 0xbe, 0x66, 0x72, 0xaf, 0x83, 0x65, 0x71, 0x20, 0xab, 0x70, 0xba,
-	0xc2, 0x7c, 0xcd, 0x30, 0xbd, 0xa9, 0x02, 0x7f, 0x22, 0xe6, 0xb3, 0x33, 0x78, 0x34, 0x9a, 0x72,
-	0xa4, 0x48, 0x9f, 0xc0, 0xb9, 0x44, 0x87, 0x0b, 0x03, 0xcd, 0xa1, 0x26, 0x11, 0x45, 0x21, 0x15,
-	0xc5, 0x4b, 0x18, 0x7b, 0x2c, 0x1e, 0x66, 0x34, 0x92, 0x64, 0x42, 0x4a, 0x33, 0xf1, 0x28, 0xac,
-	0xbb, 0x50, 0x28, 0x42, 0x7d, 0xb5, 0x6b, 0xe3, 0xe5, 0x88, 0x51, 0x89, 0x3e, 0xc6, 0x19, 0x23,
-	0x19, 0x55, 0xa7, 0x1c, 0x77, 0x7a, 0x90, 0x69, 0x13, 0xc7, 0x86, 0x1c, 0x23, 0xb9, 0xd4, 0x3f,
-	0x6c, 0xa7, 0xbe, 0x43, 0x7f, 0x49, 0xb1, 0x12, 0xb3, 0x5e, 0xfa, 0xf5, 0x14, 0x0c, 0x71, 0xff,
-	0xe4, 0xa7, 0x12, 0x0c, 0x8b, 0xc2, 0x25, 0xf3, 0x39, 0xcf, 0x5f, 0xea, 0xa3, 0x84, 0xbc, 0xd0,
-	0x83, 0xa4, 0x80, 0x4f, 0xaf, 0xfe, 0xe4, 0xcd, 0x7f, 0x7e, 0x51, 0x98, 0x25, 0x33, 0x4a, 0xce,
-	0xc7, 0x28, 0xf2, 0x2b, 0x09, 0x46, 0x5b, 0x3b, 0xd8, 0xcd, 0x3c, 0xf3, 0x6d, 0x1f, 0x2d, 0xe4,
-	0xc5, 0xde, 0x84, 0x11, 0xce, 0x12, 0x87, 0x73, 0x93, 0x2c, 0x28, 0xb9, 0x9f, 0xa3, 0x3c, 0xa5,
-	0x81, 0xcd, 0xa1, 0x49, 0x7e, 0x23, 0x01, 0xb4, 0x5e, 0x3f, 0x64, 0xb1, 0xc7, 0xb7, 0x94, 0x40,
-	0xd7, 0xdf, 0x3b, 0x8d, 0x3e, 0xe0, 0xf0, 0xee, 0x92, 0xdb, 0xd9, 0xf0, 0xaa, 0x2c, 0xde, 0xd1,
-	0x5b, 0x00, 0x95, 0x86, 0x58, 0xa6, 0x9b, 0xe4, 0x2f, 0x12, 0x8c, 0xa5, 0xd6, 0x62, 0xa2, 0xe4,
-	0xb8, 0xcf, 0x5a, 0xe1, 0xe5, 0x8f, 0x7a, 0x57, 0x40, 0xc8, 0x65, 0x0e, 0x79, 0x9d, 0xbc, 0xc8,
-	0x86, 0x5c, 0xe7, 0x4a, 0x39, 0xa8, 0x95, 0x46, 0x44, 0x7a, 0x53, 0x69, 0xf0, 0x29, 0xa2, 0x49,
-	0x7e, 0x56, 0x00, 0xba, 0xdd, 0xc3, 0x32, 0x94, 0x4f, 0x6e, 0xcf, 0x5b, 0xa6, 0xfc, 0xcd, 0xe3,
-	0x1b, 0x42, 0x36, 0xd6, 0x39, 0x1b, 0xcf, 0xc8, 0x13, 0xe5, 0x18, 0x5f, 0x2e, 0x95, 0x06, 0x1f,
-	0xa3, 0x9b, 0xe4, 0xc7, 0x05, 0xb8, 0xd6, 0xdd, 0xf9, 0x8a, 0x69, 0xe6, 0x52, 0xd1, 0xcf, 0xc2,
-	0x9d, 0x4b, 0x45, 0x5f, 0xbb, 0x33, 0x7d, 0xc2, 0xa9, 0x78, 0x44, 0x1e, 0x1c, 0x87, 0x0a, 0xf2,
-	0x46, 0x82, 0xc9, 0xec, 0x15, 0x88, 0xdc, 0xef, 0xf2, 0x6c, 0xe5, 0x2d, 0x80, 0xf2, 0x83, 0xf7,
-	0x53, 0xc6, 0xd8, 0x1e, 0xf1, 0xd8, 0x96, 0xc9, 0x5d, 0xa5, 0xaf, 0xaf, 0xda, 0x71, 0x62, 0xff,
-	0x26, 0xc1, 0x74, 0xb6, 0x8b, 0x30, 0x99, 0xf7, 0xf3, 0x73, 0xf0, 0xfe, 0x81, 0x75, 0x5d, 0x52,
-	0xe9, 0x5d, 0x1e, 0xd8, 0x47, 0xa4, 0xd8, 0x5f, 0x60, 0xe4, 0xf7, 0x12, 0x8c, 0xa5, 0x76, 0x19,
-	0x52, 0xca, 0x27, 0x38, 0x6b, 0x4b, 0x93, 0x6f, 0xf5, 0xa5, 0x83, 0x90, 0x6f, 0x73, 0xc8, 0x45,
-	0xb2, 0xa8, 0xf4, 0xf0, 0x5b, 0x46, 0x9c, 0x81, 0xdf, 0x4a, 0x70, 0x21, 0x65, 0x2f, 0x24, 0xbe,
-	0x94, 0xcf, 0x5d, 0xdf, 0x98, 0x3b, 0x2d, 0x89, 0x74, 0x91, 0x63, 0xbe, 0x4e, 0xae, 0xf6, 0x82,
-	0x99, 0x7c, 0x2e, 0xc1, 0x68, 0xbc, 0x51, 0xe5, 0x76, 0xc7, 0xf6, 0xd5, 0x2e, 0xb7, 0x3b, 0x1e,
-	0x59, 0xd2, 0xba, 0xb5, 0x9f, 0xc0, 0x63, 0xae, 0xf8, 0xb9, 0x44, 0x69, 0xe0, 0x86, 0xd8, 0x4c,
-	0x34, 0xca, 0x3f, 0x49, 0xf0, 0x41, 0xc6, 0x0a, 0x45, 0xee, 0xe4, 0x60, 0xe8, 0xbc, 0xaf, 0xc9,
-	0x77, 0xfb, 0x55, 0xc3, 0x20, 0x1e, 0xf2, 0x20, 0x3e, 0x26, 0x77, 0xb2, 0x83, 0xf0, 0xb8, 0x6a,
-	0xeb, 0x43, 0xb0, 0x6a, 0x1a, 0x9e, 0x9f, 0x88, 0xe2, 0x8f, 0x12, 0x9c, 0x6f, 0xdb, 0xa2, 0xc8,
-	0x52, 0x0e, 0x94, 0xec, 0x2d, 0x4e, 0x2e, 0xf5, 0xa3, 0x82, 0xc8, 0x57, 0x39, 0xf2, 0x07, 0xe4,
-	0x5e, 0x87, 0xaa, 0x88, 0xd4, 0x70, 0x1d, 0x53, 0x1a, 0xd1, 0x38, 0xd9, 0x54, 0x1a, 0x62, 0x11,
-	0x6c, 0x92, 0xbf, 0x4a, 0x30, 0x91, 0x39, 0xcb, 0x93, 0x8f, 0x7b, 0x18, 0x94, 0xb2, 0xe6, 0x58,
-	0x79, 0xb9, 0x7f, 0x45, 0x0c, 0xe8, 0x1b, 0x3c, 0xa0, 0x7b, 0x64, 0xb9, 0xcb, 0xdb, 0xa4, 0x26,
-	0xb4, 0x55, 0x31, 0x62, 0x27, 0x26, 0x02, 0xf2, 0x0f, 0x09, 0xa6, 0x3b, 0xce, 0xc8, 0xb9, 0x2f,
-	0xca, 0x6e, 0xe3, 0x79, 0xee, 0x8b, 0xb2, 0xeb, 0x58, 0xde, 0xad, 0xbb, 0x25, 0xd7, 0xb2, 0x23,
-	0xe1, 0xc5, 0x69, 0x23, 0x9f, 0x49, 0x00, 0xad, 0xd5, 0xf8, 0x04, 0x67, 0xcb, 0xa3, 0xfb, 0x36,
-	0x5d, 0xe0, 0x88, 0xaf, 0x90, 0xcb, 0x1d, 0x10, 0x57, 0xf6, 0xa3, 0x29, 0x6d, 0x75, 0xe5, 0x8b,
-	0xb7, 0xb3, 0xd2, 0x97, 0x6f, 0x67, 0xa5, 0x7f, 0xbf, 0x9d, 0x95, 0x7e, 0xfe, 0x6e, 0xf6, 0xd4,
-	0x97, 0xef, 0x66, 0x4f, 0xfd, 0xfd, 0xdd, 0xec, 0xa9, 0xef, 0xdf, 0xa8, 0x1a, 0xfe, 0x5e, 0xb0,
-	0x53, 0xd4, 0xed, 0x5a, 0xda, 0xcc, 0x41, 0x6c, 0xc8, 0x3f, 0x74, 0x98, 0xb7, 0x33, 0xcc, 0x7f,
-	0xd4, 0xbc, 0xf5, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xff, 0x5f, 0xe2, 0xef, 0x1c, 0x1f, 0x00,
-	0x00,
+	// 2110 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x59, 0xcd, 0x6f, 0xdc, 0x5a,
+	0x15, 0xaf, 0x27, 0x69, 0x9a, 0x9c, 0x36, 0x6d, 0xb9, 0x2f, 0x49, 0x13, 0x93, 0x4e, 0x53, 0xf7,
+	0x2b, 0xa1, 0xc1, 0x7e, 0x99, 0xb6, 0x79, 0x51, 0x9b, 0x16, 0x92, 0xa6, 0x1f, 0x29, 0x81, 0x97,
+	0x3a, 0x84, 0x05, 0x1b, 0xcb, 0xf1, 0xdc, 0x99, 0xb8, 0xf1, 0xd8, 0xae, 0x3f, 0xd2, 0x84, 0xd1,
+	0x00, 0x02, 0xb1, 0xad, 0x90, 0x78, 0x48, 0xb0, 0x7f, 0x12, 0x62, 0x01, 0x7b, 0x24, 0x76, 0x08,
+	0xf4, 0x16, 0x08, 0x3d, 0xe9, 0x6d, 0x58, 0xf0, 0xa5, 0x96, 0x7f, 0x80, 0xff, 0x00, 0xf9, 0xde,
+	0xe3, 0x19, 0x7b, 0xea, 0xf1, 0xcc, 0x34, 0xd1, 0xdb, 0xb4, 0x73, 0xed, 0xf3, 0xbb, 0xe7, 0x77,
+	0x7e, 0xe7, 0xfa, 0xde, 0x73, 0x6e, 0x60, 0xc6, 0xd2, 0xf7, 0x75, 0x9b, 0x06, 0x4a, 0xf4, 0xbf,
+	0xe2, 0xea, 0xa6, 0x67, 0xda, 0x55, 0xe5, 0x65, 0x48, 0xbd, 0x43, 0xd9, 0xf5, 0x9c, 0xc0, 0x21,
+	0x63, 0x68, 0x21, 0x47, 0xff, 0xcb, 0x68, 0x21, 0x8e, 0x55, 0x9d, 0xaa, 0xc3, 0x0c, 0x94, 0xe8,
+	0x17, 0xb7, 0x15, 0xa7, 0xab, 0x8e, 0x53, 0xb5, 0xa8, 0xa2, 0xbb, 0xa6, 0xa2, 0xdb, 0xb6, 0x13,
+	0xe8, 0x81, 0xe9, 0xd8, 0x3e, 0xbe, 0xfd, 0x9a, 0xe1, 0xf8, 0x35, 0xc7, 0x57, 0x76, 0x74, 0x9f,
+	0x72, 0x17, 0xca, 0xfe, 0xc2, 0x0e, 0x0d, 0xf4, 0x05, 0xc5, 0xd5, 0xab, 0xa6, 0xcd, 0x8c, 0xd1,
+	0xf6, 0x72, 0x26, 0x2f, 0x57, 0xf7, 0xf4, 0x5a, 0x3c, 0xdd, 0x5c, 0xa6, 0x09, 0x75, 0x1d, 0x63,
+	0x57, 0x73, 0xf5, 0xc3, 0x1a, 0xb5, 0x83, 0xd8, 0x74, 0x3a, 0x65, 0xea, 0xbb, 0xd4, 0x60, 0xff,
+	0xe0, 0xdb, 0x4b, 0xe9, 0x89, 0x2c, 0xdd, 0xf6, 0x15, 0xd7, 0xb1, 0x4c, 0x03, 0x25, 0x10, 0x6f,
+	0x65, 0x93, 0xf1, 0x9c, 0x7d, 0xb3, 0x4c, 0xbd, 0xd8, 0x99, 0xe6, 0x07, 0x8e, 0xa7, 0x57, 0x29,
+	0x82, 0x56, 0x32, 0x41, 0xa1, 0x6d, 0xbe, 0x0c, 0x69, 0x3b, 0x44, 0x33, 0x2c, 0x33, 0x1a, 0xc6,
+	0x53, 0xe2, 0x14, 0x37, 0x53, 0x53, 0xb0, 0xc8, 0x10, 0xa0, 0xf8, 0x81, 0xbe, 0x47, 0x35, 0x6a,
+	0x07, 0x71, 0x9e, 0xc4, 0xf9, 0x74, 0x8c, 0xe1, 0x8e, 0x6f, 0x78, 0xa6, 0x1b, 0x49, 0x9a, 0x1a,
+	0xa0, 0xf5, 0x95, 0x34, 0x3b, 0xcf, 0x79, 0x41, 0x8d, 0xc0, 0x8f, 0x7f, 0xa0, 0xd1, 0x8d, 0x94,
+	0x51, 0xd9, 0x79, 0x65, 0x07, 0x66, 0x8d, 0x2a, 0xfb, 0x0b, 0xcd, 0xdf, 0x68, 0x58, 0xc4, 0xbc,
+	0xb3, 0xd1, 0x4e, 0x58, 0x51, 0xca, 0xa1, 0x97, 0xc8, 0xa6, 0x34, 0x06, 0xe4, 0x79, 0x94, 0xef,
+	0x4d, 0x96, 0x3f, 0x95, 0xbe, 0x0c, 0xa9, 0x1f, 0x48, 0xcf, 0xe1, 0x83, 0xd4, 0x53, 0xdf, 0x75,
+	0x6c, 0x9f, 0x92, 0xbb, 0x30, 0xc4, 0xf3, 0x3c, 0x29, 0xcc, 0x08, 0xb3, 0xa7, 0x4b, 0xd3, 0x72,
+	0xd6, 0x0a, 0x94, 0x39, 0x6a, 0x75, 0xf0, 0xb3, 0x7f, 0x5d, 0x3a, 0xa1, 0x22, 0x42, 0x7a, 0x0e,
+	0xe3, 0x7c, 0x4a, 0x14, 0x32, 0xf6, 0x45, 0x26, 0xe1, 0x94, 0xb1, 0xab, 0x9b, 0xf6, 0xfa, 0x1a,
+	0x9b, 0x75, 0x44, 0x8d, 0x87, 0xa4, 0x08, 0xe0, 0xef, 0x3a, 0xaf, 0x1e, 0x7b, 0xce, 0x0f, 0xa8,
+	0x3d, 0x59, 0x98, 0x11, 0x66, 0x87, 0xd5, 0xc4, 0x13, 0x69, 0x0f, 0x26, 0xda, 0xa7, 0x44, 0xa2,
+	0xdf, 0x02, 0x60, 0x69, 0x78, 0x14, 0x65, 0x61, 0x52, 0x98, 0x19, 0x98, 0x3d, 0x5d, 0xba, 0x96,
+	0x26, 0x9b, 0xcc, 0x99, 0xbc, 0xd5, 0x34, 0x46, 0xd6, 0x09, 0xf8, 0xb3, 0xc1, 0xe1, 0xc2, 0xf9,
+	0x01, 0xe9, 0x19, 0x3a, 0x7b, 0x42, 0x83, 0x4d, 0x1e, 0x67, 0xf7, 0x00, 0x26, 0x60, 0x88, 0x2f,
+	0x1f, 0x46, 0x7e, 0x44, 0xc5, 0x91, 0xf4, 0xbb, 0x02, 0x5c, 0x78, 0x67, 0x32, 0xa4, 0xbe, 0x0e,
+	0x23, 0xf1, 0x5a, 0xf3, 0xdf, 0x87, 0x79, 0x0b, 0x4d, 0xae, 0xc0, 0xa8, 0x11, 0x7a, 0x5e, 0xb4,
+	0x7c, 0x19, 0x86, 0xb1, 0x18, 0x54, 0xcf, 0xe0, 0xc3, 0x47, 0xd1, 0x33, 0xb2, 0x04, 0x53, 0xd1,
+	0x72, 0xd1, 0x2c, 0x5a, 0x09, 0xb4, 0xc0, 0xd1, 0x6c, 0x7a, 0x10, 0x68, 0x98, 0xc9, 0xc9, 0x01,
+	0x06, 0x18, 0x8f, 0x0c, 0x36, 0x68, 0x25, 0xf8, 0xae, 0xf3, 0x1d, 0x7a, 0x10, 0x33, 0x26, 0x77,
+	0xe0, 0x42, 0xf4, 0xa9, 0x6a, 0x96, 0xee, 0x07, 0x5a, 0xe8, 0x96, 0xf5, 0x80, 0x96, 0xb5, 0x1d,
+	0xcb, 0x31, 0xf6, 0x26, 0x07, 0x19, 0x6e, 0x2c, 0x7a, 0xbd, 0xa1, 0xfb, 0xc1, 0x36, 0x7f, 0xb9,
+	0x1a, 0xbd, 0x23, 0x0b, 0x30, 0xce, 0x8c, 0x34, 0xa7, 0x92, 0x76, 0x76, 0x92, 0x81, 0x08, 0x7b,
+	0xf9, 0x71, 0x25, 0xe1, 0x49, 0xfa, 0x11, 0x4c, 0x31, 0xb9, 0xbe, 0x47, 0x3d, 0xb3, 0x72, 0x78,
+	0x54, 0xf9, 0x89, 0x08, 0xc3, 0xb1, 0x48, 0x2c, 0xc2, 0x11, 0xb5, 0x39, 0x26, 0x63, 0x70, 0x32,
+	0x19, 0x02, 0x1f, 0x48, 0x9f, 0x0a, 0x20, 0x66, 0x31, 0xc0, 0x9c, 0x8d, 0xc1, 0xc9, 0x7d, 0xdd,
+	0x32, 0xcb, 0x8c, 0xc0, 0xb0, 0xca, 0x07, 0x64, 0x0e, 0xce, 0x47, 0xa1, 0xd1, 0xb2, 0xd6, 0x4a,
+	0x28, 0x17, 0xf4, 0x1c, 0x7f, 0xde, 0x5c, 0xb7, 0x64, 0x06, 0xce, 0x18, 0xa1, 0xe6, 0x52, 0x0f,
+	0x13, 0xc5, 0x9d, 0x83, 0x11, 0x6e, 0x52, 0x8f, 0xa7, 0xe9, 0x22, 0x00, 0xee, 0x00, 0x9a, 0x59,
+	0x66, 0x52, 0x8d, 0xa8, 0x23, 0xf8, 0x64, 0xbd, 0x8c, 0x6b, 0x74, 0x1d, 0x16, 0xe2, 0x65, 0xb5,
+	0xcd, 0x76, 0xb3, 0x4d, 0xbe, 0x99, 0x6d, 0xf1, 0xc5, 0xf2, 0x90, 0x85, 0x1f, 0x7b, 0x8d, 0xf5,
+	0x1b, 0x83, 0x93, 0xa6, 0x5d, 0xa6, 0x07, 0xa8, 0x1e, 0x1f, 0x48, 0x7f, 0x16, 0xa0, 0xd4, 0xcf,
+	0x5c, 0xa8, 0xc4, 0x6b, 0x01, 0xa4, 0xb0, 0xab, 0x39, 0x6e, 0x1f, 0x4b, 0xd9, 0xdb, 0x47, 0x77,
+	0x77, 0xb8, 0xd4, 0x7b, 0xf0, 0x24, 0xd5, 0x51, 0x92, 0x15, 0xcb, 0xea, 0x5d, 0x92, 0xc7, 0x00,
+	0xad, 0x63, 0x0f, 0xc9, 0x5e, 0x97, 0xf9, 0x19, 0x29, 0x47, 0x67, 0xa4, 0xcc, 0x8f, 0x61, 0x3c,
+	0x23, 0xe5, 0x4d, 0xbd, 0x4a, 0x11, 0xab, 0x26, 0x90, 0xd2, 0xeb, 0x02, 0x94, 0xfa, 0xf1, 0xde,
+	0xaf, 0x88, 0x03, 0x5f, 0x8e, 0x88, 0xe4, 0x49, 0x4a, 0x8f, 0x02, 0xd3, 0xe3, 0x46, 0x57, 0x3d,
+	0x78, 0x34, 0x29, 0x41, 0xee, 0xc3, 0xb5, 0xe6, 0xbe, 0x87, 0x93, 0xa7, 0x1d, 0xe7, 0x2f, 0xca,
+	0x4f, 0x04, 0xb8, 0xde, 0x0d, 0x8f, 0x1a, 0xbe, 0x80, 0x09, 0x37, 0xd3, 0x02, 0xd3, 0x39, 0xdf,
+	0xe1, 0xe8, 0xca, 0xc4, 0xa0, 0x54, 0x1d, 0x66, 0x94, 0x1c, 0x8c, 0x6a, 0xc5, 0xb2, 0xf2, 0xa3,
+	0x3a, 0xae, 0x75, 0xf5, 0xcf, 0x58, 0x87, 0x1c, 0x8f, 0x3d, 0xe8, 0x30, 0x70, 0xbc, 0x3a, 0x1c,
+	0xdf, 0x32, 0xb9, 0x0d, 0xd3, 0x71, 0x9a, 0xd9, 0xee, 0x87, 0x7e, 0xfc, 0xfc, 0xd5, 0xe1, 0xc2,
+	0xc5, 0x0e, 0x28, 0xd4, 0xe2, 0x63, 0x18, 0xa5, 0xc9, 0x17, 0x98, 0x81, 0x2b, 0xd9, 0x12, 0xa4,
+	0xe6, 0xc0, 0xc8, 0xd3, 0x78, 0xa9, 0x82, 0x3c, 0x57, 0x2c, 0x2b, 0x93, 0xe7, 0x71, 0xe5, 0xfb,
+	0x0f, 0x02, 0x5c, 0xec, 0xe0, 0xa8, 0x73, 0x68, 0x03, 0x47, 0x09, 0xed, 0xf8, 0x72, 0xa9, 0x63,
+	0xdd, 0xb7, 0xed, 0x53, 0x8f, 0xd5, 0x29, 0x89, 0x73, 0x5b, 0x2f, 0x97, 0x3d, 0xea, 0xfb, 0xf1,
+	0xb9, 0x8d, 0xc3, 0xe4, 0x89, 0x5e, 0x48, 0x9f, 0xe8, 0xcd, 0xd3, 0x79, 0x20, 0x79, 0x3a, 0xbf,
+	0x82, 0x89, 0x76, 0x17, 0x28, 0xcb, 0x13, 0x18, 0x36, 0x1c, 0xdb, 0x0f, 0x6b, 0xcd, 0x33, 0xa7,
+	0xaf, 0x5a, 0xaa, 0x09, 0x8e, 0x1c, 0xd7, 0xf4, 0x83, 0x87, 0xdb, 0x58, 0x42, 0xf1, 0x81, 0x74,
+	0x0f, 0x2e, 0x31, 0xc7, 0x5b, 0x81, 0x1e, 0x98, 0x46, 0xf3, 0x38, 0xdf, 0x30, 0xfd, 0xa0, 0x6b,
+	0x75, 0x22, 0xd5, 0x60, 0xa6, 0x33, 0xf8, 0xd8, 0x8b, 0x41, 0xe9, 0x39, 0x7c, 0x95, 0xb9, 0x7b,
+	0x54, 0xa9, 0x50, 0x23, 0x30, 0xf7, 0xe9, 0x26, 0xeb, 0xa3, 0x62, 0x9e, 0x62, 0x9b, 0x52, 0x23,
+	0x89, 0xe0, 0x27, 0x60, 0x28, 0xaa, 0xe4, 0x9a, 0xe9, 0xc0, 0x91, 0xf4, 0x4b, 0x01, 0xa6, 0xb3,
+	0xe7, 0x44, 0xfa, 0x25, 0x18, 0xe2, 0xdd, 0x1a, 0x8a, 0x2f, 0xb6, 0x2d, 0xc7, 0xa8, 0x9f, 0x93,
+	0x11, 0x83, 0x96, 0x64, 0x05, 0xce, 0xba, 0xd4, 0x2e, 0x9b, 0x76, 0x55, 0x43, 0x6c, 0xa1, 0x2b,
+	0x76, 0x14, 0x11, 0x7c, 0x28, 0xfd, 0x4f, 0xc0, 0xf2, 0x7a, 0xab, 0xbc, 0xd7, 0x5e, 0xaa, 0x3d,
+	0x81, 0x53, 0x71, 0xbd, 0xc9, 0x39, 0x7d, 0x3d, 0xfb, 0x13, 0xe9, 0x50, 0x9e, 0xab, 0x31, 0x9a,
+	0x8c, 0xc3, 0x50, 0x4d, 0x3f, 0xd0, 0x8c, 0x30, 0xb9, 0x24, 0x42, 0x72, 0x13, 0x06, 0x23, 0x75,
+	0xd8, 0x02, 0x3d, 0x5d, 0xba, 0x90, 0x9e, 0x3c, 0x7a, 0x23, 0x6f, 0xb9, 0xd4, 0x50, 0x99, 0x11,
+	0x59, 0x87, 0x73, 0x71, 0xbb, 0xa6, 0x61, 0x63, 0x35, 0xc8, 0x70, 0x33, 0x69, 0x5c, 0x6c, 0x24,
+	0xef, 0x2f, 0x60, 0x73, 0xa5, 0x9e, 0x8d, 0x9f, 0xf1, 0xb1, 0xf4, 0x0d, 0xb8, 0x9c, 0xea, 0x85,
+	0xbe, 0xed, 0xd8, 0xc1, 0xae, 0x75, 0xb8, 0xa9, 0x1f, 0x3a, 0x61, 0x90, 0x48, 0xb2, 0x9b, 0x2c,
+	0xc1, 0x12, 0x85, 0xaf, 0xb4, 0x07, 0x64, 0x2b, 0xd1, 0x8c, 0x72, 0x20, 0x91, 0xe0, 0x4c, 0xb2,
+	0x45, 0x45, 0x54, 0xea, 0x19, 0x99, 0x82, 0x61, 0xb6, 0xa6, 0xa3, 0xc2, 0x34, 0xf5, 0xbd, 0x96,
+	0xa3, 0x95, 0xa3, 0xd7, 0x9c, 0xd0, 0x0e, 0xf0, 0x83, 0xc5, 0x91, 0xf4, 0x43, 0x90, 0xf2, 0xd8,
+	0xb6, 0xca, 0xea, 0xc0, 0x09, 0x74, 0x8b, 0x79, 0x1d, 0x54, 0xf9, 0x80, 0xac, 0xc2, 0xa9, 0x32,
+	0x0d, 0x74, 0xd3, 0xf2, 0x27, 0x0b, 0xec, 0x8b, 0x98, 0xcd, 0xce, 0xe0, 0xbb, 0xd1, 0xa8, 0x31,
+	0x50, 0x5a, 0x83, 0xb3, 0x89, 0x13, 0xce, 0x09, 0x73, 0xa5, 0x49, 0x44, 0x51, 0x48, 0x45, 0xf1,
+	0x02, 0x46, 0x1f, 0xf2, 0x8f, 0x19, 0x27, 0x49, 0x2a, 0x21, 0xa4, 0x95, 0x78, 0x10, 0xad, 0xbb,
+	0xc8, 0x28, 0x66, 0x7d, 0xb5, 0xeb, 0xc1, 0xcb, 0x18, 0x23, 0x48, 0x7a, 0x88, 0x35, 0x46, 0x32,
+	0xaa, 0x4e, 0x39, 0xee, 0xf4, 0x21, 0x4b, 0x0d, 0xb8, 0xde, 0x6d, 0x92, 0x5c, 0xe9, 0xef, 0xb7,
+	0x4b, 0xdf, 0xe1, 0x7c, 0x49, 0xa9, 0xd2, 0x52, 0x3d, 0xde, 0x2e, 0xb7, 0xed, 0x1d, 0x87, 0x7d,
+	0xaf, 0x4f, 0x1d, 0x8b, 0x37, 0x85, 0xdd, 0x2f, 0x03, 0xa4, 0xd7, 0x02, 0xcc, 0x74, 0x46, 0x23,
+	0xed, 0x09, 0x18, 0x62, 0x47, 0x82, 0x8f, 0xbc, 0x71, 0x44, 0x54, 0x20, 0xd4, 0x0f, 0xcc, 0x1a,
+	0x6b, 0x51, 0xe3, 0x1b, 0x10, 0xdc, 0x58, 0xa6, 0x64, 0x7e, 0x45, 0x22, 0xc7, 0x57, 0x24, 0xf2,
+	0x1a, 0x1a, 0xac, 0x0e, 0x47, 0x9b, 0xe8, 0xaf, 0xfe, 0x7d, 0x49, 0x50, 0xbf, 0xd2, 0x84, 0xc7,
+	0x2f, 0x4b, 0x6f, 0xa6, 0xe0, 0x24, 0x23, 0x44, 0x7e, 0x2a, 0xc0, 0x10, 0xff, 0x0c, 0xc9, 0x6c,
+	0xce, 0x6e, 0x92, 0xba, 0x62, 0x11, 0xe7, 0x7a, 0xb0, 0xe4, 0x51, 0x49, 0x57, 0x7f, 0xf2, 0xc5,
+	0x7f, 0x7f, 0x51, 0x28, 0x92, 0x69, 0x25, 0xe7, 0xea, 0x8d, 0xfc, 0x5a, 0x80, 0x91, 0x56, 0x47,
+	0x79, 0x33, 0x6f, 0xfa, 0xb6, 0x2b, 0x18, 0x71, 0xbe, 0x37, 0x63, 0xa4, 0xb3, 0xc0, 0xe8, 0xdc,
+	0x24, 0x73, 0x4a, 0xee, 0xe5, 0x9b, 0xaf, 0xd4, 0x31, 0x77, 0x0d, 0xf2, 0x1b, 0x01, 0xa0, 0xb5,
+	0x99, 0x92, 0xf9, 0x1e, 0xf7, 0x5c, 0xce, 0xae, 0xbf, 0x1d, 0x5a, 0x5a, 0x66, 0xf4, 0x16, 0xc9,
+	0xed, 0x6c, 0x7a, 0x55, 0xda, 0xbc, 0x71, 0x68, 0x11, 0x54, 0xea, 0xfc, 0x6a, 0xa0, 0x41, 0xfe,
+	0x22, 0xc0, 0x68, 0xaa, 0xc9, 0x27, 0x4a, 0x8e, 0xfb, 0xac, 0x0b, 0x09, 0xf1, 0xc3, 0xde, 0x01,
+	0x48, 0x59, 0x65, 0x94, 0x37, 0xc8, 0xb3, 0x6c, 0xca, 0xfb, 0x0c, 0x94, 0xc3, 0x5a, 0xa9, 0xc7,
+	0xa2, 0x37, 0x94, 0x3a, 0x5b, 0xf2, 0x0d, 0xf2, 0xb3, 0x02, 0x48, 0xdb, 0x3d, 0xb4, 0x76, 0xf9,
+	0xe2, 0xf6, 0xdc, 0x33, 0x8b, 0x4f, 0x8f, 0x3e, 0x11, 0xaa, 0xb1, 0xc1, 0xd4, 0x78, 0x4c, 0xd6,
+	0x94, 0x23, 0xdc, 0xd3, 0x2a, 0x75, 0xd6, 0x14, 0x34, 0xc8, 0x8f, 0x0b, 0x70, 0xad, 0xbb, 0xf3,
+	0x15, 0xcb, 0xca, 0x95, 0xa2, 0x9f, 0xeb, 0x03, 0xf1, 0xe9, 0xd1, 0x27, 0x42, 0x29, 0xd6, 0x98,
+	0x14, 0x0f, 0xc8, 0xf2, 0x51, 0xa4, 0x20, 0x5f, 0x08, 0x30, 0x91, 0xdd, 0xd0, 0x91, 0x7b, 0x5d,
+	0xbe, 0xad, 0xbc, 0x76, 0x56, 0x5c, 0x7e, 0x3f, 0x30, 0xc6, 0xf6, 0x80, 0xc5, 0xb6, 0x44, 0x16,
+	0x95, 0xbe, 0xee, 0xf0, 0x9b, 0x89, 0xfd, 0x9b, 0x00, 0x53, 0xd9, 0x2e, 0xa2, 0x64, 0xde, 0xcb,
+	0xcf, 0xc1, 0xfb, 0x07, 0xd6, 0xb5, 0xe5, 0x96, 0x16, 0x59, 0x60, 0x1f, 0x12, 0xb9, 0xbf, 0xc0,
+	0xc8, 0xef, 0x05, 0x18, 0x4d, 0x75, 0x66, 0xa4, 0x94, 0x2f, 0x70, 0x56, 0xcf, 0x29, 0xde, 0xea,
+	0x0b, 0x83, 0x94, 0x6f, 0x33, 0xca, 0x32, 0x99, 0x57, 0x7a, 0xf8, 0xcb, 0x4d, 0x33, 0x03, 0xbf,
+	0x15, 0xe0, 0x7c, 0x6a, 0xbe, 0x48, 0xf8, 0x52, 0xbe, 0x76, 0x7d, 0x73, 0xee, 0xd4, 0xf2, 0x4a,
+	0xf3, 0x8c, 0xf3, 0x75, 0x72, 0xb5, 0x17, 0xce, 0xe4, 0x53, 0x01, 0x46, 0x9a, 0xfd, 0x61, 0xee,
+	0xe9, 0xd8, 0xde, 0xa8, 0x8a, 0xf3, 0xbd, 0x19, 0xf7, 0x76, 0xfc, 0x84, 0x7e, 0x74, 0xc9, 0x1b,
+	0x21, 0x94, 0x3a, 0xf6, 0xbb, 0x8d, 0xc4, 0x41, 0xf9, 0x27, 0x01, 0x3e, 0xc8, 0x68, 0x08, 0xc9,
+	0x9d, 0x1c, 0x0e, 0x9d, 0xbb, 0x4f, 0x71, 0xb1, 0x5f, 0x18, 0x06, 0x71, 0x9f, 0x05, 0xf1, 0x11,
+	0xb9, 0x93, 0x1d, 0x84, 0xcf, 0xa0, 0xad, 0x6b, 0x6d, 0xcd, 0x32, 0xfd, 0x20, 0x11, 0xc5, 0x1f,
+	0x05, 0x38, 0xd7, 0xd6, 0x13, 0x92, 0x85, 0x1c, 0x2a, 0xd9, 0x3d, 0xa9, 0x58, 0xea, 0x07, 0x82,
+	0xcc, 0x57, 0x19, 0xf3, 0x65, 0x72, 0xb7, 0xc3, 0xaa, 0x88, 0x61, 0xd8, 0x5c, 0x2a, 0xf5, 0xb8,
+	0x38, 0x6e, 0x28, 0x75, 0xde, 0xd6, 0x36, 0xc8, 0x5f, 0x05, 0x18, 0xcf, 0xec, 0x4c, 0xc8, 0x47,
+	0x3d, 0x14, 0x4a, 0x59, 0x55, 0xb9, 0xb8, 0xd4, 0x3f, 0x10, 0x03, 0xfa, 0x26, 0x0b, 0xe8, 0x2e,
+	0x59, 0xea, 0xb2, 0x9b, 0xd4, 0x38, 0x5a, 0xe3, 0x0d, 0x43, 0xa2, 0x22, 0x20, 0xff, 0x10, 0x60,
+	0xaa, 0x63, 0xc5, 0x9f, 0xbb, 0x51, 0x76, 0x6b, 0x36, 0xc4, 0xe5, 0xf7, 0x03, 0xf7, 0x76, 0xba,
+	0x25, 0x9b, 0xcc, 0x77, 0xc2, 0x6b, 0xa6, 0x8d, 0x7d, 0x32, 0x19, 0x3d, 0x41, 0xee, 0x27, 0xd3,
+	0xb9, 0x03, 0x11, 0x17, 0xfb, 0x85, 0xf5, 0xf6, 0xc9, 0x84, 0x31, 0x54, 0xdb, 0x75, 0x2c, 0xfc,
+	0x33, 0x59, 0xb2, 0x42, 0xfe, 0x44, 0x00, 0x68, 0x5d, 0x57, 0x1c, 0x63, 0x85, 0xfc, 0xee, 0x1d,
+	0x88, 0x34, 0xc7, 0xa8, 0x5e, 0x21, 0x97, 0x3b, 0xe8, 0x5e, 0xde, 0x8b, 0x6b, 0xcd, 0xd5, 0x95,
+	0xcf, 0xde, 0x14, 0x85, 0xcf, 0xdf, 0x14, 0x85, 0xff, 0xbc, 0x29, 0x0a, 0x3f, 0x7f, 0x5b, 0x3c,
+	0xf1, 0xf9, 0xdb, 0xe2, 0x89, 0xbf, 0xbf, 0x2d, 0x9e, 0xf8, 0xfe, 0x8d, 0xaa, 0x19, 0xec, 0x86,
+	0x3b, 0xb2, 0xe1, 0xd4, 0xd2, 0xd3, 0x1c, 0x34, 0x27, 0x0a, 0x0e, 0x5d, 0xea, 0xef, 0x0c, 0xb1,
+	0xbe, 0xea, 0xd6, 0xff, 0x07, 0x00, 0xb7, 0x3b, 0x11, 0x37, 0xd0, 0x20, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1992,6 +2100,8 @@ type QueryClient interface {
 	ProviderMonthlyPayout(ctx context.Context, in *QueryProviderMonthlyPayoutRequest, opts ...grpc.CallOption) (*QueryProviderMonthlyPayoutResponse, error)
 	// Queries the expected monthly payout of a specific subscription
 	SubscriptionMonthlyPayout(ctx context.Context, in *QuerySubscriptionMonthlyPayoutRequest, opts ...grpc.CallOption) (*QuerySubscriptionMonthlyPayoutResponse, error)
+	// Queries the hold window of unstaked funds on a chain (static specs hold longer than dynamic ones)
+	UnbondingHoldBlocks(ctx context.Context, in *QueryUnbondingHoldBlocksRequest, opts ...grpc.CallOption) (*QueryUnbondingHoldBlocksResponse, error)
 	// this line is used by starport scaffolding # 2
 	// Queries a list of SdkPairing items.
 	SdkPairing(ctx context.Context, in *QueryGetPairingRequest, opts ...grpc.CallOption) (*QuerySdkPairingResponse, error)
@@ -2140,6 +2250,15 @@ func (c *queryClient) SubscriptionMonthlyPayout(ctx context.Context, in *QuerySu
 	return out, nil
 }
 
+func (c *queryClient) UnbondingHoldBlocks(ctx context.Context, in *QueryUnbondingHoldBlocksRequest, opts ...grpc.CallOption) (*QueryUnbondingHoldBlocksResponse, error) {
+	out := new(QueryUnbondingHoldBlocksResponse)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.pairing.Query/UnbondingHoldBlocks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) SdkPairing(ctx context.Context, in *QueryGetPairingRequest, opts ...grpc.CallOption) (*QuerySdkPairingResponse, error) {
 	out := new(QuerySdkPairingResponse)
 	err := c.cc.Invoke(ctx, "/lavanet.lava.pairing.Query/SdkPairing", in, out, opts...)
@@ -2181,6 +2300,8 @@ type QueryServer interface {
 	ProviderMonthlyPayout(context.Context, *QueryProviderMonthlyPayoutRequest) (*QueryProviderMonthlyPayoutResponse, error)
 	// Queries the expected monthly payout of a specific subscription
 	SubscriptionMonthlyPayout(context.Context, *QuerySubscriptionMonthlyPayoutRequest) (*QuerySubscriptionMonthlyPayoutResponse, error)
+	// Queries the hold window of unstaked funds on a chain (static specs hold longer than dynamic ones)
+	UnbondingHoldBlocks(context.Context, *QueryUnbondingHoldBlocksRequest) (*QueryUnbondingHoldBlocksResponse, error)
 	// this line is used by starport scaffolding # 2
 	// Queries a list of SdkPairing items.
 	SdkPairing(context.Context, *QueryGetPairingRequest) (*QuerySdkPairingResponse, error)
@@ -2235,6 +2356,9 @@ func (*UnimplementedQueryServer) ProviderMonthlyPayout(ctx context.Context, req
 func (*UnimplementedQueryServer) SubscriptionMonthlyPayout(ctx context.Context, req *QuerySubscriptionMonthlyPayoutRequest) (*QuerySubscriptionMonthlyPayoutResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SubscriptionMonthlyPayout not implemented")
 }
+func (*UnimplementedQueryServer) UnbondingHoldBlocks(ctx context.Context, req *QueryUnbondingHoldBlocksRequest) (*QueryUnbondingHoldBlocksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnbondingHoldBlocks not implemented")
+}
 func (*UnimplementedQueryServer) SdkPairing(ctx context.Context, req *QueryGetPairingRequest) (*QuerySdkPairingResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SdkPairing not implemented")
 }
@@ -2513,6 +2637,24 @@ func _Query_SubscriptionMonthlyPayout_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_UnbondingHoldBlocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUnbondingHoldBlocksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UnbondingHoldBlocks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.pairing.Query/UnbondingHoldBlocks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UnbondingHoldBlocks(ctx, req.(*QueryUnbondingHoldBlocksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_SdkPairing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryGetPairingRequest)
 	if err := dec(in); err != nil {
@@ -2595,6 +2737,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SubscriptionMonthlyPayout",
 			Handler:    _Query_SubscriptionMonthlyPayout_Handler,
 		},
+		{
+			MethodName: "UnbondingHoldBlocks",
+			Handler:    _Query_UnbondingHoldBlocks_Handler,
+		},
 		{
 			MethodName: "SdkPairing",
 			Handler:    _Query_SdkPairing_Handler,
@@ -3931,6 +4077,72 @@ func (m *QuerySubscriptionMonthlyPayoutResponse) MarshalToSizedBuffer(dAtA []byt
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryUnbondingHoldBlocksRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUnbondingHoldBlocksRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUnbondingHoldBlocksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUnbondingHoldBlocksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUnbondingHoldBlocksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUnbondingHoldBlocksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n17, err17 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.EstimatedDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.EstimatedDuration):])
+	if err17 != nil {
+		return 0, err17
+	}
+	i -= n17
+	i = encodeVarintQuery(dAtA, i, uint64(n17))
+	i--
+	dAtA[i] = 0x12
+	if m.Blocks != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Blocks))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -4488,6 +4700,33 @@ func (m *QuerySubscriptionMonthlyPayoutResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryUnbondingHoldBlocksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryUnbondingHoldBlocksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Blocks != 0 {
+		n += 1 + sovQuery(uint64(m.Blocks))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.EstimatedDuration)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -8039,6 +8278,190 @@ func (m *QuerySubscriptionMonthlyPayoutResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryUnbondingHoldBlocksRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUnbondingHoldBlocksRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUnbondingHoldBlocksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUnbondingHoldBlocksResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUnbondingHoldBlocksResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUnbondingHoldBlocksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
+			}
+			m.Blocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Blocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EstimatedDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.EstimatedDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0